@@ -36,6 +36,7 @@ func main() {
 		args                    = os.Args[1:]
 		clientFactory           = app.FastlyAPIClient
 		httpClient              = http.DefaultClient
+		rtsFactory              = app.FastlyRTSClient
 		in            io.Reader = os.Stdin
 		out           io.Writer = sync.NewWriter(os.Stdout)
 		versionerCLI            = update.NewGitHub(update.GitHubOpts{
@@ -140,12 +141,14 @@ Compatibility and versioning information for the Fastly CLI is being updated in
 		Env:        env,
 		ErrLog:     fsterrors.Log,
 		HTTPClient: httpClient,
+		RTSClient:  rtsFactory,
 		Stdin:      in,
 		Stdout:     out,
 		Versioners: app.Versioners{
 			CLI:     versionerCLI,
 			Viceroy: versionerViceroy,
 		},
+		TokenCheck: true,
 	}
 	err = app.Run(opts)
 
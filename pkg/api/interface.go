@@ -17,9 +17,18 @@ type HTTPClient interface {
 // Interface models the methods of the Fastly API client that we use.
 // It exists to allow for easier testing, in combination with Mock.
 type Interface interface {
+	// Get issues a request to an arbitrary API path, for the endpoints whose
+	// go-fastly functions don't support a feature we need (e.g. pagination).
+	Get(string, *fastly.RequestOptions) (*http.Response, error)
+
 	AllIPs() (v4, v6 fastly.IPAddrs, err error)
 	AllDatacenters() (datacenters []fastly.Datacenter, err error)
 	GetTokenSelf() (*fastly.Token, error)
+	ListTokens() ([]*fastly.Token, error)
+	ListCustomerTokens(*fastly.ListCustomerTokensInput) ([]*fastly.Token, error)
+	CreateToken(*fastly.CreateTokenInput) (*fastly.Token, error)
+	DeleteToken(*fastly.DeleteTokenInput) error
+	DeleteTokenSelf() error
 
 	CreateService(*fastly.CreateServiceInput) (*fastly.Service, error)
 	ListServices(*fastly.ListServicesInput) ([]*fastly.Service, error)
@@ -56,6 +65,45 @@ type Interface interface {
 	UpdateHealthCheck(*fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
 	DeleteHealthCheck(*fastly.DeleteHealthCheckInput) error
 
+	CreateHeader(*fastly.CreateHeaderInput) (*fastly.Header, error)
+	ListHeaders(*fastly.ListHeadersInput) ([]*fastly.Header, error)
+	GetHeader(*fastly.GetHeaderInput) (*fastly.Header, error)
+	UpdateHeader(*fastly.UpdateHeaderInput) (*fastly.Header, error)
+	DeleteHeader(*fastly.DeleteHeaderInput) error
+
+	CreateCondition(*fastly.CreateConditionInput) (*fastly.Condition, error)
+	ListConditions(*fastly.ListConditionsInput) ([]*fastly.Condition, error)
+	GetCondition(*fastly.GetConditionInput) (*fastly.Condition, error)
+	UpdateCondition(*fastly.UpdateConditionInput) (*fastly.Condition, error)
+	DeleteCondition(*fastly.DeleteConditionInput) error
+
+	CreateCacheSetting(*fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	ListCacheSettings(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	GetCacheSetting(*fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCacheSetting(*fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	DeleteCacheSetting(*fastly.DeleteCacheSettingInput) error
+
+	CreateRequestSetting(*fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	ListRequestSettings(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	GetRequestSetting(*fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateRequestSetting(*fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	DeleteRequestSetting(*fastly.DeleteRequestSettingInput) error
+
+	CreateResponseObject(*fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	ListResponseObjects(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	GetResponseObject(*fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateResponseObject(*fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	DeleteResponseObject(*fastly.DeleteResponseObjectInput) error
+
+	CreateGzip(*fastly.CreateGzipInput) (*fastly.Gzip, error)
+	ListGzips(*fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	GetGzip(*fastly.GetGzipInput) (*fastly.Gzip, error)
+	UpdateGzip(*fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	DeleteGzip(*fastly.DeleteGzipInput) error
+
+	GetSettings(*fastly.GetSettingsInput) (*fastly.Settings, error)
+	UpdateSettings(*fastly.UpdateSettingsInput) (*fastly.Settings, error)
+
 	GetPackage(*fastly.GetPackageInput) (*fastly.Package, error)
 	UpdatePackage(*fastly.UpdatePackageInput) (*fastly.Package, error)
 
@@ -225,6 +273,11 @@ type Interface interface {
 	DeleteOpenstack(*fastly.DeleteOpenstackInput) error
 
 	GetUser(*fastly.GetUserInput) (*fastly.User, error)
+	GetCurrentUser() (*fastly.User, error)
+	ListCustomerUsers(*fastly.ListCustomerUsersInput) ([]*fastly.User, error)
+	CreateUser(*fastly.CreateUserInput) (*fastly.User, error)
+	UpdateUser(*fastly.UpdateUserInput) (*fastly.User, error)
+	DeleteUser(*fastly.DeleteUserInput) error
 
 	GetRegions() (*fastly.RegionsResponse, error)
 	GetStatsJSON(*fastly.GetStatsInput, interface{}) error
@@ -268,6 +321,31 @@ type Interface interface {
 	GetNewRelic(i *fastly.GetNewRelicInput) (*fastly.NewRelic, error)
 	ListNewRelic(i *fastly.ListNewRelicInput) ([]*fastly.NewRelic, error)
 	UpdateNewRelic(i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error)
+
+	CreatePrivateKey(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error)
+	DeletePrivateKey(i *fastly.DeletePrivateKeyInput) error
+	GetPrivateKey(i *fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error)
+	ListPrivateKeys(i *fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error)
+
+	CreateCustomTLSCertificate(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	DeleteCustomTLSCertificate(i *fastly.DeleteCustomTLSCertificateInput) error
+	GetCustomTLSCertificate(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	ListCustomTLSCertificates(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error)
+	UpdateCustomTLSCertificate(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+
+	CreateTLSActivation(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error)
+	DeleteTLSActivation(i *fastly.DeleteTLSActivationInput) error
+	GetTLSActivation(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error)
+	ListTLSActivations(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error)
+	UpdateTLSActivation(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error)
+
+	CreateTLSSubscription(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	DeleteTLSSubscription(i *fastly.DeleteTLSSubscriptionInput) error
+	GetTLSSubscription(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	ListTLSSubscriptions(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error)
+	UpdateTLSSubscription(i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+
+	ListTLSDomains(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error)
 }
 
 // RealtimeStatsInterface is the subset of go-fastly's realtime stats API used here.
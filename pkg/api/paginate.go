@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/mitchellh/mapstructure"
+)
+
+// PageSize is the number of items requested per page by the ListAll
+// functions.
+const PageSize = 100
+
+// ListAllDictionaryItems returns every item in a dictionary.
+//
+// The go-fastly ListDictionaryItems function makes a single unpaged request,
+// which the API truncates to its default page size, so the pages are requested
+// directly until a short page is returned.
+func ListAllDictionaryItems(c Interface, i *fastly.ListDictionaryItemsInput) ([]*fastly.DictionaryItem, error) {
+	if i.ServiceID == "" {
+		return nil, fastly.ErrMissingServiceID
+	}
+	if i.DictionaryID == "" {
+		return nil, fastly.ErrMissingDictionaryID
+	}
+
+	var items []*fastly.DictionaryItem
+	path := fmt.Sprintf("/service/%s/dictionary/%s/items", i.ServiceID, i.DictionaryID)
+	if err := listAll(c, path, &items); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].ItemKey < items[j].ItemKey
+	})
+	return items, nil
+}
+
+// ListAllACLEntries returns every entry in an ACL.
+//
+// The go-fastly ListACLEntries function makes a single unpaged request, which
+// the API truncates to its default page size, so the pages are requested
+// directly until a short page is returned.
+func ListAllACLEntries(c Interface, i *fastly.ListACLEntriesInput) ([]*fastly.ACLEntry, error) {
+	if i.ServiceID == "" {
+		return nil, fastly.ErrMissingServiceID
+	}
+	if i.ACLID == "" {
+		return nil, fastly.ErrMissingACLID
+	}
+
+	var entries []*fastly.ACLEntry
+	path := fmt.Sprintf("/service/%s/acl/%s/entries", i.ServiceID, i.ACLID)
+	if err := listAll(c, path, &entries); err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+	return entries, nil
+}
+
+// listAll requests each page of a list endpoint and decodes the combined
+// results into out, which must be a pointer to a slice of go-fastly structs.
+func listAll(c Interface, path string, out interface{}) error {
+	var all []interface{}
+	for page := 1; ; page++ {
+		resp, err := c.Get(path, &fastly.RequestOptions{
+			Params: map[string]string{
+				"page":     strconv.Itoa(page),
+				"per_page": strconv.Itoa(PageSize),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		var results []interface{}
+		if err := decodeJSON(resp, &results); err != nil {
+			return err
+		}
+		all = append(all, results...)
+
+		if len(results) < PageSize {
+			break
+		}
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook:       mapstructure.StringToTimeHookFunc(time.RFC3339),
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(all)
+}
+
+func decodeJSON(resp *http.Response, v interface{}) error {
+	defer resp.Body.Close() // #nosec G307
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,87 @@
+package api_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestListAllDictionaryItems(t *testing.T) {
+	var want []*fastly.DictionaryItem
+	for i := 0; i < api.PageSize*2+50; i++ {
+		want = append(want, &fastly.DictionaryItem{
+			ItemKey:   fmt.Sprintf("key%03d", i),
+			ItemValue: fmt.Sprintf("value%d", i),
+		})
+	}
+
+	var pages []string
+	client := mock.API{
+		GetFn: func(path string, ro *fastly.RequestOptions) (*http.Response, error) {
+			testutil.AssertEqual(t, "/service/123/dictionary/456/items", path)
+			pages = append(pages, ro.Params["page"])
+			return testutil.PaginatedGet(want)(path, ro)
+		},
+	}
+
+	have, err := api.ListAllDictionaryItems(client, &fastly.ListDictionaryItemsInput{
+		ServiceID:    "123",
+		DictionaryID: "456",
+	})
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, []string{"1", "2", "3"}, pages)
+	testutil.AssertEqual(t, len(want), len(have))
+	for i := range want {
+		testutil.AssertEqual(t, want[i].ItemKey, have[i].ItemKey)
+		testutil.AssertEqual(t, want[i].ItemValue, have[i].ItemValue)
+	}
+}
+
+func TestListAllACLEntries(t *testing.T) {
+	deleted := testutil.MustParseTimeRFC3339("2021-06-15T23:00:00Z")
+
+	var want []*fastly.ACLEntry
+	for i := 0; i < api.PageSize; i++ {
+		want = append(want, &fastly.ACLEntry{
+			ID:     fmt.Sprintf("%03d", i),
+			IP:     fmt.Sprintf("192.0.2.%d", i),
+			Subnet: 32,
+		})
+	}
+	want[0].DeletedAt = deleted
+
+	var pages int
+	client := mock.API{
+		GetFn: func(path string, ro *fastly.RequestOptions) (*http.Response, error) {
+			pages++
+			return testutil.PaginatedGet(want)(path, ro)
+		},
+	}
+
+	have, err := api.ListAllACLEntries(client, &fastly.ListACLEntriesInput{
+		ServiceID: "123",
+		ACLID:     "456",
+	})
+	testutil.AssertNoError(t, err)
+
+	// A full last page can't be told apart from a partial one, so an empty
+	// page is requested to confirm there are no more entries.
+	testutil.AssertEqual(t, 2, pages)
+	testutil.AssertEqual(t, len(want), len(have))
+	testutil.AssertEqual(t, 32, have[1].Subnet)
+	if have[0].DeletedAt == nil || !have[0].DeletedAt.Equal(*deleted) {
+		t.Errorf("want deleted_at %s, have %v", deleted.Format(time.RFC3339), have[0].DeletedAt)
+	}
+
+	_, err = api.ListAllACLEntries(mock.API{GetFn: testutil.GetError}, &fastly.ListACLEntriesInput{
+		ServiceID: "123",
+		ACLID:     "456",
+	})
+	testutil.AssertErrorContains(t, err, testutil.Err.Error())
+}
@@ -7,18 +7,26 @@ import (
 	"io"
 	"os"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/check"
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/commands/acl"
 	"github.com/fastly/cli/pkg/commands/aclentry"
 	"github.com/fastly/cli/pkg/commands/backend"
+	"github.com/fastly/cli/pkg/commands/cachesetting"
 	"github.com/fastly/cli/pkg/commands/compute"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/commands/condition"
 	"github.com/fastly/cli/pkg/commands/configure"
+	"github.com/fastly/cli/pkg/commands/credentials"
 	"github.com/fastly/cli/pkg/commands/domain"
 	"github.com/fastly/cli/pkg/commands/edgedictionary"
 	"github.com/fastly/cli/pkg/commands/edgedictionaryitem"
+	"github.com/fastly/cli/pkg/commands/gzip"
+	"github.com/fastly/cli/pkg/commands/header"
 	"github.com/fastly/cli/pkg/commands/healthcheck"
 	"github.com/fastly/cli/pkg/commands/ip"
 	"github.com/fastly/cli/pkg/commands/logging"
@@ -50,11 +58,24 @@ import (
 	"github.com/fastly/cli/pkg/commands/logging/syslog"
 	"github.com/fastly/cli/pkg/commands/logs"
 	"github.com/fastly/cli/pkg/commands/pop"
+	"github.com/fastly/cli/pkg/commands/profile"
 	"github.com/fastly/cli/pkg/commands/purge"
+	"github.com/fastly/cli/pkg/commands/requestsetting"
+	"github.com/fastly/cli/pkg/commands/responseobject"
 	"github.com/fastly/cli/pkg/commands/service"
+	"github.com/fastly/cli/pkg/commands/serviceauth"
 	"github.com/fastly/cli/pkg/commands/serviceversion"
+	"github.com/fastly/cli/pkg/commands/serviceversion/settings"
 	"github.com/fastly/cli/pkg/commands/stats"
+	"github.com/fastly/cli/pkg/commands/tls"
+	"github.com/fastly/cli/pkg/commands/tls/activation"
+	"github.com/fastly/cli/pkg/commands/tls/certificate"
+	tlsdomain "github.com/fastly/cli/pkg/commands/tls/domain"
+	"github.com/fastly/cli/pkg/commands/tls/privatekey"
+	"github.com/fastly/cli/pkg/commands/tls/subscription"
+	"github.com/fastly/cli/pkg/commands/token"
 	"github.com/fastly/cli/pkg/commands/update"
+	"github.com/fastly/cli/pkg/commands/user"
 	"github.com/fastly/cli/pkg/commands/vcl"
 	"github.com/fastly/cli/pkg/commands/vcl/custom"
 	"github.com/fastly/cli/pkg/commands/vcl/snippet"
@@ -65,6 +86,7 @@ import (
 	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/revision"
 	"github.com/fastly/cli/pkg/text"
+	fsttime "github.com/fastly/cli/pkg/time"
 	"github.com/fastly/go-fastly/v3/fastly"
 	"github.com/fastly/kingpin"
 )
@@ -88,9 +110,15 @@ type RunOpts struct {
 	Env        config.Environment
 	ErrLog     errors.LogInterface
 	HTTPClient api.HTTPClient
+	RTSClient  RTSClientFactory
 	Stdin      io.Reader
 	Stdout     io.Writer
 	Versioners Versioners
+
+	// TokenCheck enables the check of the API token's expiry and scope before
+	// a command runs. Tests leave it disabled so that they don't need to mock
+	// the token lookup.
+	TokenCheck bool
 }
 
 // Run constructs the application including all of the subcommands, parses the
@@ -141,9 +169,13 @@ func Run(opts RunOpts) error {
 	tokenHelp := fmt.Sprintf("Fastly API token (or via %s)", env.Token)
 	app.Flag("token", tokenHelp).Short('t').StringVar(&globals.Flag.Token)
 	app.Flag("verbose", "Verbose logging").Short('v').BoolVar(&globals.Flag.Verbose)
+	app.Flag("json", "JSON output (lists are newline-delimited JSON)").BoolVar(&globals.Flag.JSON)
 	app.Flag("endpoint", "Fastly API endpoint").Hidden().StringVar(&globals.Flag.Endpoint)
+	profileHelp := fmt.Sprintf("Config profile to use (or via %s)", env.Profile)
+	app.Flag("profile", profileHelp).StringVar(&globals.Flag.Profile)
 
 	aclCmdRoot := acl.NewRootCommand(app, &globals)
+	aclCopy := acl.NewCopyCommand(aclCmdRoot.CmdClause, &globals)
 	aclCreate := acl.NewCreateCommand(aclCmdRoot.CmdClause, &globals)
 	aclDelete := acl.NewDeleteCommand(aclCmdRoot.CmdClause, &globals)
 	aclDescribe := acl.NewDescribeCommand(aclCmdRoot.CmdClause, &globals)
@@ -153,7 +185,9 @@ func Run(opts RunOpts) error {
 	aclEntryCreate := aclentry.NewCreateCommand(aclEntryCmdRoot.CmdClause, &globals)
 	aclEntryDelete := aclentry.NewDeleteCommand(aclEntryCmdRoot.CmdClause, &globals)
 	aclEntryDescribe := aclentry.NewDescribeCommand(aclEntryCmdRoot.CmdClause, &globals)
+	aclEntryExport := aclentry.NewExportCommand(aclEntryCmdRoot.CmdClause, &globals)
 	aclEntryList := aclentry.NewListCommand(aclEntryCmdRoot.CmdClause, &globals)
+	aclEntrySync := aclentry.NewSyncCommand(aclEntryCmdRoot.CmdClause, &globals)
 	aclEntryUpdate := aclentry.NewUpdateCommand(aclEntryCmdRoot.CmdClause, &globals)
 	backendCmdRoot := backend.NewRootCommand(app, &globals)
 	backendCreate := backend.NewCreateCommand(backendCmdRoot.CmdClause, &globals)
@@ -161,6 +195,12 @@ func Run(opts RunOpts) error {
 	backendDescribe := backend.NewDescribeCommand(backendCmdRoot.CmdClause, &globals)
 	backendList := backend.NewListCommand(backendCmdRoot.CmdClause, &globals)
 	backendUpdate := backend.NewUpdateCommand(backendCmdRoot.CmdClause, &globals)
+	cacheSettingCmdRoot := cachesetting.NewRootCommand(app, &globals)
+	cacheSettingCreate := cachesetting.NewCreateCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingDelete := cachesetting.NewDeleteCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingDescribe := cachesetting.NewDescribeCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingList := cachesetting.NewListCommand(cacheSettingCmdRoot.CmdClause, &globals)
+	cacheSettingUpdate := cachesetting.NewUpdateCommand(cacheSettingCmdRoot.CmdClause, &globals)
 	computeCmdRoot := compute.NewRootCommand(app, &globals)
 	computeBuild := compute.NewBuildCommand(computeCmdRoot.CmdClause, opts.HTTPClient, &globals)
 	computeDeploy := compute.NewDeployCommand(computeCmdRoot.CmdClause, opts.HTTPClient, &globals)
@@ -170,8 +210,20 @@ func Run(opts RunOpts) error {
 	computeServe := compute.NewServeCommand(computeCmdRoot.CmdClause, &globals, computeBuild, opts.Versioners.Viceroy)
 	computeUpdate := compute.NewUpdateCommand(computeCmdRoot.CmdClause, opts.HTTPClient, &globals)
 	computeValidate := compute.NewValidateCommand(computeCmdRoot.CmdClause, &globals)
+	conditionCmdRoot := condition.NewRootCommand(app, &globals)
+	conditionCreate := condition.NewCreateCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionDelete := condition.NewDeleteCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionDescribe := condition.NewDescribeCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionList := condition.NewListCommand(conditionCmdRoot.CmdClause, &globals)
+	conditionUpdate := condition.NewUpdateCommand(conditionCmdRoot.CmdClause, &globals)
 	configureCmdRoot := configure.NewRootCommand(app, opts.ConfigPath, configure.APIClientFactory(opts.APIClient), &globals)
+	credentialsCmdRoot := credentials.NewRootCommand(app, &globals)
+	credentialsAgent := credentials.NewAgentCommand(credentialsCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	credentialsLock := credentials.NewLockCommand(credentialsCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	credentialsMigrate := credentials.NewMigrateCommand(credentialsCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	credentialsUnlock := credentials.NewUnlockCommand(credentialsCmdRoot.CmdClause, opts.ConfigPath, &globals)
 	dictionaryCmdRoot := edgedictionary.NewRootCommand(app, &globals)
+	dictionaryCopy := edgedictionary.NewCopyCommand(dictionaryCmdRoot.CmdClause, &globals)
 	dictionaryCreate := edgedictionary.NewCreateCommand(dictionaryCmdRoot.CmdClause, &globals)
 	dictionaryDelete := edgedictionary.NewDeleteCommand(dictionaryCmdRoot.CmdClause, &globals)
 	dictionaryDescribe := edgedictionary.NewDescribeCommand(dictionaryCmdRoot.CmdClause, &globals)
@@ -180,7 +232,9 @@ func Run(opts RunOpts) error {
 	dictionaryItemCreate := edgedictionaryitem.NewCreateCommand(dictionaryItemCmdRoot.CmdClause, &globals)
 	dictionaryItemDelete := edgedictionaryitem.NewDeleteCommand(dictionaryItemCmdRoot.CmdClause, &globals)
 	dictionaryItemDescribe := edgedictionaryitem.NewDescribeCommand(dictionaryItemCmdRoot.CmdClause, &globals)
+	dictionaryItemExport := edgedictionaryitem.NewExportCommand(dictionaryItemCmdRoot.CmdClause, &globals)
 	dictionaryItemList := edgedictionaryitem.NewListCommand(dictionaryItemCmdRoot.CmdClause, &globals)
+	dictionaryItemSync := edgedictionaryitem.NewSyncCommand(dictionaryItemCmdRoot.CmdClause, &globals)
 	dictionaryItemUpdate := edgedictionaryitem.NewUpdateCommand(dictionaryItemCmdRoot.CmdClause, &globals)
 	dictionaryList := edgedictionary.NewListCommand(dictionaryCmdRoot.CmdClause, &globals)
 	dictionaryUpdate := edgedictionary.NewUpdateCommand(dictionaryCmdRoot.CmdClause, &globals)
@@ -190,6 +244,18 @@ func Run(opts RunOpts) error {
 	domainDescribe := domain.NewDescribeCommand(domainCmdRoot.CmdClause, &globals)
 	domainList := domain.NewListCommand(domainCmdRoot.CmdClause, &globals)
 	domainUpdate := domain.NewUpdateCommand(domainCmdRoot.CmdClause, &globals)
+	gzipCmdRoot := gzip.NewRootCommand(app, &globals)
+	gzipCreate := gzip.NewCreateCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipDelete := gzip.NewDeleteCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipDescribe := gzip.NewDescribeCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipList := gzip.NewListCommand(gzipCmdRoot.CmdClause, &globals)
+	gzipUpdate := gzip.NewUpdateCommand(gzipCmdRoot.CmdClause, &globals)
+	headerCmdRoot := header.NewRootCommand(app, &globals)
+	headerCreate := header.NewCreateCommand(headerCmdRoot.CmdClause, &globals)
+	headerDelete := header.NewDeleteCommand(headerCmdRoot.CmdClause, &globals)
+	headerDescribe := header.NewDescribeCommand(headerCmdRoot.CmdClause, &globals)
+	headerList := header.NewListCommand(headerCmdRoot.CmdClause, &globals)
+	headerUpdate := header.NewUpdateCommand(headerCmdRoot.CmdClause, &globals)
 	healthcheckCmdRoot := healthcheck.NewRootCommand(app, &globals)
 	healthcheckCreate := healthcheck.NewCreateCommand(healthcheckCmdRoot.CmdClause, &globals)
 	healthcheckDelete := healthcheck.NewDeleteCommand(healthcheckCmdRoot.CmdClause, &globals)
@@ -357,26 +423,94 @@ func Run(opts RunOpts) error {
 	logsCmdRoot := logs.NewRootCommand(app, &globals)
 	logsTail := logs.NewTailCommand(logsCmdRoot.CmdClause, &globals)
 	popCmdRoot := pop.NewRootCommand(app, &globals)
+	profileCmdRoot := profile.NewRootCommand(app, &globals)
+	profileCreate := profile.NewCreateCommand(profileCmdRoot.CmdClause, opts.ConfigPath, configure.APIClientFactory(opts.APIClient), &globals)
+	profileDelete := profile.NewDeleteCommand(profileCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	profileList := profile.NewListCommand(profileCmdRoot.CmdClause, &globals)
+	profileSwitch := profile.NewSwitchCommand(profileCmdRoot.CmdClause, opts.ConfigPath, &globals)
+	profileUpdate := profile.NewUpdateCommand(profileCmdRoot.CmdClause, opts.ConfigPath, configure.APIClientFactory(opts.APIClient), &globals)
 	purgeCmdRoot := purge.NewRootCommand(app, &globals)
+	requestSettingCmdRoot := requestsetting.NewRootCommand(app, &globals)
+	requestSettingCreate := requestsetting.NewCreateCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingDelete := requestsetting.NewDeleteCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingDescribe := requestsetting.NewDescribeCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingList := requestsetting.NewListCommand(requestSettingCmdRoot.CmdClause, &globals)
+	requestSettingUpdate := requestsetting.NewUpdateCommand(requestSettingCmdRoot.CmdClause, &globals)
+	responseObjectCmdRoot := responseobject.NewRootCommand(app, &globals)
+	responseObjectCreate := responseobject.NewCreateCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectDelete := responseobject.NewDeleteCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectDescribe := responseobject.NewDescribeCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectList := responseobject.NewListCommand(responseObjectCmdRoot.CmdClause, &globals)
+	responseObjectUpdate := responseobject.NewUpdateCommand(responseObjectCmdRoot.CmdClause, &globals)
 	serviceCmdRoot := service.NewRootCommand(app, &globals)
+	serviceApply := service.NewApplyCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceCreate := service.NewCreateCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceDelete := service.NewDeleteCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceDescribe := service.NewDescribeCommand(serviceCmdRoot.CmdClause, &globals)
+	serviceExport := service.NewExportCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceList := service.NewListCommand(serviceCmdRoot.CmdClause, &globals)
+	servicePlan := service.NewPlanCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceSearch := service.NewSearchCommand(serviceCmdRoot.CmdClause, &globals)
 	serviceUpdate := service.NewUpdateCommand(serviceCmdRoot.CmdClause, &globals)
+	serviceAuthCmdRoot := serviceauth.NewRootCommand(app, &globals)
+	serviceAuthCreate := serviceauth.NewCreateCommand(serviceAuthCmdRoot.CmdClause, opts.HTTPClient, &globals)
+	serviceAuthDelete := serviceauth.NewDeleteCommand(serviceAuthCmdRoot.CmdClause, opts.HTTPClient, &globals)
+	serviceAuthDescribe := serviceauth.NewDescribeCommand(serviceAuthCmdRoot.CmdClause, opts.HTTPClient, &globals)
+	serviceAuthList := serviceauth.NewListCommand(serviceAuthCmdRoot.CmdClause, opts.HTTPClient, &globals)
+	serviceAuthUpdate := serviceauth.NewUpdateCommand(serviceAuthCmdRoot.CmdClause, opts.HTTPClient, &globals)
 	serviceVersionCmdRoot := serviceversion.NewRootCommand(app, &globals)
 	serviceVersionActivate := serviceversion.NewActivateCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionClone := serviceversion.NewCloneCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionDeactivate := serviceversion.NewDeactivateCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	serviceVersionDiff := serviceversion.NewDiffCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionList := serviceversion.NewListCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	serviceVersionLock := serviceversion.NewLockCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	serviceVersionRollback := serviceversion.NewRollbackCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	serviceVersionSettingsCmdRoot := settings.NewRootCommand(serviceVersionCmdRoot.CmdClause, &globals)
+	serviceVersionSettingsDescribe := settings.NewDescribeCommand(serviceVersionSettingsCmdRoot.CmdClause, &globals)
+	serviceVersionSettingsUpdate := settings.NewUpdateCommand(serviceVersionSettingsCmdRoot.CmdClause, &globals)
 	serviceVersionUpdate := serviceversion.NewUpdateCommand(serviceVersionCmdRoot.CmdClause, &globals)
 	statsCmdRoot := stats.NewRootCommand(app, &globals)
 	statsHistorical := stats.NewHistoricalCommand(statsCmdRoot.CmdClause, &globals)
 	statsRealtime := stats.NewRealtimeCommand(statsCmdRoot.CmdClause, &globals)
 	statsRegions := stats.NewRegionsCommand(statsCmdRoot.CmdClause, &globals)
+	tlsCmdRoot := tls.NewRootCommand(app, &globals)
+	tlsActivationCmdRoot := activation.NewRootCommand(tlsCmdRoot.CmdClause, &globals)
+	tlsActivationCreate := activation.NewCreateCommand(tlsActivationCmdRoot.CmdClause, &globals)
+	tlsActivationDelete := activation.NewDeleteCommand(tlsActivationCmdRoot.CmdClause, &globals)
+	tlsActivationDescribe := activation.NewDescribeCommand(tlsActivationCmdRoot.CmdClause, &globals)
+	tlsActivationList := activation.NewListCommand(tlsActivationCmdRoot.CmdClause, &globals)
+	tlsActivationUpdate := activation.NewUpdateCommand(tlsActivationCmdRoot.CmdClause, &globals)
+	tlsCertificateCmdRoot := certificate.NewRootCommand(tlsCmdRoot.CmdClause, &globals)
+	tlsCertificateCreate := certificate.NewCreateCommand(tlsCertificateCmdRoot.CmdClause, &globals)
+	tlsCertificateDelete := certificate.NewDeleteCommand(tlsCertificateCmdRoot.CmdClause, &globals)
+	tlsCertificateDescribe := certificate.NewDescribeCommand(tlsCertificateCmdRoot.CmdClause, &globals)
+	tlsCertificateList := certificate.NewListCommand(tlsCertificateCmdRoot.CmdClause, &globals)
+	tlsCertificateUpdate := certificate.NewUpdateCommand(tlsCertificateCmdRoot.CmdClause, &globals)
+	tlsDomainCmdRoot := tlsdomain.NewRootCommand(tlsCmdRoot.CmdClause, &globals)
+	tlsDomainList := tlsdomain.NewListCommand(tlsDomainCmdRoot.CmdClause, &globals)
+	tlsPrivateKeyCmdRoot := privatekey.NewRootCommand(tlsCmdRoot.CmdClause, &globals)
+	tlsPrivateKeyCreate := privatekey.NewCreateCommand(tlsPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsPrivateKeyDelete := privatekey.NewDeleteCommand(tlsPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsPrivateKeyDescribe := privatekey.NewDescribeCommand(tlsPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsPrivateKeyList := privatekey.NewListCommand(tlsPrivateKeyCmdRoot.CmdClause, &globals)
+	tlsSubscriptionCmdRoot := subscription.NewRootCommand(tlsCmdRoot.CmdClause, &globals)
+	tlsSubscriptionCreate := subscription.NewCreateCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tlsSubscriptionDelete := subscription.NewDeleteCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tlsSubscriptionDescribe := subscription.NewDescribeCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tlsSubscriptionList := subscription.NewListCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tlsSubscriptionUpdate := subscription.NewUpdateCommand(tlsSubscriptionCmdRoot.CmdClause, &globals)
+	tokenCmdRoot := token.NewRootCommand(app, &globals)
+	tokenCreate := token.NewCreateCommand(tokenCmdRoot.CmdClause, &globals)
+	tokenDelete := token.NewDeleteCommand(tokenCmdRoot.CmdClause, &globals)
+	tokenList := token.NewListCommand(tokenCmdRoot.CmdClause, &globals)
 	updateRoot := update.NewRootCommand(app, opts.ConfigPath, opts.Versioners.CLI, opts.HTTPClient, &globals)
+	userCmdRoot := user.NewRootCommand(app, &globals)
+	userCreate := user.NewCreateCommand(userCmdRoot.CmdClause, &globals)
+	userDelete := user.NewDeleteCommand(userCmdRoot.CmdClause, &globals)
+	userDescribe := user.NewDescribeCommand(userCmdRoot.CmdClause, &globals)
+	userList := user.NewListCommand(userCmdRoot.CmdClause, &globals)
+	userUpdate := user.NewUpdateCommand(userCmdRoot.CmdClause, &globals)
 	vclCmdRoot := vcl.NewRootCommand(app, &globals)
 	vclCustomCmdRoot := custom.NewRootCommand(vclCmdRoot.CmdClause, &globals)
 	vclCustomCreate := custom.NewCreateCommand(vclCustomCmdRoot.CmdClause, &globals)
@@ -389,12 +523,14 @@ func Run(opts RunOpts) error {
 	vclSnippetDelete := snippet.NewDeleteCommand(vclSnippetCmdRoot.CmdClause, &globals)
 	vclSnippetDescribe := snippet.NewDescribeCommand(vclSnippetCmdRoot.CmdClause, &globals)
 	vclSnippetList := snippet.NewListCommand(vclSnippetCmdRoot.CmdClause, &globals)
+	vclSnippetSync := snippet.NewSyncCommand(vclSnippetCmdRoot.CmdClause, &globals)
 	vclSnippetUpdate := snippet.NewUpdateCommand(vclSnippetCmdRoot.CmdClause, &globals)
 	versionCmdRoot := version.NewRootCommand(app)
 	whoamiCmdRoot := whoami.NewRootCommand(app, opts.HTTPClient, &globals)
 
 	commands := []cmd.Command{
 		aclCmdRoot,
+		aclCopy,
 		aclCreate,
 		aclDelete,
 		aclDescribe,
@@ -404,7 +540,9 @@ func Run(opts RunOpts) error {
 		aclEntryCreate,
 		aclEntryDelete,
 		aclEntryDescribe,
+		aclEntryExport,
 		aclEntryList,
+		aclEntrySync,
 		aclEntryUpdate,
 		backendCmdRoot,
 		backendCreate,
@@ -413,6 +551,12 @@ func Run(opts RunOpts) error {
 		backendList,
 		backendUpdate,
 		computeBuild,
+		cacheSettingCmdRoot,
+		cacheSettingCreate,
+		cacheSettingDelete,
+		cacheSettingDescribe,
+		cacheSettingList,
+		cacheSettingUpdate,
 		computeCmdRoot,
 		computeDeploy,
 		computeInit,
@@ -421,8 +565,20 @@ func Run(opts RunOpts) error {
 		computeServe,
 		computeUpdate,
 		computeValidate,
+		conditionCmdRoot,
+		conditionCreate,
+		conditionDelete,
+		conditionDescribe,
+		conditionList,
+		conditionUpdate,
 		configureCmdRoot,
+		credentialsCmdRoot,
+		credentialsAgent,
+		credentialsLock,
+		credentialsMigrate,
+		credentialsUnlock,
 		dictionaryCmdRoot,
+		dictionaryCopy,
 		dictionaryCreate,
 		dictionaryDelete,
 		dictionaryDescribe,
@@ -431,7 +587,9 @@ func Run(opts RunOpts) error {
 		dictionaryItemCreate,
 		dictionaryItemDelete,
 		dictionaryItemDescribe,
+		dictionaryItemExport,
 		dictionaryItemList,
+		dictionaryItemSync,
 		dictionaryItemUpdate,
 		dictionaryList,
 		dictionaryUpdate,
@@ -441,6 +599,18 @@ func Run(opts RunOpts) error {
 		domainDescribe,
 		domainList,
 		domainUpdate,
+		gzipCmdRoot,
+		gzipCreate,
+		gzipDelete,
+		gzipDescribe,
+		gzipList,
+		gzipUpdate,
+		headerCmdRoot,
+		headerCreate,
+		headerDelete,
+		headerDescribe,
+		headerList,
+		headerUpdate,
 		healthcheckCmdRoot,
 		healthcheckCreate,
 		healthcheckDelete,
@@ -608,26 +778,94 @@ func Run(opts RunOpts) error {
 		logsCmdRoot,
 		logsTail,
 		popCmdRoot,
+		profileCmdRoot,
+		profileCreate,
+		profileDelete,
+		profileList,
+		profileSwitch,
+		profileUpdate,
 		purgeCmdRoot,
+		requestSettingCmdRoot,
+		requestSettingCreate,
+		requestSettingDelete,
+		requestSettingDescribe,
+		requestSettingList,
+		requestSettingUpdate,
+		responseObjectCmdRoot,
+		responseObjectCreate,
+		responseObjectDelete,
+		responseObjectDescribe,
+		responseObjectList,
+		responseObjectUpdate,
 		serviceCmdRoot,
+		serviceApply,
 		serviceCreate,
 		serviceDelete,
 		serviceDescribe,
+		serviceExport,
 		serviceList,
+		servicePlan,
 		serviceSearch,
 		serviceUpdate,
+		serviceAuthCmdRoot,
+		serviceAuthCreate,
+		serviceAuthDelete,
+		serviceAuthDescribe,
+		serviceAuthList,
+		serviceAuthUpdate,
 		serviceVersionActivate,
 		serviceVersionClone,
 		serviceVersionCmdRoot,
 		serviceVersionDeactivate,
+		serviceVersionDiff,
 		serviceVersionList,
 		serviceVersionLock,
+		serviceVersionRollback,
+		serviceVersionSettingsCmdRoot,
+		serviceVersionSettingsDescribe,
+		serviceVersionSettingsUpdate,
 		serviceVersionUpdate,
 		statsCmdRoot,
 		statsHistorical,
 		statsRealtime,
 		statsRegions,
+		tlsCmdRoot,
+		tlsActivationCmdRoot,
+		tlsActivationCreate,
+		tlsActivationDelete,
+		tlsActivationDescribe,
+		tlsActivationList,
+		tlsActivationUpdate,
+		tlsCertificateCmdRoot,
+		tlsCertificateCreate,
+		tlsCertificateDelete,
+		tlsCertificateDescribe,
+		tlsCertificateList,
+		tlsCertificateUpdate,
+		tlsDomainCmdRoot,
+		tlsDomainList,
+		tlsPrivateKeyCmdRoot,
+		tlsPrivateKeyCreate,
+		tlsPrivateKeyDelete,
+		tlsPrivateKeyDescribe,
+		tlsPrivateKeyList,
+		tlsSubscriptionCmdRoot,
+		tlsSubscriptionCreate,
+		tlsSubscriptionDelete,
+		tlsSubscriptionDescribe,
+		tlsSubscriptionList,
+		tlsSubscriptionUpdate,
+		tokenCmdRoot,
+		tokenCreate,
+		tokenDelete,
+		tokenList,
 		updateRoot,
+		userCmdRoot,
+		userCreate,
+		userDelete,
+		userDescribe,
+		userList,
+		userUpdate,
 		vclCmdRoot,
 		vclCustomCmdRoot,
 		vclCustomCreate,
@@ -640,6 +878,7 @@ func Run(opts RunOpts) error {
 		vclSnippetDelete,
 		vclSnippetDescribe,
 		vclSnippetList,
+		vclSnippetSync,
 		vclSnippetUpdate,
 		versionCmdRoot,
 		whoamiCmdRoot,
@@ -704,15 +943,82 @@ func Run(opts RunOpts) error {
 		return errors.RemediationError{Prefix: buf.String()}
 	}
 
-	token, source := globals.Token()
-	if globals.Verbose() {
+	// A profile that was explicitly requested must exist, otherwise we would
+	// silently fall back to a different set of credentials. The profile
+	// subcommands are exempt so that the situation can be resolved.
+	profileName, source := globals.Profile()
+	if source == config.SourceFlag || source == config.SourceEnvironment {
+		if _, ok := globals.File.Profiles[profileName]; !ok && !isCommand(name, "profile") {
+			err := errors.RemediationError{
+				Inner:       fmt.Errorf("profile '%s' does not exist", profileName),
+				Remediation: errors.ProfileRemediation,
+			}
+			globals.ErrLog.Add(err)
+			return err
+		}
+	}
+
+	// The sources of the CLI's configuration are reported with --verbose,
+	// unless --json is set, as they would precede (and so invalidate) the JSON
+	// output.
+	verbose := globals.Verbose() && !globals.JSON()
+	if verbose {
+		switch source {
+		case config.SourceFlag:
+			fmt.Fprintf(opts.Stdout, "Fastly CLI profile provided via --profile: %s\n", profileName)
+		case config.SourceEnvironment:
+			fmt.Fprintf(opts.Stdout, "Fastly CLI profile provided via %s: %s\n", env.Profile, profileName)
+		case config.SourceFile:
+			fmt.Fprintf(opts.Stdout, "Fastly CLI profile (default via config file): %s\n", profileName)
+		}
+	}
+
+	// The --env flag is defined per command, as only those commands that read
+	// the fastly.toml manifest accept it.
+	if verbose {
+		ctx, _ := app.ParseContext(opts.Args)
+		if e := flagValue(ctx, "env"); e != "" {
+			fmt.Fprintf(opts.Stdout, "Fastly manifest environment provided via --env: %s (%s)\n", e, manifest.EnvFilename(e))
+		}
+	}
+
+	// A token that is provided by the token helper, or that has been moved into
+	// the encrypted credential store, can only be used once it's resolved.
+	// Commands that manage configuration don't need a token, and so it's not
+	// resolved for them at all: they're left usable if the token helper fails
+	// or the store is locked, and the token helper can't prompt for input.
+	var token string
+	source = config.SourceUndefined
+	if requiresCredentials(name) {
+		if err := globals.TokenError(); err != nil {
+			globals.ErrLog.Add(err)
+			return errors.RemediationError{
+				Inner:       err,
+				Remediation: "Check the token_helper command in the config file (`fastly configure --display`) runs successfully and outputs a token.",
+			}
+		}
+		if globals.TokenStored() {
+			globals.Credentials, err = credentials.Unlock(opts.Stdin, opts.Stdout, opts.ConfigPath, &globals)
+			if err != nil {
+				return err
+			}
+		}
+		token, source = globals.Token()
+	}
+	if verbose && requiresCredentials(name) {
 		switch source {
 		case config.SourceFlag:
 			fmt.Fprintf(opts.Stdout, "Fastly API token provided via --token\n")
 		case config.SourceEnvironment:
 			fmt.Fprintf(opts.Stdout, "Fastly API token provided via %s\n", env.Token)
+		case config.SourceTokenHelper:
+			fmt.Fprintf(opts.Stdout, "Fastly API token provided via token helper\n")
 		case config.SourceFile:
-			fmt.Fprintf(opts.Stdout, "Fastly API token provided via config file\n")
+			if globals.TokenStored() {
+				fmt.Fprintf(opts.Stdout, "Fastly API token provided via credential store\n")
+			} else {
+				fmt.Fprintf(opts.Stdout, "Fastly API token provided via config file\n")
+			}
 		default:
 			fmt.Fprintf(opts.Stdout, "Fastly API token not provided\n")
 		}
@@ -721,7 +1027,7 @@ func Run(opts RunOpts) error {
 	// If we are using the token from config file, check the files permissions
 	// to assert if they are not too open or have been altered outside of the
 	// application and warn if so.
-	if source == config.SourceFile && name != "configure" {
+	if source == config.SourceFile && name != "configure" && !isCommand(name, "profile") {
 		if fi, err := os.Stat(config.FilePath); err == nil {
 			if mode := fi.Mode().Perm(); mode > config.FilePermissions {
 				text.Warning(opts.Stdout, "Unprotected configuration file.")
@@ -733,7 +1039,7 @@ func Run(opts RunOpts) error {
 	}
 
 	endpoint, source := globals.Endpoint()
-	if globals.Verbose() {
+	if verbose {
 		switch source {
 		case config.SourceEnvironment:
 			fmt.Fprintf(opts.Stdout, "Fastly API endpoint (via %s): %s\n", env.Endpoint, endpoint)
@@ -750,7 +1056,7 @@ func Run(opts RunOpts) error {
 		return fmt.Errorf("error constructing Fastly API client: %w", err)
 	}
 
-	globals.RTSClient, err = fastly.NewRealtimeStatsClientForEndpoint(token, fastly.DefaultRealtimeStatsEndpoint)
+	globals.RTSClient, err = opts.RTSClient(token, fastly.DefaultRealtimeStatsEndpoint)
 	if err != nil {
 		globals.ErrLog.Add(err)
 		return fmt.Errorf("error constructing Fastly realtime stats client: %w", err)
@@ -762,6 +1068,14 @@ func Run(opts RunOpts) error {
 		return errors.RemediationError{Prefix: usage, Inner: fmt.Errorf("command not found")}
 	}
 
+	// Inspect the token up front so that a command which is bound to fail part
+	// way through, because the token is about to expire or lacks the required
+	// scope, is flagged before it makes any changes.
+	if opts.TokenCheck && token != "" && !globals.JSON() {
+		ctx, _ := app.ParseContext(opts.Args)
+		checkToken(opts.Stdout, &globals, opts.ConfigPath, token, name, serviceID(ctx))
+	}
+
 	if opts.Versioners.CLI != nil && name != "update" && !version.IsPreRelease(revision.AppVersion) {
 		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
 		defer cancel() // push cancel on the defer stack first...
@@ -786,6 +1100,18 @@ func FastlyAPIClient(token, endpoint string) (api.Interface, error) {
 	return client, err
 }
 
+// RTSClientFactory creates a Fastly real-time stats client (modeled as an
+// api.RealtimeStatsInterface) from a user-provided API token. Like
+// APIClientFactory, it exists so that tests can provide a mock.
+type RTSClientFactory func(token, endpoint string) (api.RealtimeStatsInterface, error)
+
+// FastlyRTSClient is a RTSClientFactory that returns a real Fastly real-time
+// stats client using the provided token and endpoint.
+func FastlyRTSClient(token, endpoint string) (api.RealtimeStatsInterface, error) {
+	client, err := fastly.NewRealtimeStatsClientForEndpoint(token, endpoint)
+	return client, err
+}
+
 // contextHasHelpFlag asserts whether a given kingpin.ParseContext contains a
 // `help` flag.
 func contextHasHelpFlag(ctx *kingpin.ParseContext) bool {
@@ -793,6 +1119,133 @@ func contextHasHelpFlag(ctx *kingpin.ParseContext) bool {
 	return ok
 }
 
+// requiresCredentials reports whether the named command should unlock the
+// credential store before it runs.
+func requiresCredentials(name string) bool {
+	for _, command := range []string{"configure", "credentials", "profile", "version"} {
+		if isCommand(name, command) {
+			return false
+		}
+	}
+	return true
+}
+
+// isCommand reports whether name is the command or one of its subcommands.
+func isCommand(name, command string) bool {
+	return name == command || strings.HasPrefix(name, command+" ")
+}
+
+// flagValue returns the value of the named flag, if it was provided.
+func flagValue(ctx *kingpin.ParseContext, name string) string {
+	if ctx == nil {
+		return ""
+	}
+	if e, ok := ctx.Elements.FlagMap()[name]; ok && e.Value != nil {
+		return *e.Value
+	}
+	return ""
+}
+
+// serviceID returns the service the selected command will act on, from the
+// same sources as manifest.Data.ServiceID(), or an empty string if the command
+// doesn't take a service.
+func serviceID(ctx *kingpin.ParseContext) string {
+	if ctx == nil || ctx.SelectedCommand == nil || ctx.SelectedCommand.GetFlag("service-id") == nil {
+		return ""
+	}
+
+	var m manifest.Data
+	m.Flag.ServiceID = flagValue(ctx, "service-id")
+	m.File.SetOutput(io.Discard)
+	m.File.Read(manifest.Filename)
+	if env := flagValue(ctx, "env"); env != "" {
+		if err := m.File.ReadEnv(env); err != nil {
+			return ""
+		}
+	}
+	serviceID, _ := m.ServiceID()
+	return serviceID
+}
+
+// checkToken warns if the API token in use expires within the configured
+// window, or if its scope or services don't allow the named command to run.
+//
+// NOTE: the check is advisory, so failing to look up the token isn't an error
+// and the command is left to report any problem itself.
+func checkToken(out io.Writer, globals *config.Data, configPath, apiToken, name, serviceID string) {
+	required := token.RequiredScopes(name)
+	if required == nil {
+		return
+	}
+
+	t, err := lookupToken(globals, configPath, apiToken)
+	if err != nil {
+		if globals.Verbose() {
+			fmt.Fprintf(out, "Unable to inspect the Fastly API token: %v\n", err)
+		}
+		return
+	}
+
+	if token.ExpiresWithin(t.ExpiresAt, globals.TokenExpiryWarning(), time.Now()) {
+		text.Warning(out, "Fastly API token expires soon.")
+		fmt.Fprintf(out, "The token '%s' expires at %s (UTC).\n", t.Name, t.ExpiresAt.UTC().Format(fsttime.Format))
+		fmt.Fprintf(out, "Create a replacement with `fastly token create` before then.\n")
+		fmt.Fprintln(out)
+	}
+
+	if !token.HasScope(t.Scope, required) {
+		scopes := make([]string, 0, len(required)+1)
+		for _, s := range required {
+			scopes = append(scopes, fmt.Sprintf("'%s'", s))
+		}
+		if required[0] != fastly.GlobalScope {
+			scopes = append(scopes, fmt.Sprintf("'%s'", fastly.GlobalScope))
+		}
+		text.Warning(out, "Insufficient Fastly API token scope.")
+		fmt.Fprintf(out, "The token '%s' has scope '%s' but 'fastly %s' requires %s.\n", t.Name, t.Scope, name, strings.Join(scopes, " or "))
+		fmt.Fprintf(out, "The command is likely to fail with a permissions error.\n")
+		fmt.Fprintln(out)
+	}
+
+	if !token.HasService(t, serviceID) {
+		text.Warning(out, "Fastly API token is limited to other services.")
+		fmt.Fprintf(out, "The token '%s' can't access service '%s' (it's limited to: %s).\n", t.Name, serviceID, strings.Join(t.Services, ", "))
+		fmt.Fprintln(out)
+	}
+}
+
+// lookupToken returns the details of the API token in use. They're cached in
+// the config file for config.TokenCheckTTL, so that the token isn't looked up
+// on every command.
+func lookupToken(globals *config.Data, configPath, apiToken string) (*fastly.Token, error) {
+	key := config.TokenFingerprint(apiToken)
+	if c, ok := globals.File.TokenChecks[key]; ok && !check.Stale(c.LastChecked, config.TokenCheckTTL) {
+		return c.Token(), nil
+	}
+
+	t, err := globals.Client.GetTokenSelf()
+	if err != nil {
+		return nil, err
+	}
+
+	// Drop the details of any other tokens that are no longer fresh, so that
+	// tokens which have since been replaced don't accumulate.
+	for k, c := range globals.File.TokenChecks {
+		if check.Stale(c.LastChecked, config.TokenCheckTTL) {
+			delete(globals.File.TokenChecks, k)
+		}
+	}
+	if globals.File.TokenChecks == nil {
+		globals.File.TokenChecks = make(map[string]*config.TokenCheck)
+	}
+	globals.File.TokenChecks[key] = config.NewTokenCheck(t)
+	if err := globals.File.Write(configPath); err != nil {
+		globals.ErrLog.Add(err)
+	}
+
+	return t, nil
+}
+
 // argsIsHelpJSON determines whether the supplied command arguments are exactly
 // `help --format json`.
 func argsIsHelpJSON(args []string) bool {
@@ -3,12 +3,19 @@ package app_test
 import (
 	"bufio"
 	"bytes"
+	"io"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
 )
 
 func TestApplication(t *testing.T) {
@@ -56,6 +63,372 @@ func TestApplication(t *testing.T) {
 	}
 }
 
+func TestTokenCheck(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name           string
+		args           []string
+		token          func() (*fastly.Token, error)
+		wantOutput     string
+		dontWantOutput string
+	}{
+		{
+			name:           "global token",
+			args:           args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123"),
+			token:          testutil.GetTokenSelf,
+			dontWantOutput: "WARNING",
+		},
+		{
+			name:       "insufficient scope",
+			args:       args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123"),
+			token:      tokenSelf(fastly.PurgeSelectScope, nil, nil),
+			wantOutput: "The token 'Foo' has scope 'purge_select' but 'fastly backend create' requires 'global'.",
+		},
+		{
+			name:       "expiring token",
+			args:       args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123"),
+			token:      tokenSelf(fastly.GlobalScope, nil, expiresIn(24*time.Hour)),
+			wantOutput: "Fastly API token expires soon.",
+		},
+		{
+			name:           "token not yet expiring",
+			args:           args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123"),
+			token:          tokenSelf(fastly.GlobalScope, nil, expiresIn(30*24*time.Hour)),
+			dontWantOutput: "WARNING",
+		},
+		{
+			name:       "other services",
+			args:       args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123"),
+			token:      tokenSelf(fastly.GlobalScope, []string{"456"}, nil),
+			wantOutput: "The token 'Foo' can't access service '123' (it's limited to: 456).",
+		},
+		{
+			name:           "json output",
+			args:           args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123 --json"),
+			token:          tokenSelf(fastly.PurgeSelectScope, nil, nil),
+			dontWantOutput: "WARNING",
+		},
+		{
+			name:           "local command",
+			args:           args("version --token 123"),
+			token:          tokenSelf(fastly.PurgeSelectScope, nil, nil),
+			dontWantOutput: "WARNING",
+		},
+		{
+			name: "token lookup fails",
+			args: args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123 -v"),
+			token: func() (*fastly.Token, error) {
+				return nil, testutil.Err
+			},
+			wantOutput:     "Unable to inspect the Fastly API token: test error",
+			dontWantOutput: "WARNING",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				GetTokenSelfFn: testcase.token,
+				ListVersionsFn: testutil.ListVersionsError,
+			})
+			opts.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+			opts.TokenCheck = true
+			app.Run(opts)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			if testcase.dontWantOutput != "" && strings.Contains(stdout.String(), testcase.dontWantOutput) {
+				t.Errorf("unexpected %q in output:\n%s", testcase.dontWantOutput, stdout.String())
+			}
+		})
+	}
+}
+
+func TestTokenCheckManifest(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name          string
+		args          []string
+		wantServiceID string
+	}{
+		{
+			name:          "base manifest",
+			args:          args("service-version list --token 123"),
+			wantServiceID: "base",
+		},
+		{
+			name:          "environment manifest",
+			args:          args("service-version list --env stage --token 123"),
+			wantServiceID: "stage",
+		},
+		{
+			name:          "flag overrides manifest",
+			args:          args("service-version list --env stage --service-id 123 --token 123"),
+			wantServiceID: "123",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T: t,
+				Write: []testutil.FileIO{
+					{Src: "manifest_version = 1\nname = \"test\"\nservice_id = \"base\"\n", Dst: "fastly.toml"},
+					{Src: "service_id = \"stage\"\n", Dst: "fastly.stage.toml"},
+				},
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(pwd)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				GetTokenSelfFn: tokenSelf(fastly.GlobalScope, []string{"other"}, nil),
+				ListVersionsFn: testutil.ListVersions,
+			})
+			opts.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+			opts.TokenCheck = true
+			testutil.AssertNoError(t, app.Run(opts))
+			testutil.AssertStringContains(t, stdout.String(), "The token 'Foo' can't access service '"+testcase.wantServiceID+"' (it's limited to: other).")
+		})
+	}
+}
+
+func TestTokenCheckCache(t *testing.T) {
+	key := config.TokenFingerprint("123")
+	for _, testcase := range []struct {
+		name        string
+		cached      *config.TokenCheck
+		wantLookups int
+		wantOutput  string
+	}{
+		{
+			name:        "nothing cached",
+			wantLookups: 1,
+			wantOutput:  "The token 'Foo' has scope 'purge_select'",
+		},
+		{
+			name: "fresh details",
+			cached: &config.TokenCheck{
+				Name:        "Cached",
+				Scope:       string(fastly.PurgeSelectScope),
+				LastChecked: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			},
+			wantOutput: "The token 'Cached' has scope 'purge_select'",
+		},
+		{
+			name: "stale details",
+			cached: &config.TokenCheck{
+				Name:        "Cached",
+				Scope:       string(fastly.GlobalScope),
+				LastChecked: time.Now().Add(-48 * time.Hour).Format(time.RFC3339),
+			},
+			wantLookups: 1,
+			wantOutput:  "The token 'Foo' has scope 'purge_select'",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var lookups int
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("backend create --service-id 123 --version 1 --name www.test.com --address 127.0.0.1 --token 123"), &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				GetTokenSelfFn: func() (*fastly.Token, error) {
+					lookups++
+					return tokenSelf(fastly.PurgeSelectScope, nil, nil)()
+				},
+				ListVersionsFn: testutil.ListVersionsError,
+			})
+			if testcase.cached != nil {
+				opts.ConfigFile = config.File{
+					TokenChecks: map[string]*config.TokenCheck{
+						key:     testcase.cached,
+						"other": {LastChecked: time.Now().Add(-48 * time.Hour).Format(time.RFC3339)},
+					},
+				}
+			}
+			opts.ConfigPath = filepath.Join(t.TempDir(), "config.toml")
+			opts.TokenCheck = true
+			app.Run(opts)
+			testutil.AssertEqual(t, testcase.wantLookups, lookups)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+
+			if testcase.wantLookups == 0 {
+				return
+			}
+			var file config.File
+			testutil.AssertNoError(t, file.Read(opts.ConfigPath, strings.NewReader(""), io.Discard))
+			testutil.AssertEqual(t, 1, len(file.TokenChecks))
+			c, ok := file.TokenChecks[key]
+			if !ok {
+				t.Fatalf("want token details cached under %q, have %v", key, file.TokenChecks)
+			}
+			testutil.AssertString(t, "Foo", c.Name)
+			testutil.AssertString(t, string(fastly.PurgeSelectScope), c.Scope)
+		})
+	}
+}
+
+func TestTokenHelper(t *testing.T) {
+	args := testutil.Args
+
+	// The marker file is created if the helper is ever run by a command that
+	// doesn't use a token.
+	marker := filepath.Join(t.TempDir(), "helper-ran")
+
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		helper     string
+		wantError  string
+		wantOutput string
+	}{
+		{
+			name:       "token from helper",
+			args:       args("pops -v"),
+			helper:     "echo helper-token",
+			wantOutput: "Fastly API token provided via token helper",
+		},
+		{
+			name:      "helper fails",
+			args:      args("pops"),
+			helper:    "false",
+			wantError: "error running token helper 'false'",
+		},
+		{
+			name:   "configuration commands ignore a failing helper",
+			args:   args("profile list"),
+			helper: "false",
+		},
+		{
+			name:   "version doesn't run the helper",
+			args:   args("version -v"),
+			helper: "touch " + marker,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				AllDatacentersFn: func() ([]fastly.Datacenter, error) { return nil, nil },
+			})
+			opts.ConfigFile = config.File{User: config.User{TokenHelper: testcase.helper}}
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			if _, err := os.Stat(marker); err == nil {
+				t.Errorf("token helper was run by %q", strings.Join(testcase.args, " "))
+			}
+		})
+	}
+}
+
+func TestManifestEnv(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name           string
+		args           []string
+		wantError      string
+		wantOutput     string
+		dontWantOutput string
+		wantServiceID  string
+	}{
+		{
+			name:          "base manifest",
+			args:          args("service-version list"),
+			wantServiceID: "base",
+		},
+		{
+			name:          "environment manifest",
+			args:          args("service-version list --env stage"),
+			wantServiceID: "stage",
+		},
+		{
+			name:          "environment in verbose output",
+			args:          args("service-version list --env stage --verbose"),
+			wantOutput:    "Fastly manifest environment provided via --env: stage (fastly.stage.toml)",
+			wantServiceID: "stage",
+		},
+		{
+			name:           "sources not in verbose JSON output",
+			args:           args("service-version list --env stage --verbose --json"),
+			dontWantOutput: "provided via",
+			wantServiceID:  "stage",
+		},
+		{
+			name:          "flag overrides environment manifest",
+			args:          args("service-version list --env stage --service-id 123"),
+			wantServiceID: "123",
+		},
+		{
+			name:      "missing environment manifest",
+			args:      args("service-version list --env prod"),
+			wantError: "error reading prod manifest",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			// We're going to chdir to an environment with manifests,
+			// so save the PWD to return to, afterwards.
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T: t,
+				Write: []testutil.FileIO{
+					{Src: "manifest_version = 1\nname = \"test\"\nservice_id = \"base\"\n", Dst: "fastly.toml"},
+					{Src: "service_id = \"stage\"\n", Dst: "fastly.stage.toml"},
+				},
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(pwd)
+
+			var serviceID string
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				ListVersionsFn: func(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+					serviceID = i.ServiceID
+					return testutil.ListVersions(i)
+				},
+			})
+			err = app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			if testcase.dontWantOutput != "" && strings.Contains(stdout.String(), testcase.dontWantOutput) {
+				t.Errorf("unexpected %q in output:\n%s", testcase.dontWantOutput, stdout.String())
+			}
+			testutil.AssertString(t, testcase.wantServiceID, serviceID)
+		})
+	}
+}
+
+func tokenSelf(scope fastly.TokenScope, services []string, expires *time.Time) func() (*fastly.Token, error) {
+	return func() (*fastly.Token, error) {
+		return &fastly.Token{
+			ID:        "123",
+			Name:      "Foo",
+			Scope:     scope,
+			Services:  services,
+			ExpiresAt: expires,
+		}, nil
+	}
+}
+
+func expiresIn(d time.Duration) *time.Time {
+	t := time.Now().Add(d)
+	return &t
+}
+
 // stripTrailingSpace removes any trailing spaces from the multiline str.
 func stripTrailingSpace(str string) string {
 	buf := bytes.NewBuffer(nil)
@@ -75,30 +448,46 @@ USAGE
 A tool to interact with the Fastly API
 
 GLOBAL FLAGS
-      --help         Show context-sensitive help.
-  -t, --token=TOKEN  Fastly API token (or via FASTLY_API_TOKEN)
-  -v, --verbose      Verbose logging
+      --help             Show context-sensitive help.
+  -t, --token=TOKEN      Fastly API token (or via FASTLY_API_TOKEN)
+  -v, --verbose          Verbose logging
+      --json             JSON output (lists are newline-delimited JSON)
+      --profile=PROFILE  Config profile to use (or via FASTLY_PROFILE)
 
 COMMANDS
   help             Show help.
   acl              Manipulate Fastly ACLs (Access Control Lists)
   acl-entry        Manipulate Fastly ACL (Access Control List) entries
   backend          Manipulate Fastly service version backends
+  cache-setting    Manipulate Fastly service version cache settings
   compute          Manage Compute@Edge packages
+  condition        Manipulate Fastly service version conditions
   configure        Configure the Fastly CLI
+  credentials      Manage the encrypted credential store for API tokens
   dictionary       Manipulate Fastly edge dictionaries
   dictionaryitem   Manipulate Fastly edge dictionary items
   domain           Manipulate Fastly service version domains
+  gzip             Manipulate Fastly service version gzip configurations
+  header           Manipulate Fastly service version headers
   healthcheck      Manipulate Fastly service version healthchecks
   ip-list          List Fastly's public IPs
   logging          Manipulate Fastly service version logging endpoints
   logs             Compute@Edge Log Tailing
   pops             List Fastly datacenters
+  profile          Manage named configuration profiles
   purge            Invalidate objects in the Fastly cache
+  request-setting  Manipulate Fastly service version request settings
+  response-object  Manipulate Fastly service version response objects
   service          Manipulate Fastly services
+  service-auth     Manipulate service authorizations (per-service permissions
+                   for users)
   service-version  Manipulate Fastly service versions
   stats            View historical and realtime statistics for a Fastly service
+  tls              Manage Fastly TLS private keys, certificates, activations and
+                   subscriptions
+  token            Manipulate Fastly API tokens
   update           Update the CLI to the latest version
+  user             Manipulate users of the Fastly account
   vcl              Manipulate Fastly service version VCL
   version          Display version information for the Fastly CLI
   whoami           Get information about the currently authenticated account
@@ -109,12 +498,30 @@ USAGE
   fastly [<flags>] service
 
 GLOBAL FLAGS
-      --help         Show context-sensitive help.
-  -t, --token=TOKEN  Fastly API token (or via FASTLY_API_TOKEN)
-  -v, --verbose      Verbose logging
+      --help             Show context-sensitive help.
+  -t, --token=TOKEN      Fastly API token (or via FASTLY_API_TOKEN)
+  -v, --verbose          Verbose logging
+      --json             JSON output (lists are newline-delimited JSON)
+      --profile=PROFILE  Config profile to use (or via FASTLY_PROFILE)
 
 SUBCOMMANDS
 
+  service apply --version=VERSION --file=FILE [<flags>]
+    Update a Fastly service version to match a service definition file
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+        --file=FILE              Path to a TOML or JSON service definition (e.g.
+                                 as created by the service export command)
+        --activate               Activate the service version once the changes
+                                 have been applied
+
   service create --name=NAME [<flags>]
     Create a Fastly service
 
@@ -126,6 +533,8 @@ SUBCOMMANDS
   service delete [<flags>]
     Delete a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
     -f, --force                  Force deletion of an active service
@@ -133,13 +542,42 @@ SUBCOMMANDS
   service describe [<flags>]
     Show detailed information about a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  service export --version=VERSION [<flags>]
+    Export the configuration of a Fastly service version to a TOML or JSON file
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --file=FILE              Path of the file to write the configuration to
+                                 (defaults to stdout)
+        --format=FORMAT          Format of the exported configuration (toml,
+                                 json), defaults to the --file extension or toml
 
   service list
     List Fastly services
 
 
+  service plan --version=VERSION --file=FILE [<flags>]
+    Show the changes required for a Fastly service version to match a service
+    definition file
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --file=FILE              Path to a TOML or JSON service definition (e.g.
+                                 as created by the service export command)
+
   service search [<flags>]
     Search for a Fastly service by name
 
@@ -148,6 +586,8 @@ SUBCOMMANDS
   service update [<flags>]
     Update a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
     -n, --name=NAME              Service name
@@ -162,15 +602,31 @@ USAGE
 A tool to interact with the Fastly API
 
 GLOBAL FLAGS
-      --help         Show context-sensitive help.
-  -t, --token=TOKEN  Fastly API token (or via FASTLY_API_TOKEN)
-  -v, --verbose      Verbose logging
+      --help             Show context-sensitive help.
+  -t, --token=TOKEN      Fastly API token (or via FASTLY_API_TOKEN)
+  -v, --verbose          Verbose logging
+      --json             JSON output (lists are newline-delimited JSON)
+      --profile=PROFILE  Config profile to use (or via FASTLY_PROFILE)
 
 COMMANDS
   help [<command> ...]
     Show help.
 
 
+  acl copy --from-service=FROM-SERVICE --name=NAME --to-service=TO-SERVICE --version=VERSION [<flags>]
+    Copy an ACL and its entries to another service, creating it on the
+    destination version if it doesn't exist
+
+    --from-service=FROM-SERVICE  Service ID to copy the ACL from
+    --name=NAME                  The name of the ACL to copy
+    --to-service=TO-SERVICE      Service ID to copy the ACL to
+    --version=VERSION            'latest', 'active', or the number of a specific
+                                 version
+    --autoclone                  If the selected service version is not
+                                 editable, clone it and use the clone.
+    --from-version="active"      'latest', 'active', or the number of a specific
+                                 version of the service to copy from
+
   acl create --name=NAME --version=VERSION [<flags>]
     Create a new ACL attached to the specified service version
 
@@ -182,6 +638,8 @@ COMMANDS
                                  version
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -193,6 +651,8 @@ COMMANDS
                                  version
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -202,6 +662,8 @@ COMMANDS
         --name=NAME              The name of the ACL
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -210,6 +672,8 @@ COMMANDS
 
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -222,6 +686,8 @@ COMMANDS
                                  version
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -232,6 +698,8 @@ COMMANDS
         --ip=IP                  An IP address
         --comment=COMMENT        A freeform descriptive note
         --negated                Whether to negate the match
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --subnet=SUBNET          Number of bits for the subnet mask applied to
@@ -242,6 +710,8 @@ COMMANDS
 
         --acl-id=ACL-ID          Alphanumeric string identifying a ACL
         --id=ID                  Alphanumeric string identifying an ACL Entry
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -250,6 +720,21 @@ COMMANDS
 
         --acl-id=ACL-ID          Alphanumeric string identifying a ACL
         --id=ID                  Alphanumeric string identifying an ACL Entry
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  acl-entry export --acl-id=ACL-ID [<flags>]
+    Export the entries of an ACL as CSV, JSON, batch update JSON or a sync file
+
+        --acl-id=ACL-ID          Alphanumeric string identifying a ACL
+        --file=FILE              Path to write the entries to (defaults to
+                                 stdout)
+        --format=json            Format of the exported entries: batch can be
+                                 read by update --file and sync by sync --file
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -257,6 +742,23 @@ COMMANDS
     List ACLs
 
         --acl-id=ACL-ID          Alphanumeric string identifying a ACL
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  acl-entry sync --acl-id=ACL-ID --file=FILE [<flags>]
+    Sync the entries of an ACL with a file of IP addresses and CIDR ranges
+
+        --acl-id=ACL-ID          Alphanumeric string identifying a ACL
+        --file=FILE              Path to a file with an IP address per line,
+                                 with an optional /subnet and ! prefix to negate
+                                 the match
+        --dry-run                Print the changes that would be made without
+                                 making them
+        --prune                  Delete entries that are absent from the file
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -270,6 +772,8 @@ COMMANDS
         --id=ID                  Alphanumeric string identifying an ACL Entry
         --ip=IP                  An IP address
         --negated                Whether to negate the match
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --subnet=SUBNET          Number of bits for the subnet mask applied to
@@ -278,6 +782,8 @@ COMMANDS
   backend create --version=VERSION --name=NAME --address=ADDRESS [<flags>]
     Create a backend on a Fastly service version
 
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --version=VERSION          'latest', 'active', or the number of a
@@ -342,6 +848,8 @@ COMMANDS
   backend delete --version=VERSION --name=NAME [<flags>]
     Delete a backend on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -353,6 +861,8 @@ COMMANDS
   backend describe --version=VERSION --name=NAME [<flags>]
     Show detailed information about a backend on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -362,6 +872,8 @@ COMMANDS
   backend list --version=VERSION [<flags>]
     List backends on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -370,6 +882,8 @@ COMMANDS
   backend update --version=VERSION --name=NAME [<flags>]
     Update a backend on a Fastly service version
 
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --version=VERSION          'latest', 'active', or the number of a
@@ -432,10 +946,93 @@ COMMANDS
                                    https://www.openssl.org/docs/man1.0.2/man1/ciphers
                                    for details)
 
+  cache-setting create --version=VERSION --name=NAME [<flags>]
+    Create a cache setting on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Cache setting name
+        --action=ACTION          Action to take when the cache condition is met.
+                                 Valid values are: cache, pass, restart
+        --ttl=TTL                Maximum time in seconds to consider the object
+                                 fresh in the cache
+        --stale-ttl=STALE-TTL    Maximum time in seconds to continue to use a
+                                 stale version of the object if future requests
+                                 to your backend server fail
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the cache
+                                 setting
+
+  cache-setting delete --version=VERSION --name=NAME [<flags>]
+    Delete a cache setting on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Cache setting name
+
+  cache-setting describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a cache setting on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of cache setting
+
+  cache-setting list --version=VERSION [<flags>]
+    List cache settings on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  cache-setting update --version=VERSION --name=NAME [<flags>]
+    Update a cache setting on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Cache setting name
+        --new-name=NEW-NAME      New cache setting name
+        --action=ACTION          Action to take when the cache condition is met.
+                                 Valid values are: cache, pass, restart
+        --ttl=TTL                Maximum time in seconds to consider the object
+                                 fresh in the cache
+        --stale-ttl=STALE-TTL    Maximum time in seconds to continue to use a
+                                 stale version of the object if future requests
+                                 to your backend server fail
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the cache
+                                 setting
+
   compute build [<flags>]
     Build a Compute@Edge package locally
 
     --name=NAME          Package name
+    --env=ENV            The environment configuration to use (e.g. stage reads
+                         fastly.stage.toml)
     --language=LANGUAGE  Language type
     --include-source     Include source code in built package
     --force              Skip verification steps and force build
@@ -444,14 +1041,25 @@ COMMANDS
   compute deploy [<flags>]
     Deploy a package to a Fastly Compute@Edge service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --[no-]activate          Activate the version the package is uploaded to
+                                 (use --no-activate to leave it as a draft)
         --backend=BACKEND        A hostname, IPv4, or IPv6 address for the
                                  package backend
         --backend-port=BACKEND-PORT
                                  A port number for the package backend
+        --canary                 After activating, watch the ratio of 5xx
+                                 responses and reactivate the previously active
+                                 version if it crosses --canary-threshold
+        --canary-threshold=0.05  The ratio of 5xx responses to requests (between
+                                 0 and 1) above which --canary rolls back
+        --canary-window=5m       How long --canary watches real-time stats for
+                                 after activating (e.g. 30s, 5m)
         --comment=COMMENT        Human-readable comment
         --domain=DOMAIN          The name of the domain associated to the
                                  package
@@ -490,14 +1098,25 @@ COMMANDS
         --force                  Skip verification steps and force build
         --timeout=TIMEOUT        Timeout, in seconds, for the build compilation
                                  step
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --[no-]activate          Activate the version the package is uploaded to
+                                 (use --no-activate to leave it as a draft)
         --backend=BACKEND        A hostname, IPv4, or IPv6 address for the
                                  package backend
         --backend-port=BACKEND-PORT
                                  A port number for the package backend
+        --canary                 After activating, watch the ratio of 5xx
+                                 responses and reactivate the previously active
+                                 version if it crosses --canary-threshold
+        --canary-threshold=0.05  The ratio of 5xx responses to requests (between
+                                 0 and 1) above which --canary rolls back
+        --canary-window=5m       How long --canary watches real-time stats for
+                                 after activating (e.g. 30s, 5m)
         --comment=COMMENT        Human-readable comment
         --domain=DOMAIN          The name of the domain associated to the
                                  package
@@ -512,17 +1131,22 @@ COMMANDS
     Build and run a Compute@Edge package locally
 
     --addr="127.0.0.1:7676"  The IPv4 address and port to listen on
-    --env=ENV                The environment configuration to use (e.g. stage)
+    --env=ENV                The environment configuration to use (e.g. stage
+                             reads fastly.stage.toml)
     --file="bin/main.wasm"   The Wasm file to run
     --force                  Skip verification steps and force build
     --include-source         Include source code in built package
     --language=LANGUAGE      Language type
     --name=NAME              Package name
     --skip-build             Skip the build step
+    --watch                  Watch for changes to the package source, then
+                             rebuild and restart the local server
 
   compute update --version=VERSION --path=PATH [<flags>]
     Update a package on a Fastly Compute@Edge service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -536,15 +1160,121 @@ COMMANDS
 
     -p, --path=PATH  Path to package
 
+  condition create --version=VERSION --name=NAME --type=TYPE --statement=STATEMENT [<flags>]
+    Create a condition on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Condition name
+        --type=TYPE              Type of the condition. Valid values are:
+                                 REQUEST, RESPONSE, CACHE, PREFETCH
+        --statement=STATEMENT    VCL conditional expression passed as file path
+                                 or content, e.g. $(< condition.vcl)
+        --priority=PRIORITY      Priority determines execution order. Lower
+                                 numbers execute first
+
+  condition delete --version=VERSION --name=NAME [<flags>]
+    Delete a condition on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Condition name
+
+  condition describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a condition on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of condition
+
+  condition list --version=VERSION [<flags>]
+    List conditions on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  condition update --version=VERSION --name=NAME [<flags>]
+    Update a condition on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Condition name
+        --comment=COMMENT        A descriptive note
+        --type=TYPE              Type of the condition. Valid values are:
+                                 REQUEST, RESPONSE, CACHE, PREFETCH
+        --statement=STATEMENT    VCL conditional expression passed as file path
+                                 or content, e.g. $(< condition.vcl)
+        --priority=PRIORITY      Priority determines execution order. Lower
+                                 numbers execute first
+
   configure [<flags>]
     Configure the Fastly CLI
 
     -l, --location  Print the location of the CLI configuration file
     -d, --display   Print the CLI configuration file
 
+  credentials lock
+    Lock the credential store, so the passphrase is required again
+
+
+  credentials migrate
+    Move plaintext API tokens from the config file into the encrypted credential
+    store, creating it if necessary
+
+
+  credentials unlock [<flags>]
+    Unlock the credential store so subsequent commands don't prompt for the
+    passphrase
+
+    --timeout=15m0s  How long the credential store remains unlocked for (e.g.
+                     30m, 8h)
+
+  dictionary copy --name=NAME --from-service=FROM-SERVICE --to-service=TO-SERVICE --version=VERSION [<flags>]
+    Copy a Fastly edge dictionary and its items to another service, creating it
+    on the destination version if it doesn't exist
+
+    -n, --name=NAME              Name of Dictionary
+        --from-service=FROM-SERVICE
+                                 Service ID to copy the dictionary from
+        --from-version="active"  'latest', 'active', or the number of a specific
+                                 version of the service to copy from
+        --to-service=TO-SERVICE  Service ID to copy the dictionary to
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+
   dictionary create --version=VERSION --name=NAME [<flags>]
     Create a Fastly edge dictionary on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -558,6 +1288,8 @@ COMMANDS
   dictionary delete --version=VERSION --name=NAME [<flags>]
     Delete a Fastly edge dictionary from a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -569,6 +1301,8 @@ COMMANDS
   dictionary describe --version=VERSION --name=NAME [<flags>]
     Show detailed information about a Fastly edge dictionary
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -578,6 +1312,8 @@ COMMANDS
   dictionary list --version=VERSION [<flags>]
     List all dictionaries on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -586,6 +1322,8 @@ COMMANDS
   dictionary update --version=VERSION --name=NAME [<flags>]
     Update name of dictionary on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -600,6 +1338,8 @@ COMMANDS
   dictionaryitem batchmodify --dictionary-id=DICTIONARY-ID --file=FILE [<flags>]
     Update multiple items in a Fastly edge dictionary
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --dictionary-id=DICTIONARY-ID
@@ -609,6 +1349,8 @@ COMMANDS
   dictionaryitem create --dictionary-id=DICTIONARY-ID --key=KEY --value=VALUE [<flags>]
     Create a new item on a Fastly edge dictionary
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --dictionary-id=DICTIONARY-ID
@@ -619,6 +1361,8 @@ COMMANDS
   dictionaryitem delete --dictionary-id=DICTIONARY-ID --key=KEY [<flags>]
     Delete an item from a Fastly edge dictionary
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --dictionary-id=DICTIONARY-ID
@@ -628,23 +1372,60 @@ COMMANDS
   dictionaryitem describe --dictionary-id=DICTIONARY-ID --key=KEY [<flags>]
     Show detailed information about a Fastly edge dictionary item
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --dictionary-id=DICTIONARY-ID
                                  Dictionary ID
         --key=KEY                Dictionary item key
 
+  dictionaryitem export --dictionary-id=DICTIONARY-ID [<flags>]
+    Export the items in a Fastly edge dictionary as CSV, JSON or batchmodify
+    JSON
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --dictionary-id=DICTIONARY-ID
+                                 Dictionary ID
+        --file=FILE              Path to write the items to (defaults to stdout)
+        --format=json            Format of the exported items: csv and json can
+                                 be read by sync, batch by batchmodify
+
   dictionaryitem list --dictionary-id=DICTIONARY-ID [<flags>]
     List items in a Fastly edge dictionary
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --dictionary-id=DICTIONARY-ID
                                  Dictionary ID
 
+  dictionaryitem sync --dictionary-id=DICTIONARY-ID --file=FILE [<flags>]
+    Sync the items in a Fastly edge dictionary with a CSV, JSON or YAML file of
+    key/value pairs
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --dictionary-id=DICTIONARY-ID
+                                 Dictionary ID
+        --file=FILE              Path to a .csv, .json, .yaml or .yml file of
+                                 key/value pairs
+        --dry-run                Print the changes that would be made without
+                                 making them
+        --prune                  Delete items whose keys are absent from the
+                                 file
+
   dictionaryitem update --dictionary-id=DICTIONARY-ID --key=KEY --value=VALUE [<flags>]
     Update or insert an item on a Fastly edge dictionary
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --dictionary-id=DICTIONARY-ID
@@ -657,6 +1438,8 @@ COMMANDS
 
     -n, --name=NAME              Domain name
         --comment=COMMENT        A descriptive note
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -668,6 +1451,8 @@ COMMANDS
     Delete a domain on a Fastly service version
 
     -n, --name=NAME              Domain name
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -678,6 +1463,8 @@ COMMANDS
   domain describe --version=VERSION --name=NAME [<flags>]
     Show detailed information about a domain on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -687,6 +1474,8 @@ COMMANDS
   domain list --version=VERSION [<flags>]
     List domains on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -695,6 +1484,8 @@ COMMANDS
   domain update --version=VERSION --name=NAME [<flags>]
     Update a domain on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -705,9 +1496,200 @@ COMMANDS
         --new-name=NEW-NAME      New domain name
         --comment=COMMENT        A descriptive note
 
+  gzip create --version=VERSION --name=NAME [<flags>]
+    Create a gzip configuration on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Gzip configuration name
+        --content-types=CONTENT-TYPES
+                                 Space-separated list of content types to
+                                 compress (e.g. text/html application/json)
+        --extensions=EXTENSIONS  Space-separated list of file extensions to
+                                 compress (e.g. css js html)
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the gzip
+                                 configuration
+
+  gzip delete --version=VERSION --name=NAME [<flags>]
+    Delete a gzip configuration on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Gzip configuration name
+
+  gzip describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a gzip configuration on a Fastly service
+    version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of gzip configuration
+
+  gzip list --version=VERSION [<flags>]
+    List gzip configurations on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  gzip update --version=VERSION --name=NAME [<flags>]
+    Update a gzip configuration on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Gzip configuration name
+        --new-name=NEW-NAME      New gzip configuration name
+        --content-types=CONTENT-TYPES
+                                 Space-separated list of content types to
+                                 compress (e.g. text/html application/json)
+        --extensions=EXTENSIONS  Space-separated list of file extensions to
+                                 compress (e.g. css js html)
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the gzip
+                                 configuration
+
+  header create --version=VERSION --name=NAME --action=ACTION --type=TYPE --dst=DST [<flags>]
+    Create a header on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Header name
+        --action=ACTION          Action to perform on the header. Valid values
+                                 are: set, append, delete, regex, regex_repeat
+        --type=TYPE              When to perform the action. Valid values are:
+                                 request, fetch, cache, response
+        --dst=DST                Header to set (e.g. http.X-Served-By)
+        --src=SRC                Variable to be used as a source for the header
+                                 content (does not apply to delete action)
+        --regex=REGEX            Regular expression to use (only applies to
+                                 regex and regex_repeat actions)
+        --substitution=SUBSTITUTION
+                                 Value to substitute in place of regular
+                                 expression (only applies to regex and
+                                 regex_repeat actions)
+        --ignore-if-set          Don't add the header if it is added already
+                                 (only applies to set action)
+        --priority=PRIORITY      Lower priorities execute first
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to the request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to the cached response
+        --response-condition=RESPONSE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to the response
+
+  header delete --version=VERSION --name=NAME [<flags>]
+    Delete a header on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Header name
+
+  header describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a header on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of header
+
+  header list --version=VERSION [<flags>]
+    List headers on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  header update --version=VERSION --name=NAME [<flags>]
+    Update a header on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Header name
+        --new-name=NEW-NAME      New header name
+        --action=ACTION          Action to perform on the header. Valid values
+                                 are: set, append, delete, regex, regex_repeat
+        --type=TYPE              When to perform the action. Valid values are:
+                                 request, fetch, cache, response
+        --dst=DST                Header to set (e.g. http.X-Served-By)
+        --src=SRC                Variable to be used as a source for the header
+                                 content (does not apply to delete action)
+        --regex=REGEX            Regular expression to use (only applies to
+                                 regex and regex_repeat actions)
+        --substitution=SUBSTITUTION
+                                 Value to substitute in place of regular
+                                 expression (only applies to regex and
+                                 regex_repeat actions)
+        --ignore-if-set          Don't add the header if it is added already
+                                 (only applies to set action)
+        --priority=PRIORITY      Lower priorities execute first
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to the request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to the cached response
+        --response-condition=RESPONSE-CONDITION
+                                 Condition which, if met, will apply the header
+                                 to the response
+
   healthcheck create --version=VERSION --name=NAME [<flags>]
     Create a healthcheck on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -737,6 +1719,8 @@ COMMANDS
   healthcheck delete --version=VERSION --name=NAME [<flags>]
     Delete a healthcheck on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -748,6 +1732,8 @@ COMMANDS
   healthcheck describe --version=VERSION --name=NAME [<flags>]
     Show detailed information about a healthcheck on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -757,6 +1743,8 @@ COMMANDS
   healthcheck list --version=VERSION [<flags>]
     List healthchecks on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -765,6 +1753,8 @@ COMMANDS
   healthcheck update --version=VERSION --name=NAME [<flags>]
     Update a healthcheck on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -814,6 +1804,8 @@ COMMANDS
                                  write access to the blob service objects. Be
                                  sure to update your token before it expires or
                                  the logging functionality will not work
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --path=PATH              The path to upload logs to
@@ -864,6 +1856,8 @@ COMMANDS
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Azure Blob Storage logging
                                  object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -871,6 +1865,8 @@ COMMANDS
     Show detailed information about an Azure Blob Storage logging endpoint on a
     Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -881,6 +1877,8 @@ COMMANDS
   logging azureblob list --version=VERSION [<flags>]
     List Azure Blob Storage logging endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -895,6 +1893,8 @@ COMMANDS
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Azure Blob Storage logging
                                  object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Azure Blob Storage logging
@@ -965,6 +1965,8 @@ COMMANDS
         --secret-key=SECRET-KEY  Your Google Cloud Platform account secret key.
                                  The private_key field in your service account
                                  authentication JSON.
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --template-suffix=TEMPLATE-SUFFIX
@@ -996,6 +1998,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the BigQuery logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1003,6 +2007,8 @@ COMMANDS
     Show detailed information about a BigQuery logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1012,6 +2018,8 @@ COMMANDS
   logging bigquery list --version=VERSION [<flags>]
     List BigQuery endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1025,6 +2033,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the BigQuery logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the BigQuery logging object
@@ -1070,6 +2080,8 @@ COMMANDS
         --user=USER              The username for your Cloudfile account
         --access-key=ACCESS-KEY  Your Cloudfile account access key
         --bucket=BUCKET          The name of your Cloudfiles container
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --path=PATH              The path to upload logs to
@@ -1120,6 +2132,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Cloudfiles logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1127,6 +2141,8 @@ COMMANDS
     Show detailed information about a Cloudfiles logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1136,6 +2152,8 @@ COMMANDS
   logging cloudfiles list --version=VERSION [<flags>]
     List Cloudfiles endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1149,6 +2167,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Cloudfiles logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Cloudfiles logging object
@@ -1205,6 +2225,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
         --auth-token=AUTH-TOKEN  The API key from your Datadog account
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --region=REGION          The region that log data will be sent to. One
@@ -1232,6 +2254,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Datadog logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1239,6 +2263,8 @@ COMMANDS
     Show detailed information about a Datadog logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1248,6 +2274,8 @@ COMMANDS
   logging datadog list --version=VERSION [<flags>]
     List Datadog endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1261,6 +2289,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Datadog logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Datadog logging object
@@ -1294,6 +2324,8 @@ COMMANDS
         --bucket=BUCKET          The name of the DigitalOcean Space
         --access-key=ACCESS-KEY  Your DigitalOcean Spaces account access key
         --secret-key=SECRET-KEY  Your DigitalOcean Spaces account secret key
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --domain=DOMAIN          The domain of the DigitalOcean Spaces endpoint
@@ -1344,6 +2376,8 @@ COMMANDS
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the DigitalOcean Spaces logging
                                  object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1351,6 +2385,8 @@ COMMANDS
     Show detailed information about a DigitalOcean Spaces logging endpoint on a
     Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1361,6 +2397,8 @@ COMMANDS
   logging digitalocean list --version=VERSION [<flags>]
     List DigitalOcean Spaces logging endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1375,6 +2413,8 @@ COMMANDS
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the DigitalOcean Spaces logging
                                  object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the DigitalOcean Spaces logging
@@ -1440,6 +2480,8 @@ COMMANDS
                                    with a pound symbol. For example, #{%F} will
                                    interpolate as YYYY-MM-DD with today's date
         --url=URL                  The URL to stream logs to. Must use HTTPS.
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --pipeline=PIPELINE        The ID of the Elasticsearch ingest pipeline
@@ -1490,6 +2532,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Elasticsearch logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1497,6 +2541,8 @@ COMMANDS
     Show detailed information about an Elasticsearch logging endpoint on a
     Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1506,6 +2552,8 @@ COMMANDS
   logging elasticsearch list --version=VERSION [<flags>]
     List Elasticsearch endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1519,6 +2567,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
     -n, --name=NAME                The name of the Elasticsearch logging object
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --new-name=NEW-NAME        New name of the Elasticsearch logging object
@@ -1585,6 +2635,8 @@ COMMANDS
         --user=USER              The username for the server (can be anonymous)
         --password=PASSWORD      The password for the server (for anonymous use
                                  an email address)
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --port=PORT              The port number
@@ -1628,6 +2680,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the FTP logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1635,6 +2689,8 @@ COMMANDS
     Show detailed information about an FTP logging endpoint on a Fastly service
     version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1644,6 +2700,8 @@ COMMANDS
   logging ftp list --version=VERSION [<flags>]
     List FTP endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1657,6 +2715,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the FTP logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the FTP logging object
@@ -1720,6 +2780,8 @@ COMMANDS
         --secret-key=SECRET-KEY  Your GCS account secret key. The private_key
                                  field in your service account authentication
                                  JSON
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --period=PERIOD          How frequently log files are finalized so they
@@ -1768,6 +2830,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the GCS logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1775,6 +2839,8 @@ COMMANDS
     Show detailed information about a GCS logging endpoint on a Fastly service
     version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1784,6 +2850,8 @@ COMMANDS
   logging gcs list --version=VERSION [<flags>]
     List GCS endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1797,6 +2865,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the GCS logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the GCS logging object
@@ -1863,6 +2933,8 @@ COMMANDS
         --topic=TOPIC            The Google Cloud Pub/Sub topic to which logs
                                  will be published
         --project-id=PROJECT-ID  The ID of your Google Cloud Platform project
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --format=FORMAT          Apache style log formatting
@@ -1888,6 +2960,8 @@ COMMANDS
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Google Cloud Pub/Sub logging
                                  object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1895,6 +2969,8 @@ COMMANDS
     Show detailed information about a Google Cloud Pub/Sub logging endpoint on a
     Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1905,6 +2981,8 @@ COMMANDS
   logging googlepubsub list --version=VERSION [<flags>]
     List Google Cloud Pub/Sub endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1919,6 +2997,8 @@ COMMANDS
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Google Cloud Pub/Sub logging
                                  object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Google Cloud Pub/Sub logging
@@ -1958,6 +3038,8 @@ COMMANDS
         --url=URL                The url to stream logs to
         --auth-token=AUTH-TOKEN  The token to use for authentication
                                  (https://devcenter.heroku.com/articles/add-on-partner-log-integration)
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --format=FORMAT          Apache style log formatting
@@ -1981,6 +3063,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Heroku logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -1988,6 +3072,8 @@ COMMANDS
     Show detailed information about a Heroku logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -1997,6 +3083,8 @@ COMMANDS
   logging heroku list --version=VERSION [<flags>]
     List Heroku endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2010,6 +3098,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Heroku logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Heroku logging object
@@ -2041,6 +3131,8 @@ COMMANDS
         --dataset=DATASET        The Honeycomb Dataset you want to log to
         --auth-token=AUTH-TOKEN  The Write Key from the Account page of your
                                  Honeycomb account
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --format=FORMAT          Apache style log formatting. Your log must
@@ -2065,6 +3157,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Honeycomb logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2072,6 +3166,8 @@ COMMANDS
     Show detailed information about a Honeycomb logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2081,6 +3177,8 @@ COMMANDS
   logging honeycomb list --version=VERSION [<flags>]
     List Honeycomb endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2094,6 +3192,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Honeycomb logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Honeycomb logging object
@@ -2125,6 +3225,8 @@ COMMANDS
                                    editable, clone it and use the clone.
         --url=URL                  URL that log data will be sent to. Must use
                                    the https protocol
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --content-type=CONTENT-TYPE
@@ -2186,6 +3288,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the HTTPS logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2193,6 +3297,8 @@ COMMANDS
     Show detailed information about an HTTPS logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2202,6 +3308,8 @@ COMMANDS
   logging https list --version=VERSION [<flags>]
     List HTTPS endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2215,6 +3323,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
     -n, --name=NAME                The name of the HTTPS logging object
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --new-name=NEW-NAME        New name of the HTTPS logging object
@@ -2283,6 +3393,8 @@ COMMANDS
         --topic=TOPIC              The Kafka topic to send logs to
         --brokers=BROKERS          A comma-separated list of IP addresses or
                                    hostnames of Kafka brokers
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --compression-codec=COMPRESSION-CODEC
@@ -2344,6 +3456,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Kafka logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2351,6 +3465,8 @@ COMMANDS
     Show detailed information about a Kafka logging endpoint on a Fastly service
     version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2360,6 +3476,8 @@ COMMANDS
   logging kafka list --version=VERSION [<flags>]
     List Kafka endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2373,6 +3491,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
     -n, --name=NAME                The name of the Kafka logging object
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --new-name=NEW-NAME        New name of the Kafka logging object
@@ -2447,6 +3567,8 @@ COMMANDS
         --iam-role=IAM-ROLE        The IAM role ARN for logging
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --format=FORMAT            Apache style log formatting
@@ -2471,6 +3593,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Kinesis logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2478,6 +3602,8 @@ COMMANDS
     Show detailed information about a Kinesis logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2487,6 +3613,8 @@ COMMANDS
   logging kinesis list --version=VERSION [<flags>]
     List Kinesis endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2500,6 +3628,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
     -n, --name=NAME                The name of the Kinesis logging object
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --new-name=NEW-NAME        New name of the Kinesis logging object
@@ -2528,6 +3658,8 @@ COMMANDS
 
     -n, --name=NAME              The name of the Logentries logging object. Used
                                  as a primary key for API access
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --port=PORT              The port number
@@ -2565,6 +3697,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Logentries logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2572,6 +3706,8 @@ COMMANDS
     Show detailed information about a Logentries logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2581,6 +3717,8 @@ COMMANDS
   logging logentries list --version=VERSION [<flags>]
     List Logentries endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2594,6 +3732,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Logentries logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Logentries logging object
@@ -2631,6 +3771,8 @@ COMMANDS
                                  editable, clone it and use the clone.
         --auth-token=AUTH-TOKEN  The token to use for authentication
                                  (https://www.loggly.com/docs/customer-token-authentication-token/)
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --format=FORMAT          Apache style log formatting
@@ -2654,6 +3796,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Loggly logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2661,6 +3805,8 @@ COMMANDS
     Show detailed information about a Loggly logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2670,6 +3816,8 @@ COMMANDS
   logging loggly list --version=VERSION [<flags>]
     List Loggly endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2683,6 +3831,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Loggly logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Loggly logging object
@@ -2713,6 +3863,8 @@ COMMANDS
         --url=URL                Your Log Shuttle endpoint url
         --auth-token=AUTH-TOKEN  The data authentication token associated with
                                  this endpoint
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --format=FORMAT          Apache style log formatting
@@ -2736,6 +3888,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Logshuttle logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2743,6 +3897,8 @@ COMMANDS
     Show detailed information about a Logshuttle logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2752,6 +3908,8 @@ COMMANDS
   logging logshuttle list --version=VERSION [<flags>]
     List Logshuttle endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2765,6 +3923,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Logshuttle logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Logshuttle logging object
@@ -2806,6 +3966,8 @@ COMMANDS
         --response-condition=RESPONSE-CONDITION
                                  The name of an existing condition in the
                                  configured endpoint
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2819,6 +3981,8 @@ COMMANDS
                                  version
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2830,6 +3994,8 @@ COMMANDS
                                  configuration
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2839,6 +4005,8 @@ COMMANDS
 
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2865,6 +4033,8 @@ COMMANDS
         --response-condition=RESPONSE-CONDITION
                                  The name of an existing condition in the
                                  configured endpoint
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2881,6 +4051,8 @@ COMMANDS
         --access-key=ACCESS-KEY  Your OpenStack account access key
         --user=USER              The username for your OpenStack account
         --url=URL                Your OpenStack auth url
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --public-key=PUBLIC-KEY  A PGP public key that Fastly will use to
@@ -2928,6 +4100,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the OpenStack logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -2935,6 +4109,8 @@ COMMANDS
     Show detailed information about an OpenStack logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2944,6 +4120,8 @@ COMMANDS
   logging openstack list --version=VERSION [<flags>]
     List OpenStack logging endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -2957,6 +4135,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the OpenStack logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the OpenStack logging object
@@ -3011,6 +4191,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
         --address=ADDRESS        A hostname or IPv4 address
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --port=PORT              The port number
@@ -3040,6 +4222,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Papertrail logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3047,6 +4231,8 @@ COMMANDS
     Show detailed information about a Papertrail logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3056,6 +4242,8 @@ COMMANDS
   logging papertrail list --version=VERSION [<flags>]
     List Papertrail endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3069,6 +4257,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Papertrail logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Papertrail logging object
@@ -3105,6 +4295,8 @@ COMMANDS
         --access-key=ACCESS-KEY  Your S3 account access key
         --secret-key=SECRET-KEY  Your S3 account secret key
         --iam-role=IAM-ROLE      The IAM role ARN for logging
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --domain=DOMAIN          The domain of the S3 endpoint
@@ -3161,6 +4353,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the S3 logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3168,6 +4362,8 @@ COMMANDS
     Show detailed information about a S3 logging endpoint on a Fastly service
     version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3177,6 +4373,8 @@ COMMANDS
   logging s3 list --version=VERSION [<flags>]
     List S3 endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3190,6 +4388,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the S3 logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the S3 logging object
@@ -3254,6 +4454,8 @@ COMMANDS
                                  editable, clone it and use the clone.
         --auth-token=AUTH-TOKEN  The token to use for authentication
                                  (https://www.scalyr.com/keys)
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --region=REGION          The region that log data will be sent to. One
@@ -3279,6 +4481,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Scalyr logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3286,6 +4490,8 @@ COMMANDS
     Show detailed information about a Scalyr logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3295,6 +4501,8 @@ COMMANDS
   logging scalyr list --version=VERSION [<flags>]
     List Scalyr endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3308,6 +4516,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Scalyr logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Scalyr logging object
@@ -3342,6 +4552,8 @@ COMMANDS
         --ssh-known-hosts=SSH-KNOWN-HOSTS
                                  A list of host keys for all hosts we can
                                  connect to over SFTP
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --port=PORT              The port number
@@ -3398,6 +4610,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the SFTP logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3405,6 +4619,8 @@ COMMANDS
     Show detailed information about an SFTP logging endpoint on a Fastly service
     version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3414,6 +4630,8 @@ COMMANDS
   logging sftp list --version=VERSION [<flags>]
     List SFTP endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3427,6 +4645,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the SFTP logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the SFTP logging object
@@ -3491,6 +4711,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
         --url=URL                  The URL to POST to
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --tls-ca-cert=TLS-CA-CERT  A secure certificate to authenticate the
@@ -3529,6 +4751,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Splunk logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3536,6 +4760,8 @@ COMMANDS
     Show detailed information about a Splunk logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3545,6 +4771,8 @@ COMMANDS
   logging splunk list --version=VERSION [<flags>]
     List Splunk endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3558,6 +4786,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
     -n, --name=NAME                The name of the Splunk logging object
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --new-name=NEW-NAME        New name of the Splunk logging object
@@ -3600,6 +4830,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
         --url=URL                The URL to POST to
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --format=FORMAT          Apache style log formatting
@@ -3631,6 +4863,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Sumologic logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3638,6 +4872,8 @@ COMMANDS
     Show detailed information about a Sumologic logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3647,6 +4883,8 @@ COMMANDS
   logging sumologic list --version=VERSION [<flags>]
     List Sumologic endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3660,6 +4898,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Sumologic logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --new-name=NEW-NAME      New name of the Sumologic logging object
@@ -3695,6 +4935,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
         --address=ADDRESS          A hostname or IPv4 address
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --port=PORT                The port number
@@ -3738,6 +4980,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
     -n, --name=NAME              The name of the Syslog logging object
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3745,6 +4989,8 @@ COMMANDS
     Show detailed information about a Syslog logging endpoint on a Fastly
     service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3754,6 +5000,8 @@ COMMANDS
   logging syslog list --version=VERSION [<flags>]
     List Syslog endpoints on a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3767,6 +5015,8 @@ COMMANDS
         --autoclone                If the selected service version is not
                                    editable, clone it and use the clone.
     -n, --name=NAME                The name of the Syslog logging object
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
                                    then fastly.toml)
         --new-name=NEW-NAME        New name of the Syslog logging object
@@ -3807,6 +5057,8 @@ COMMANDS
   logs tail [<flags>]
     Tail Compute@Edge logs
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --from=FROM              From time, in unix seconds
@@ -3824,6 +5076,32 @@ COMMANDS
     List Fastly datacenters
 
 
+  profile create --name=NAME [<flags>]
+    Create a named profile
+
+    -n, --name=NAME  Name of the profile
+        --default    Make this the default profile
+
+  profile delete --name=NAME
+    Delete a named profile
+
+    -n, --name=NAME  Name of the profile
+
+  profile list
+    List named profiles
+
+
+  profile switch --name=NAME
+    Set the default profile
+
+    -n, --name=NAME  Name of the profile
+
+  profile update --name=NAME [<flags>]
+    Update the token and endpoint of a named profile
+
+    -n, --name=NAME  Name of the profile
+        --default    Make this the default profile
+
   purge [<flags>]
     Invalidate objects in the Fastly cache
 
@@ -3832,12 +5110,228 @@ COMMANDS
                                  Surrogate Keys
         --key=KEY                Purge a service of objects tagged with a
                                  Surrogate Key
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --soft                   A 'soft' purge marks affected objects as stale
                                  rather than making them inaccessible
         --url=URL                Purge an individual URL
 
+  request-setting create --version=VERSION --name=NAME [<flags>]
+    Create a request setting on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Request setting name
+        --action=ACTION          Allows you to terminate request handling and
+                                 immediately perform an action. Valid values
+                                 are: lookup, pass
+        --xff=XFF                Short for X-Forwarded-For. Valid values are:
+                                 clear, leave, append, append_all, overwrite
+        --force-miss             Force a cache miss for the request
+        --force-ssl              Force the request to use SSL, redirecting a
+                                 non-SSL request to SSL
+        --bypass-busy-wait       Disable collapsed forwarding, so you don't wait
+                                 for other objects to origin
+        --max-stale-age=MAX-STALE-AGE
+                                 How old an object is allowed to be to serve
+                                 stale-if-error or stale-while-revalidate
+        --hash-keys=HASH-KEYS    Comma separated list of varnish request object
+                                 fields that should be in the hash key
+        --timer-support          Injects the X-Timer info into the request for
+                                 viewing origin fetch durations
+        --geo-headers            Injects Fastly-Geo-Country, Fastly-Geo-City,
+                                 and Fastly-Geo-Region into the request headers
+        --default-host=DEFAULT-HOST
+                                 Sets the host header
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the request
+                                 setting
+
+  request-setting delete --version=VERSION --name=NAME [<flags>]
+    Delete a request setting on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Request setting name
+
+  request-setting describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a request setting on a Fastly service
+    version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of request setting
+
+  request-setting list --version=VERSION [<flags>]
+    List request settings on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  request-setting update --version=VERSION --name=NAME [<flags>]
+    Update a request setting on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Request setting name
+        --new-name=NEW-NAME      New request setting name
+        --action=ACTION          Allows you to terminate request handling and
+                                 immediately perform an action. Valid values
+                                 are: lookup, pass
+        --xff=XFF                Short for X-Forwarded-For. Valid values are:
+                                 clear, leave, append, append_all, overwrite
+        --force-miss             Force a cache miss for the request
+        --force-ssl              Force the request to use SSL, redirecting a
+                                 non-SSL request to SSL
+        --bypass-busy-wait       Disable collapsed forwarding, so you don't wait
+                                 for other objects to origin
+        --max-stale-age=MAX-STALE-AGE
+                                 How old an object is allowed to be to serve
+                                 stale-if-error or stale-while-revalidate
+        --hash-keys=HASH-KEYS    Comma separated list of varnish request object
+                                 fields that should be in the hash key
+        --timer-support          Injects the X-Timer info into the request for
+                                 viewing origin fetch durations
+        --geo-headers            Injects Fastly-Geo-Country, Fastly-Geo-City,
+                                 and Fastly-Geo-Region into the request headers
+        --default-host=DEFAULT-HOST
+                                 Sets the host header
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will apply the request
+                                 setting
+
+  response-object create --version=VERSION --name=NAME [<flags>]
+    Create a response object on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Response object name
+        --status=STATUS          The HTTP status code
+        --response=RESPONSE      The HTTP response reason phrase (e.g. OK)
+        --content=CONTENT        The content to deliver for the response object,
+                                 passed as file path or content, e.g. $(<
+                                 page.html)
+        --content-type=CONTENT-TYPE
+                                 The MIME type of the content
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will select this
+                                 response object during a request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will select this
+                                 response object during a cache lookup
+
+  response-object delete --version=VERSION --name=NAME [<flags>]
+    Delete a response object on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Response object name
+
+  response-object describe --version=VERSION --name=NAME [<flags>]
+    Show detailed information about a response object on a Fastly service
+    version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+    -n, --name=NAME              Name of response object
+
+  response-object list --version=VERSION [<flags>]
+    List response objects on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  response-object update --version=VERSION --name=NAME [<flags>]
+    Update a response object on a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+    -n, --name=NAME              Response object name
+        --new-name=NEW-NAME      New response object name
+        --status=STATUS          The HTTP status code
+        --response=RESPONSE      The HTTP response reason phrase (e.g. OK)
+        --content=CONTENT        The content to deliver for the response object,
+                                 passed as file path or content, e.g. $(<
+                                 page.html)
+        --content-type=CONTENT-TYPE
+                                 The MIME type of the content
+        --request-condition=REQUEST-CONDITION
+                                 Condition which, if met, will select this
+                                 response object during a request
+        --cache-condition=CACHE-CONDITION
+                                 Condition which, if met, will select this
+                                 response object during a cache lookup
+
+  service apply --version=VERSION --file=FILE [<flags>]
+    Update a Fastly service version to match a service definition file
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+        --file=FILE              Path to a TOML or JSON service definition (e.g.
+                                 as created by the service export command)
+        --activate               Activate the service version once the changes
+                                 have been applied
+
   service create --name=NAME [<flags>]
     Create a Fastly service
 
@@ -3849,6 +5343,8 @@ COMMANDS
   service delete [<flags>]
     Delete a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
     -f, --force                  Force deletion of an active service
@@ -3856,13 +5352,42 @@ COMMANDS
   service describe [<flags>]
     Show detailed information about a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
+  service export --version=VERSION [<flags>]
+    Export the configuration of a Fastly service version to a TOML or JSON file
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --file=FILE              Path of the file to write the configuration to
+                                 (defaults to stdout)
+        --format=FORMAT          Format of the exported configuration (toml,
+                                 json), defaults to the --file extension or toml
+
   service list
     List Fastly services
 
 
+  service plan --version=VERSION --file=FILE [<flags>]
+    Show the changes required for a Fastly service version to match a service
+    definition file
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --file=FILE              Path to a TOML or JSON service definition (e.g.
+                                 as created by the service export command)
+
   service search [<flags>]
     Search for a Fastly service by name
 
@@ -3871,14 +5396,57 @@ COMMANDS
   service update [<flags>]
     Update a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
     -n, --name=NAME              Service name
         --comment=COMMENT        Human-readable comment
 
+  service-auth create --user-id=USER-ID [<flags>]
+    Grant a user access to a service
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --user-id=USER-ID        Alphanumeric string identifying the user
+        --permission=read_only   The permission the user has in relation to the
+                                 service. Valid values are: full, read_only,
+                                 purge_select, purge_all
+
+  service-auth delete --id=ID
+    Revoke a user's access to a service
+
+    --id=ID  Alphanumeric string identifying the service authorization
+
+  service-auth describe --id=ID
+    Show detailed information about a service authorization
+
+    --id=ID  Alphanumeric string identifying the service authorization
+
+  service-auth list [<flags>]
+    List service authorizations
+
+    --filter-service-id=FILTER-SERVICE-ID
+      Only list authorizations for the given service
+    --filter-user-id=FILTER-USER-ID
+      Only list authorizations for the given user
+
+  service-auth update --id=ID --permission=PERMISSION
+    Update the permission of a service authorization
+
+    --id=ID                  Alphanumeric string identifying the service
+                             authorization
+    --permission=PERMISSION  The permission the user has in relation to the
+                             service. Valid values are: full, read_only,
+                             purge_select, purge_all
+
   service-version activate --version=VERSION [<flags>]
     Activate a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3889,6 +5457,8 @@ COMMANDS
   service-version clone --version=VERSION [<flags>]
     Clone a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3897,28 +5467,90 @@ COMMANDS
   service-version deactivate --version=VERSION [<flags>]
     Deactivate a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
 
+  service-version diff --version-a=VERSION-A --version-b=VERSION-B [<flags>]
+    Compare the configuration of two Fastly service versions
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version-a=VERSION-A    'latest', 'active', or the number of the
+                                 version to compare from
+        --version-b=VERSION-B    'latest', 'active', or the number of the
+                                 version to compare to
+
   service-version list [<flags>]
     List Fastly service versions
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
   service-version lock --version=VERSION [<flags>]
     Lock a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+
+  service-version rollback [<flags>]
+    Activate the service version that was deployed before the active version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+        --comment=COMMENT        Reason for the rollback, recorded as the
+                                 comment of the reactivated version
+        --force                  Skip the confirmation prompt
+
+  service-version settings describe --version=VERSION [<flags>]
+    Show the settings of a Fastly service version
+
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
 
+  service-version settings update --version=VERSION [<flags>]
+    Update the settings of a Fastly service version
+
+        --env=ENV                  The environment configuration to use (e.g.
+                                   stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID    Service ID (falls back to FASTLY_SERVICE_ID,
+                                   then fastly.toml)
+        --version=VERSION          'latest', 'active', or the number of a
+                                   specific version
+        --autoclone                If the selected service version is not
+                                   editable, clone it and use the clone.
+        --default-ttl=DEFAULT-TTL  The default time-to-live (TTL) for requests,
+                                   in seconds
+        --default-host=DEFAULT-HOST
+                                   The default host name for the version
+        --stale-if-error           Enables serving a stale object if there is an
+                                   error
+        --stale-if-error-ttl=STALE-IF-ERROR-TTL
+                                   The default time-to-live (TTL) for serving
+                                   the stale object for the version, in seconds
+
   service-version update --version=VERSION [<flags>]
     Update a Fastly service version
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --version=VERSION        'latest', 'active', or the number of a specific
@@ -3930,6 +5562,8 @@ COMMANDS
   stats historical [<flags>]
     View historical stats for a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --from=FROM              From time, accepted formats at
@@ -3942,6 +5576,8 @@ COMMANDS
   stats realtime [<flags>]
     View realtime stats for a Fastly service
 
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
         --format=FORMAT          Output format (json)
@@ -3950,10 +5586,246 @@ COMMANDS
     List stats regions
 
 
+  tls activation create --cert-id=CERT-ID --domain=DOMAIN [<flags>]
+    Enable TLS for a domain using a custom certificate
+
+        --cert-id=CERT-ID      Alphanumeric string identifying a TLS certificate
+    -d, --domain=DOMAIN        The domain name to enable TLS for
+        --config-id=CONFIG-ID  Alphanumeric string identifying a TLS
+                               configuration (defaults to the account's default
+                               configuration)
+
+  tls activation delete --id=ID
+    Disable TLS on the domain associated with a TLS activation
+
+    --id=ID  Alphanumeric string identifying a TLS activation
+
+  tls activation describe --id=ID
+    Show detailed information about a TLS activation
+
+    --id=ID  Alphanumeric string identifying a TLS activation
+
+  tls activation list [<flags>]
+    List TLS activations
+
+    --filter-cert-id=FILTER-CERT-ID
+                                   Limit the returned activations to a specific
+                                   certificate
+    --filter-config-id=FILTER-CONFIG-ID
+                                   Limit the returned activations to a specific
+                                   TLS configuration
+    --filter-domain=FILTER-DOMAIN  Limit the returned activations to a specific
+                                   domain
+
+  tls activation update --id=ID --cert-id=CERT-ID
+    Update the certificate used to terminate TLS traffic for an activated domain
+
+    --id=ID            Alphanumeric string identifying a TLS activation
+    --cert-id=CERT-ID  Alphanumeric string identifying a TLS certificate
+
+  tls certificate create --cert-blob=CERT-BLOB [<flags>]
+    Upload a custom TLS certificate. The matching private key must have been
+    uploaded first
+
+        --cert-blob=CERT-BLOB  PEM-formatted certificate passed as file path or
+                               content, e.g. $(< cert.pem)
+    -n, --name=NAME            A customizable name for the certificate (defaults
+                               to the certificate's Common Name or first Subject
+                               Alternative Name)
+
+  tls certificate delete --id=ID
+    Delete a custom TLS certificate. This disables TLS for all domains listed on
+    it
+
+    --id=ID  Alphanumeric string identifying a certificate
+
+  tls certificate describe --id=ID
+    Show detailed information about a custom TLS certificate
+
+    --id=ID  Alphanumeric string identifying a certificate
+
+  tls certificate list [<flags>]
+    List custom TLS certificates
+
+    --filter-domain=FILTER-DOMAIN  Limit the returned certificates to those that
+                                   include the given domain
+    --expiring-within=EXPIRING-WITHIN
+                                   Only list certificates that expire within the
+                                   given number of days, soonest first (0 lists
+                                   already expired certificates)
+
+  tls certificate update --id=ID --cert-blob=CERT-BLOB [<flags>]
+    Replace a custom TLS certificate with a newly reissued one. Any domains
+    missing from the new certificate will have TLS disabled
+
+        --id=ID                Alphanumeric string identifying a certificate
+        --cert-blob=CERT-BLOB  PEM-formatted certificate passed as file path or
+                               content, e.g. $(< cert.pem)
+    -n, --name=NAME            A customizable name for the certificate
+
+  tls domain list [<flags>]
+    List domains listed on TLS certificates and subscriptions
+
+    --filter-in-use  Limit the returned domains to those currently using Fastly
+                     to terminate TLS
+    --filter-cert-id=FILTER-CERT-ID
+                     Limit the returned domains to those listed on the given
+                     certificate
+    --filter-subscription-id=FILTER-SUBSCRIPTION-ID
+                     Limit the returned domains to those for the given
+                     subscription
+
+  tls private-key create --name=NAME --key=KEY
+    Upload a TLS private key
+
+    -n, --name=NAME  A customizable name for the private key
+        --key=KEY    PEM-formatted private key passed as file path or content,
+                     e.g. $(< key.pem)
+
+  tls private-key delete --id=ID
+    Delete a TLS private key. Only keys not matched to any certificates can be
+    deleted
+
+    --id=ID  Alphanumeric string identifying a private key
+
+  tls private-key describe --id=ID
+    Show detailed information about a TLS private key
+
+    --id=ID  Alphanumeric string identifying a private key
+
+  tls private-key list [<flags>]
+    List TLS private keys
+
+    --filter-in-use=FILTER-IN-USE  Limit the returned keys to those without any
+                                   matching TLS certificates (false) or with one
+                                   (true)
+
+  tls subscription create --domain=DOMAIN [<flags>]
+    Create a TLS subscription so that Fastly issues and renews certificates for
+    the given domains
+
+    -d, --domain=DOMAIN ...        Domain to enable TLS for (set flag multiple
+                                   times to include multiple domains)
+        --common-name=COMMON-NAME  The domain to use as the certificate's Common
+                                   Name. Must be one of the given domains
+                                   (defaults to the first domain)
+        --cert-authority=lets-encrypt
+                                   The entity that issues and certifies the
+                                   certificates. Valid values are: lets-encrypt,
+                                   globalsign
+        --config-id=CONFIG-ID      Alphanumeric string identifying a TLS
+                                   configuration (defaults to the account's
+                                   default configuration)
+
+  tls subscription delete --id=ID [<flags>]
+    Delete a TLS subscription
+
+    --id=ID  Alphanumeric string identifying a TLS subscription
+    --force  Delete the subscription even if domains are active. Warning: can
+             disable production traffic
+
+  tls subscription describe --id=ID
+    Show detailed information about a TLS subscription, including the DNS
+    challenges required to verify domain ownership
+
+    --id=ID  Alphanumeric string identifying a TLS subscription
+
+  tls subscription list [<flags>]
+    List TLS subscriptions
+
+    --filter-state=FILTER-STATE    Limit the returned subscriptions by state.
+                                   Valid values are: pending, processing,
+                                   issued, renewing
+    --filter-domain=FILTER-DOMAIN  Limit the returned subscriptions to those
+                                   that include the given domain
+
+  tls subscription update --id=ID [<flags>]
+    Update a TLS subscription. Subscriptions can only be updated once issued,
+    unless forced
+
+        --id=ID                    Alphanumeric string identifying a TLS
+                                   subscription
+    -d, --domain=DOMAIN ...        Domain to enable TLS for (set flag multiple
+                                   times to include multiple domains). Replaces
+                                   the existing domains
+        --common-name=COMMON-NAME  The domain to use as the certificate's Common
+                                   Name
+        --config-id=CONFIG-ID      Alphanumeric string identifying a TLS
+                                   configuration
+        --force                    Apply the update even if domains are active.
+                                   Warning: can disable production traffic
+
+  token create [<flags>]
+    Create an API token. Tokens are created for the given user, which must
+    re-authenticate with their password
+
+    -n, --name=NAME              A name for the token
+        --username=USERNAME      The login of the user the token is created for
+                                 (defaults to the user of the current API token)
+        --password=PASSWORD      The password of the user (prompted for if not
+                                 provided)
+        --scope=SCOPE ...        An authorization scope for the token (set flag
+                                 multiple times to include multiple scopes).
+                                 Valid values are: global, purge_select,
+                                 purge_all, global:read
+        --services=SERVICES ...  Limit the token to the given service ID (set
+                                 flag multiple times to include multiple
+                                 services)
+        --expires=EXPIRES        Time the token expires, in RFC 3339 format
+                                 (e.g. 2021-12-31T23:59:59Z)
+
+  token delete [<flags>]
+    Revoke an API token
+
+    --id=ID    Alphanumeric string identifying the token
+    --current  Revoke the token used to authenticate this request
+
+  token list [<flags>]
+    List API tokens of the current user, or of every user of a customer account
+
+    --customer-id=CUSTOMER-ID  Alphanumeric string identifying the customer
+                               account whose tokens should be listed (requires
+                               superuser role)
+
   update
     Update the CLI to the latest version
 
 
+  user create --login=LOGIN --name=NAME [<flags>]
+    Create a user of the Fastly account (an invitation email is sent to the
+    login address)
+
+        --login=LOGIN  The login (email address) of the user
+    -n, --name=NAME    The real life name of the user
+        --role=ROLE    The permissions role assigned to the user. Valid values
+                       are: user, billing, engineer, superuser
+
+  user delete --id=ID
+    Delete a user of the Fastly account
+
+    --id=ID  Alphanumeric string identifying the user
+
+  user describe [<flags>]
+    Show detailed information about a user
+
+    --id=ID    Alphanumeric string identifying the user
+    --current  Describe the user associated with the current API token
+
+  user list [<flags>]
+    List users of a Fastly account
+
+    --customer-id=CUSTOMER-ID  Alphanumeric string identifying the customer
+                               account (defaults to the account of the current
+                               API token)
+
+  user update --id=ID [<flags>]
+    Update a user of the Fastly account
+
+        --id=ID      Alphanumeric string identifying the user
+    -n, --name=NAME  The real life name of the user
+        --role=ROLE  The permissions role assigned to the user. Valid values
+                     are: user, billing, engineer, superuser
+
   vcl custom create --content=CONTENT --name=NAME --version=VERSION [<flags>]
     Upload a VCL for a particular service and version
 
@@ -3965,6 +5837,8 @@ COMMANDS
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
         --main                   Whether the VCL is the 'main' entrypoint
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3976,6 +5850,8 @@ COMMANDS
                                  version
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3985,6 +5861,8 @@ COMMANDS
         --name=NAME              The name of the VCL
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -3993,6 +5871,8 @@ COMMANDS
 
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -4007,6 +5887,8 @@ COMMANDS
         --new-name=NEW-NAME      New name for the VCL
         --content=CONTENT        VCL passed as file path or content, e.g. $(<
                                  main.vcl)
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -4025,6 +5907,8 @@ COMMANDS
         --dynamic                Whether the VCL snippet is dynamic or versioned
     -p, --priority=PRIORITY      Priority determines execution order. Lower
                                  numbers execute first
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -4036,6 +5920,8 @@ COMMANDS
                                  version
         --autoclone              If the selected service version is not
                                  editable, clone it and use the clone.
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -4046,6 +5932,8 @@ COMMANDS
                                  version
         --dynamic                Whether the VCL snippet is dynamic or versioned
         --name=NAME              The name of the VCL snippet
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
     -i, --snippet-id=SNIPPET-ID  Alphanumeric string identifying a VCL Snippet
@@ -4055,6 +5943,26 @@ COMMANDS
 
         --version=VERSION        'latest', 'active', or the number of a specific
                                  version
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
+    -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
+                                 then fastly.toml)
+
+  vcl snippet sync --dir=DIR --version=VERSION [<flags>]
+    Sync the VCL snippets of a service with a directory of <type>.<name>.vcl
+    files
+
+        --dir=DIR                Directory of VCL snippet files, each named
+                                 after the snippet's type and name, e.g.
+                                 recv.blocklist.vcl
+        --version=VERSION        'latest', 'active', or the number of a specific
+                                 version
+        --autoclone              If the selected service version is not
+                                 editable, clone it and use the clone.
+        --dry-run                Print the changes that would be made without
+                                 making them
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
 
@@ -4072,6 +5980,8 @@ COMMANDS
         --new-name=NEW-NAME      New name for the VCL snippet
     -p, --priority=PRIORITY      Priority determines execution order. Lower
                                  numbers execute first
+        --env=ENV                The environment configuration to use (e.g.
+                                 stage reads fastly.stage.toml)
     -s, --service-id=SERVICE-ID  Service ID (falls back to FASTLY_SERVICE_ID,
                                  then fastly.toml)
     -i, --snippet-id=SNIPPET-ID  Alphanumeric string identifying a VCL Snippet
@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"reflect"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/commands/compute/manifest"
@@ -59,6 +61,39 @@ func (b Base) Name() string {
 	return b.CmdClause.FullCommand()
 }
 
+// JSONOutput indicates whether the user has requested machine-readable output
+// via the global --json flag.
+func (b Base) JSONOutput() bool {
+	return b.Globals.JSON()
+}
+
+// WriteJSON encodes v (typically a go-fastly API struct) as a single JSON
+// document to the given io.Writer.
+func (b Base) WriteJSON(out io.Writer, v interface{}) error {
+	if err := json.NewEncoder(out).Encode(v); err != nil {
+		b.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error encoding JSON output: %w", err)
+	}
+	return nil
+}
+
+// WriteJSONLines encodes each element of the slice v as its own JSON document
+// on a single line (i.e. newline-delimited JSON), which allows consumers to
+// process large lists in a streaming fashion. If v isn't a slice then it's
+// encoded as a single JSON document.
+func (b Base) WriteJSONLines(out io.Writer, v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice {
+		return b.WriteJSON(out, v)
+	}
+	for i := 0; i < rv.Len(); i++ {
+		if err := b.WriteJSON(out, rv.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Optional models an optional type that consumers can use to assert whether the
 // inner value has been set and is therefore valid for use.
 type Optional struct {
@@ -10,6 +10,7 @@ import (
 	"strings"
 
 	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
 	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v3/fastly"
@@ -17,11 +18,24 @@ import (
 )
 
 // RegisterServiceIDFlag defines a --service-id flag that will attempt to
-// acquire the Service ID from multiple sources.
+// acquire the Service ID from multiple sources, along with the --env flag that
+// selects which environment's manifest file is one of those sources.
 //
 // See: manifest.Data.ServiceID() for the sources.
-func (b Base) RegisterServiceIDFlag(dst *string) {
-	b.CmdClause.Flag("service-id", "Service ID (falls back to FASTLY_SERVICE_ID, then fastly.toml)").Short('s').StringVar(dst)
+func (b Base) RegisterServiceIDFlag(m *manifest.Data) {
+	b.RegisterEnvFlag(m)
+	b.CmdClause.Flag("service-id", "Service ID (falls back to FASTLY_SERVICE_ID, then fastly.toml)").Short('s').StringVar(&m.Flag.ServiceID)
+}
+
+// RegisterEnvFlag defines an --env flag that overlays the manifest file for an
+// environment, e.g. fastly.stage.toml, onto the fastly.toml manifest.
+//
+// NOTE: the overlay is applied when the flag is parsed, as commands read the
+// fastly.toml manifest when they're constructed.
+func (b Base) RegisterEnvFlag(m *manifest.Data) {
+	b.CmdClause.Flag("env", "The environment configuration to use (e.g. stage reads fastly.stage.toml)").Action(func(*kingpin.ParseElement, *kingpin.ParseContext) error {
+		return m.File.ReadEnv(m.Flag.Env)
+	}).StringVar(&m.Flag.Env)
 }
 
 // ServiceVersionFlagOpts enables easy configuration of the --version flag
@@ -2,6 +2,8 @@ package acl_test
 
 import (
 	"bytes"
+	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/fastly/cli/pkg/app"
@@ -10,6 +12,112 @@ import (
 	"github.com/fastly/go-fastly/v3/fastly"
 )
 
+func TestACLCopy(t *testing.T) {
+	args := testutil.Args
+	var batches [][]*fastly.BatchACLEntry
+	api := func(dstACLs []*fastly.ACL) mock.API {
+		return mock.API{
+			ListVersionsFn: testutil.ListVersions,
+			CloneVersionFn: testutil.CloneVersionResult(4),
+			ListACLsFn: func(i *fastly.ListACLsInput) ([]*fastly.ACL, error) {
+				if i.ServiceID == "123" {
+					return []*fastly.ACL{{ID: "src", Name: "foo"}}, nil
+				}
+				return dstACLs, nil
+			},
+			GetFn: func(path string, ro *fastly.RequestOptions) (*http.Response, error) {
+				if path == "/service/123/acl/src/entries" {
+					return testutil.PaginatedGet([]*fastly.ACLEntry{
+						{ID: "1", IP: "127.0.0.1", Comment: "localhost"},
+						{ID: "2", IP: "10.0.0.0", Subnet: 8, Negated: true},
+						{ID: "3", IP: "192.168.0.1", DeletedAt: &testutil.Date},
+					})(path, ro)
+				}
+				return testutil.PaginatedGet([]*fastly.ACLEntry{
+					{ID: "a", IP: "127.0.0.1", Comment: "localhost"},
+					{ID: "b", IP: "10.0.0.0", Subnet: 8},
+				})(path, ro)
+			},
+			CreateACLFn: func(i *fastly.CreateACLInput) (*fastly.ACL, error) {
+				return &fastly.ACL{ID: "dst", Name: i.Name, ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion}, nil
+			},
+			BatchModifyACLEntriesFn: func(i *fastly.BatchModifyACLEntriesInput) error {
+				if i.ACLID != "dst" {
+					return fmt.Errorf("unexpected ACL ID %s", i.ACLID)
+				}
+				batches = append(batches, i.Entries)
+				return nil
+			},
+		}
+	}
+
+	for _, testcase := range []struct {
+		name        string
+		args        []string
+		api         mock.API
+		wantError   string
+		wantOutput  []string
+		wantEntries []*fastly.BatchACLEntry
+	}{
+		{
+			name:      "validate missing --to-service flag",
+			args:      args("acl copy --from-service 123 --name foo --version 3"),
+			wantError: "error parsing arguments: required flag --to-service not provided",
+		},
+		{
+			name:      "validate missing source ACL",
+			args:      args("acl copy --from-service 123 --name bar --to-service 456 --version 3"),
+			api:       api(nil),
+			wantError: "ACL 'bar' not found on service 123 version 1",
+		},
+		{
+			name:      "validate missing --autoclone flag",
+			args:      args("acl copy --from-service 123 --name foo --to-service 456 --version 1"),
+			api:       api(nil),
+			wantError: "service version 1 is not editable",
+		},
+		{
+			name: "validate ACL is created on the destination",
+			args: args("acl copy --from-service 123 --name foo --to-service 456 --version 3"),
+			api:  api(nil),
+			wantOutput: []string{
+				"Created ACL 'foo' (id: dst, service: 456, version: 3)",
+				"Copied ACL 'foo' from service 123 version 1 to service 456 version 3 (2 entries changed)",
+			},
+			wantEntries: []*fastly.BatchACLEntry{
+				{Operation: fastly.CreateBatchOperation, IP: fastly.String("127.0.0.1"), Negated: fastly.Bool(false), Comment: fastly.String("localhost")},
+				{Operation: fastly.CreateBatchOperation, IP: fastly.String("10.0.0.0"), Subnet: fastly.Int(8), Negated: fastly.Bool(true), Comment: fastly.String("")},
+			},
+		},
+		{
+			name: "validate existing entries are updated on an autocloned version",
+			args: args("acl copy --autoclone --from-service 123 --from-version latest --name foo --to-service 456 --version 1"),
+			api:  api([]*fastly.ACL{{ID: "dst", Name: "foo"}}),
+			wantOutput: []string{
+				"Copied ACL 'foo' from service 123 version 3 to service 456 version 4 (1 entries changed)",
+			},
+			wantEntries: []*fastly.BatchACLEntry{
+				{Operation: fastly.UpdateBatchOperation, ID: fastly.String("b"), Negated: fastly.Bool(true), Comment: fastly.String("")},
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			batches = nil
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantEntries != nil {
+				testutil.AssertEqual(t, [][]*fastly.BatchACLEntry{testcase.wantEntries}, batches)
+			}
+		})
+	}
+}
+
 func TestACLCreate(t *testing.T) {
 	args := testutil.Args
 	scenarios := []testutil.TestScenario{
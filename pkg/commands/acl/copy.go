@@ -0,0 +1,221 @@
+package acl
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// NewCopyCommand returns a usable command registered under the parent.
+func NewCopyCommand(parent cmd.Registerer, globals *config.Data) *CopyCommand {
+	var c CopyCommand
+	c.CmdClause = parent.Command("copy", "Copy an ACL and its entries to another service, creating it on the destination version if it doesn't exist")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.CmdClause.Flag("from-service", "Service ID to copy the ACL from").Required().StringVar(&c.fromService)
+	c.CmdClause.Flag("name", "The name of the ACL to copy").Required().StringVar(&c.name)
+	c.CmdClause.Flag("to-service", "Service ID to copy the ACL to").Required().StringVar(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+
+	// Optional flags
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("from-version", "'latest', 'active', or the number of a specific version of the service to copy from").Default("active").StringVar(&c.fromVersion.Value)
+
+	return &c
+}
+
+// CopyCommand calls the Fastly API to copy an ACL and its entries from one
+// service to another.
+type CopyCommand struct {
+	cmd.Base
+
+	autoClone      cmd.OptionalAutoClone
+	fromService    string
+	fromVersion    cmd.OptionalServiceVersion
+	manifest       manifest.Data
+	name           string
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// Exec invokes the application logic for the command.
+func (c *CopyCommand) Exec(in io.Reader, out io.Writer) error {
+	fromVersion, err := c.fromVersion.Parse(c.fromService, c.Globals.Client)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": c.fromService,
+		})
+		return err
+	}
+
+	src, err := findACL(c.Globals.Client, c.fromService, fromVersion.Number, c.name)
+	if err == nil && src == nil {
+		err = fmt.Errorf("ACL '%s' not found on service %s version %d", c.name, c.fromService, fromVersion.Number)
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      c.fromService,
+			"Service Version": fromVersion.Number,
+		})
+		return err
+	}
+
+	entries, err := api.ListAllACLEntries(c.Globals.Client, &fastly.ListACLEntriesInput{
+		ServiceID: c.fromService,
+		ACLID:     src.ID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": c.fromService,
+			"ACL ID":     src.ID,
+		})
+		return err
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	var existing []*fastly.ACLEntry
+	dst, err := findACL(c.Globals.Client, serviceID, serviceVersion.Number, c.name)
+	switch {
+	case err != nil:
+	case dst == nil:
+		dst, err = c.Globals.Client.CreateACL(&fastly.CreateACLInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+			Name:           c.name,
+		})
+		if err == nil {
+			text.Info(out, "Created ACL '%s' (id: %s, service: %s, version: %d)", dst.Name, dst.ID, serviceID, serviceVersion.Number)
+		}
+	default:
+		existing, err = api.ListAllACLEntries(c.Globals.Client, &fastly.ListACLEntriesInput{
+			ServiceID: serviceID,
+			ACLID:     dst.ID,
+		})
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	ops := copyOperations(entries, existing)
+	for start := 0; start < len(ops); start += fastly.BatchModifyMaximumOperations {
+		end := start + fastly.BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+		err := c.Globals.Client.BatchModifyACLEntries(&fastly.BatchModifyACLEntriesInput{
+			ServiceID: serviceID,
+			ACLID:     dst.ID,
+			Entries:   ops[start:end],
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":  serviceID,
+				"ACL ID":      dst.ID,
+				"Batch start": start,
+			})
+			if start > 0 {
+				return fmt.Errorf("error after copying %d of %d entries: %w", start, len(ops), err)
+			}
+			return err
+		}
+	}
+
+	text.Success(out, "Copied ACL '%s' from service %s version %d to service %s version %d (%d entries changed)", c.name, c.fromService, fromVersion.Number, serviceID, serviceVersion.Number, len(ops))
+	return nil
+}
+
+// copyOperations returns the batch operations that copy the source entries
+// into an ACL with the existing entries. Entries for the same IP address and
+// subnet are updated in place, as the API rejects duplicates.
+func copyOperations(entries, existing []*fastly.ACLEntry) []*fastly.BatchACLEntry {
+	key := func(e *fastly.ACLEntry) string {
+		return fmt.Sprintf("%s/%d", e.IP, e.Subnet)
+	}
+
+	have := make(map[string]*fastly.ACLEntry, len(existing))
+	for _, e := range existing {
+		if e.DeletedAt == nil {
+			have[key(e)] = e
+		}
+	}
+
+	var ops []*fastly.BatchACLEntry
+	for _, e := range entries {
+		if e.DeletedAt != nil {
+			continue
+		}
+		current, ok := have[key(e)]
+		switch {
+		case !ok:
+			op := &fastly.BatchACLEntry{
+				Operation: fastly.CreateBatchOperation,
+				IP:        fastly.String(e.IP),
+				Negated:   fastly.Bool(e.Negated),
+				Comment:   fastly.String(e.Comment),
+			}
+			if e.Subnet > 0 {
+				op.Subnet = fastly.Int(e.Subnet)
+			}
+			ops = append(ops, op)
+		case current.Negated != e.Negated || current.Comment != e.Comment:
+			ops = append(ops, &fastly.BatchACLEntry{
+				Operation: fastly.UpdateBatchOperation,
+				ID:        fastly.String(current.ID),
+				Negated:   fastly.Bool(e.Negated),
+				Comment:   fastly.String(e.Comment),
+			})
+		}
+	}
+	return ops
+}
+
+// findACL returns the ACL with the given name on a service version, or nil if
+// there isn't one.
+func findACL(client api.Interface, serviceID string, serviceVersion int, name string) (*fastly.ACL, error) {
+	acls, err := client.ListACLs(&fastly.ListACLsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range acls {
+		if a.Name == name {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
@@ -26,7 +26,7 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
 	})
 
 	// Optional Flags
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 
 	return &c
 }
@@ -68,6 +68,10 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, as)
+	}
+
 	if c.Globals.Verbose() {
 		c.printVerbose(out, serviceID, serviceVersion.Number, as)
 	} else {
@@ -2,8 +2,13 @@ package aclentry_test
 
 import (
 	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/app"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
@@ -294,6 +299,89 @@ func TestACLEntryUpdate(t *testing.T) {
 			Args:       args(`acl-entry update --acl-id 123 --file {"entries":[{"op":"create","ip":"127.0.0.1","subnet":8},{"op":"update"},{"op":"upsert"}]} --id 456 --service-id 123`),
 			WantOutput: "Updated 3 ACL entries (service: 123)",
 		},
+		{
+			Name: "validate batch error",
+			API: mock.API{
+				BatchModifyACLEntriesFn: func(i *fastly.BatchModifyACLEntriesInput) error {
+					return testutil.Err
+				},
+			},
+			Args:      args("acl-entry update --acl-id 123 --file testdata/batch.json --id 456 --service-id 123"),
+			WantError: testutil.Err.Error(),
+		},
+	}
+
+	for _, testcase := range scenarios {
+		t.Run(testcase.Name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.Args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.API)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.WantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.WantOutput)
+		})
+	}
+}
+
+func TestACLEntryExport(t *testing.T) {
+	args := testutil.Args
+	scenarios := []testutil.TestScenario{
+		{
+			Name:      "validate missing --acl-id flag",
+			Args:      args("acl-entry export --service-id 123"),
+			WantError: "error parsing arguments: required flag --acl-id not provided",
+		},
+		{
+			Name:      "validate ListACLEntries API error",
+			API:       mock.API{GetFn: testutil.GetError},
+			Args:      args("acl-entry export --acl-id 123 --service-id 123"),
+			WantError: testutil.Err.Error(),
+		},
+		{
+			Name: "validate json format",
+			API:  mock.API{GetFn: testutil.PaginatedGet(aclEntriesForSync)},
+			Args: args("acl-entry export --acl-id 123 --service-id 123"),
+			WantOutput: `[
+  {
+    "ip": "127.0.0.1",
+    "negated": false
+  },
+  {
+    "ip": "10.0.0.0",
+    "subnet": 8,
+    "negated": false
+  },
+  {
+    "ip": "192.168.0.1",
+    "subnet": 16,
+    "negated": false
+  }
+]
+`,
+		},
+		{
+			Name:       "validate csv format",
+			API:        mock.API{GetFn: testutil.PaginatedGet(aclEntriesForSync)},
+			Args:       args("acl-entry export --acl-id 123 --format csv --service-id 123"),
+			WantOutput: "ip,subnet,negated,comment\n127.0.0.1,,false,\n10.0.0.0,8,false,\n192.168.0.1,16,false,\n",
+		},
+		{
+			Name: "validate batch format",
+			API:  mock.API{GetFn: testutil.PaginatedGet(aclEntriesForSync)},
+			Args: args("acl-entry export --acl-id 123 --format batch --service-id 123"),
+			WantOutput: `{
+      "op": "create",
+      "ip": "10.0.0.0",
+      "subnet": 8,
+      "negated": false
+    }`,
+		},
+		{
+			Name:       "validate sync format",
+			API:        mock.API{GetFn: testutil.PaginatedGet(aclEntriesForSync)},
+			Args:       args("acl-entry export --acl-id 123 --format sync --service-id 123"),
+			WantOutput: "127.0.0.1\n10.0.0.0/8\n192.168.0.1/16\n",
+		},
 	}
 
 	for _, testcase := range scenarios {
@@ -308,6 +396,307 @@ func TestACLEntryUpdate(t *testing.T) {
 	}
 }
 
+func TestACLEntryUpdateBatched(t *testing.T) {
+	var entries []string
+	for i := 0; i < 2500; i++ {
+		entries = append(entries, fmt.Sprintf(`{"op":"create","ip":"10.0.%d.%d"}`, i/256, i%256))
+	}
+	filePath := filepath.Join(t.TempDir(), "batch.json")
+	if err := os.WriteFile(filePath, []byte(`{"entries":[`+strings.Join(entries, ",")+`]}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, testcase := range []struct {
+		name       string
+		failAt     int
+		wantError  string
+		wantOutput string
+		wantSizes  []int
+	}{
+		{
+			name:       "success",
+			wantOutput: "Updated 2500 ACL entries (service: 123)",
+			wantSizes:  []int{1000, 1000, 500},
+		},
+		{
+			name:      "partial failure",
+			failAt:    2,
+			wantError: "error after applying 1000 of 2500 changes: " + testutil.Err.Error(),
+			wantSizes: []int{1000, 1000},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var sizes []int
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("acl-entry update --acl-id 123 --file "+filePath+" --service-id 123"), &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				BatchModifyACLEntriesFn: func(i *fastly.BatchModifyACLEntriesInput) error {
+					sizes = append(sizes, len(i.Entries))
+					if len(sizes) == testcase.failAt {
+						return testutil.Err
+					}
+					return nil
+				},
+			})
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			testutil.AssertEqual(t, testcase.wantSizes, sizes)
+		})
+	}
+}
+
+func TestACLEntryExportPages(t *testing.T) {
+	var entries []*fastly.ACLEntry
+	for i := 0; i < api.PageSize+1; i++ {
+		entries = append(entries, &fastly.ACLEntry{ID: fmt.Sprintf("%03d", i), IP: fmt.Sprintf("192.0.2.%d", i)})
+	}
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("acl-entry export --acl-id 123 --format csv --service-id 123"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{GetFn: testutil.PaginatedGet(entries)})
+	testutil.AssertNoError(t, app.Run(opts))
+	testutil.AssertEqual(t, api.PageSize+2, strings.Count(stdout.String(), "\n"))
+	testutil.AssertStringContains(t, stdout.String(), "192.0.2.100,,false,\n")
+}
+
+func TestACLEntryExportToFile(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "batch.json")
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("acl-entry export --acl-id 123 --file "+filePath+" --format batch --service-id 123"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{GetFn: testutil.PaginatedGet(aclEntriesForSync)})
+	testutil.AssertNoError(t, app.Run(opts))
+	testutil.AssertStringContains(t, stdout.String(), "Exported 3 ACL entries (acl: 123, service: 123) to "+filePath)
+
+	// The batch format is replayed by the update command.
+	var entries []*fastly.BatchACLEntry
+	stdout.Reset()
+	opts = testutil.NewRunOpts(testutil.Args("acl-entry update --acl-id 456 --file "+filePath+" --service-id 789"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{
+		BatchModifyACLEntriesFn: func(i *fastly.BatchModifyACLEntriesInput) error {
+			entries = i.Entries
+			return nil
+		},
+	})
+	testutil.AssertNoError(t, app.Run(opts))
+	testutil.AssertStringContains(t, stdout.String(), "Updated 3 ACL entries (service: 789)")
+	testutil.AssertEqual(t, 3, len(entries))
+	testutil.AssertEqual(t, "192.168.0.1", *entries[2].IP)
+}
+
+func TestACLEntryExportSyncRoundTrip(t *testing.T) {
+	entries := append([]*fastly.ACLEntry{
+		{ID: "5", IP: "0.0.0.0", Negated: true, Comment: "everything\nelse # really"},
+	}, aclEntriesForSync...)
+	filePath := filepath.Join(t.TempDir(), "blocklist.txt")
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("acl-entry export --acl-id 123 --file "+filePath+" --format sync --service-id 123"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{GetFn: testutil.PaginatedGet(entries)})
+	testutil.AssertNoError(t, app.Run(opts))
+	testutil.AssertStringContains(t, stdout.String(), "Exported 4 ACL entries (acl: 123, service: 123) to "+filePath)
+
+	// The sync format is read by the sync command, which finds nothing to do.
+	stdout.Reset()
+	opts = testutil.NewRunOpts(testutil.Args("acl-entry sync --acl-id 123 --file "+filePath+" --prune --service-id 123"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{GetFn: testutil.PaginatedGet(entries)})
+	testutil.AssertNoError(t, app.Run(opts))
+	testutil.AssertStringContains(t, stdout.String(), "ACL 123 on service 123 is already in sync with "+filePath)
+}
+
+func TestACLEntrySync(t *testing.T) {
+	args := testutil.Args
+	var batches [][]*fastly.BatchACLEntry
+	recordBatch := func(i *fastly.BatchModifyACLEntriesInput) error {
+		batches = append(batches, i.Entries)
+		return nil
+	}
+
+	var many strings.Builder
+	var manyEntries []*fastly.ACLEntry
+	for i := 0; i < 2500; i++ {
+		fmt.Fprintf(&many, "10.0.%d.%d\n", i/256, i%256)
+		manyEntries = append(manyEntries, &fastly.ACLEntry{ID: fmt.Sprintf("%04d", i), IP: fmt.Sprintf("10.0.%d.%d", i/256, i%256)})
+	}
+
+	for _, testcase := range []struct {
+		name        string
+		args        []string
+		fileData    string
+		api         mock.API
+		wantError   string
+		wantOutput  []string
+		wantBatches []int
+		wantEntries []*fastly.BatchACLEntry
+	}{
+		{
+			name:      "missing file flag",
+			args:      args("acl-entry sync --acl-id 123 --service-id 123"),
+			wantError: "error parsing arguments: required flag --file not provided",
+		},
+		{
+			name:      "invalid IP address",
+			args:      args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData:  "# blocklist\n127.0.0.1\n\n127.0.0.256/32\n",
+			wantError: "line 4: invalid IP address '127.0.0.256'",
+		},
+		{
+			name:      "invalid subnet",
+			args:      args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData:  "127.0.0.1/33\n",
+			wantError: "invalid subnet '33' for 127.0.0.1 (must be between 0 and 32)",
+		},
+		{
+			name:      "conflicting entries",
+			args:      args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData:  "127.0.0.1\n!127.0.0.1\n",
+			wantError: "line 2: !127.0.0.1 conflicts with 127.0.0.1",
+		},
+		{
+			name:      "list error",
+			args:      args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData:  "127.0.0.1\n",
+			api:       mock.API{GetFn: testutil.GetError},
+			wantError: testutil.Err.Error(),
+		},
+		{
+			name:     "dry run",
+			args:     args("acl-entry sync --acl-id 123 --dry-run --file filePath --prune --service-id 123"),
+			fileData: "127.0.0.1 # localhost\n!10.0.0.0/8\n2001:0db8::/32\n",
+			api:      mock.API{GetFn: testutil.PaginatedGet(aclEntriesForSync)},
+			wantOutput: []string{
+				"~ 10.0.0.0/8 -> !10.0.0.0/8",
+				"+ 2001:db8::/32",
+				"- 192.168.0.1/16",
+				"Dry run: 1 entries would be added, 1 updated and 1 removed",
+			},
+		},
+		{
+			name:       "already in sync",
+			args:       args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData:   "127.0.0.1\n10.0.0.0/8\n",
+			api:        mock.API{GetFn: testutil.PaginatedGet(aclEntriesForSync)},
+			wantOutput: []string{"ACL 123 on service 123 is already in sync with"},
+		},
+		{
+			name:     "without prune",
+			args:     args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData: "127.0.0.1\n!10.0.0.0/8\n::1\n",
+			api: mock.API{
+				GetFn:                   testutil.PaginatedGet(aclEntriesForSync),
+				BatchModifyACLEntriesFn: recordBatch,
+			},
+			wantOutput:  []string{"Synced ACL 123 on service 123 (1 added, 1 updated, 0 removed)"},
+			wantBatches: []int{2},
+			wantEntries: []*fastly.BatchACLEntry{
+				{Operation: fastly.UpdateBatchOperation, ID: fastly.String("2"), Negated: fastly.Bool(true)},
+				{Operation: fastly.CreateBatchOperation, IP: fastly.String("::1"), Negated: fastly.Bool(false)},
+			},
+		},
+		{
+			name:      "subnet conflicts with a host entry",
+			args:      args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData:  "0.0.0.0\n0.0.0.0/0\n",
+			wantError: "line 2: 0.0.0.0/0 conflicts with 0.0.0.0",
+		},
+		{
+			name:     "explicit zero subnet",
+			args:     args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData: "127.0.0.1\n0.0.0.0/0\n",
+			api: mock.API{
+				GetFn:                   testutil.PaginatedGet(aclEntriesForSync),
+				BatchModifyACLEntriesFn: recordBatch,
+			},
+			wantOutput:  []string{"Synced ACL 123 on service 123 (1 added, 0 updated, 0 removed)"},
+			wantBatches: []int{1},
+			wantEntries: []*fastly.BatchACLEntry{
+				{Operation: fastly.CreateBatchOperation, IP: fastly.String("0.0.0.0"), Subnet: fastly.Int(0), Negated: fastly.Bool(false)},
+			},
+		},
+		{
+			name:     "with prune",
+			args:     args("acl-entry sync --acl-id 123 --file filePath --prune --service-id 123"),
+			fileData: "127.0.0.1\n10.0.0.0/8\n",
+			api: mock.API{
+				GetFn:                   testutil.PaginatedGet(aclEntriesForSync),
+				BatchModifyACLEntriesFn: recordBatch,
+			},
+			wantOutput:  []string{"Synced ACL 123 on service 123 (0 added, 0 updated, 1 removed)"},
+			wantBatches: []int{1},
+			wantEntries: []*fastly.BatchACLEntry{
+				{Operation: fastly.DeleteBatchOperation, ID: fastly.String("3")},
+			},
+		},
+		{
+			name:     "batched",
+			args:     args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData: many.String(),
+			api: mock.API{
+				GetFn:                   testutil.PaginatedGet(aclEntriesForSync),
+				BatchModifyACLEntriesFn: recordBatch,
+			},
+			wantOutput:  []string{"Synced ACL 123 on service 123 (2500 added, 0 updated, 0 removed)"},
+			wantBatches: []int{1000, 1000, 500},
+		},
+		{
+			name:       "multiple pages",
+			args:       args("acl-entry sync --acl-id 123 --file filePath --prune --service-id 123"),
+			fileData:   many.String(),
+			api:        mock.API{GetFn: testutil.PaginatedGet(manyEntries)},
+			wantOutput: []string{"ACL 123 on service 123 is already in sync with"},
+		},
+		{
+			name:     "batch error",
+			args:     args("acl-entry sync --acl-id 123 --file filePath --service-id 123"),
+			fileData: "::1\n",
+			api: mock.API{
+				GetFn: testutil.PaginatedGet(aclEntriesForSync),
+				BatchModifyACLEntriesFn: func(i *fastly.BatchModifyACLEntriesInput) error {
+					return testutil.Err
+				},
+			},
+			wantError: testutil.Err.Error(),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			batches = nil
+			if testcase.fileData != "" {
+				filePath := filepath.Join(t.TempDir(), "blocklist.txt")
+				if err := os.WriteFile(filePath, []byte(testcase.fileData), 0600); err != nil {
+					t.Fatal(err)
+				}
+				for i, v := range testcase.args {
+					if v == "filePath" {
+						testcase.args[i] = filePath
+					}
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+
+			sizes := make([]int, 0, len(batches))
+			for _, b := range batches {
+				sizes = append(sizes, len(b))
+			}
+			if testcase.wantBatches == nil {
+				testcase.wantBatches = []int{}
+			}
+			testutil.AssertEqual(t, testcase.wantBatches, sizes)
+			if testcase.wantEntries != nil {
+				testutil.AssertEqual(t, testcase.wantEntries, batches[0])
+			}
+		})
+	}
+}
+
 func getACLEntry(i *fastly.GetACLEntryInput) (*fastly.ACLEntry, error) {
 	t := testutil.Date
 
@@ -352,3 +741,10 @@ func listACLEntries(i *fastly.ListACLEntriesInput) ([]*fastly.ACLEntry, error) {
 	}
 	return vs, nil
 }
+
+var aclEntriesForSync = []*fastly.ACLEntry{
+	{ID: "1", IP: "127.0.0.1"},
+	{ID: "2", IP: "10.0.0.0", Subnet: 8},
+	{ID: "3", IP: "192.168.0.1", Subnet: 16},
+	{ID: "4", IP: "192.168.0.2", DeletedAt: &testutil.Date},
+}
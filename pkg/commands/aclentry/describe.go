@@ -24,7 +24,7 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCo
 	c.CmdClause.Flag("id", "Alphanumeric string identifying an ACL Entry").Required().StringVar(&c.id)
 
 	// Optional Flags
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 
 	return &c
 }
@@ -57,6 +57,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.JSONOutput() {
+		return c.WriteJSON(out, a)
+	}
+
 	c.print(out, a)
 	return nil
 }
@@ -0,0 +1,175 @@
+package aclentry
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// NewExportCommand returns a usable command registered under the parent.
+func NewExportCommand(parent cmd.Registerer, globals *config.Data) *ExportCommand {
+	var c ExportCommand
+	c.CmdClause = parent.Command("export", "Export the entries of an ACL as CSV, JSON, batch update JSON or a sync file")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.CmdClause.Flag("acl-id", "Alphanumeric string identifying a ACL").Required().StringVar(&c.aclID)
+
+	// Optional flags
+	c.CmdClause.Flag("file", "Path to write the entries to (defaults to stdout)").StringVar(&c.file)
+	c.CmdClause.Flag("format", "Format of the exported entries: batch can be read by update --file and sync by sync --file").Default("json").HintOptions(exportFormats...).EnumVar(&c.format, exportFormats...)
+	c.RegisterServiceIDFlag(&c.manifest)
+
+	return &c
+}
+
+var exportFormats = []string{"batch", "csv", "json", "sync"}
+
+// ExportCommand calls the Fastly API to write the entries of an ACL to a file.
+type ExportCommand struct {
+	cmd.Base
+
+	aclID    string
+	file     string
+	format   string
+	manifest manifest.Data
+}
+
+// exportEntry is the representation of an ACL entry in the json format.
+type exportEntry struct {
+	IP      string `json:"ip"`
+	Subnet  int    `json:"subnet,omitempty"`
+	Negated bool   `json:"negated"`
+	Comment string `json:"comment,omitempty"`
+}
+
+// Exec invokes the application logic for the command.
+func (c *ExportCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		err := errors.ErrNoServiceID
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	entries, err := api.ListAllACLEntries(c.Globals.Client, &fastly.ListACLEntriesInput{
+		ServiceID: serviceID,
+		ACLID:     c.aclID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"ACL ID":     c.aclID,
+		})
+		return err
+	}
+
+	var live []*fastly.ACLEntry
+	for _, e := range entries {
+		if e.DeletedAt == nil {
+			live = append(live, e)
+		}
+	}
+
+	data, err := encodeEntries(live, c.format)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.file == "" {
+		_, err = out.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(c.file, data, 0600); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return err
+	}
+	text.Success(out, "Exported %d ACL entries (acl: %s, service: %s) to %s", len(live), c.aclID, serviceID, c.file)
+	return nil
+}
+
+// encodeEntries encodes ACL entries in the given export format. The batch
+// format is that read by the update command's --file flag, creating each entry
+// so that it can be replayed into another ACL. The sync format is the one entry
+// per line file read by the sync command, with comments kept after a '#'.
+func encodeEntries(entries []*fastly.ACLEntry, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case "csv":
+		w := csv.NewWriter(&buf)
+		if err := w.Write([]string{"ip", "subnet", "negated", "comment"}); err != nil {
+			return nil, err
+		}
+		for _, e := range entries {
+			var subnet string
+			if e.Subnet > 0 {
+				subnet = strconv.Itoa(e.Subnet)
+			}
+			if err := w.Write([]string{e.IP, subnet, strconv.FormatBool(e.Negated), e.Comment}); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+	case "batch":
+		input := fastly.BatchModifyACLEntriesInput{
+			Entries: make([]*fastly.BatchACLEntry, 0, len(entries)),
+		}
+		for _, e := range entries {
+			op := &fastly.BatchACLEntry{
+				Operation: fastly.CreateBatchOperation,
+				IP:        fastly.String(e.IP),
+				Negated:   fastly.Bool(e.Negated),
+			}
+			if e.Subnet > 0 {
+				op.Subnet = fastly.Int(e.Subnet)
+			}
+			if e.Comment != "" {
+				op.Comment = fastly.String(e.Comment)
+			}
+			input.Entries = append(input.Entries, op)
+		}
+		return marshalIndent(input)
+	case "sync":
+		for _, e := range entries {
+			buf.WriteString(entryFromAPI(e).String())
+			if e.Comment != "" {
+				buf.WriteString(" # " + strings.Join(strings.Fields(e.Comment), " "))
+			}
+			buf.WriteByte('\n')
+		}
+		return buf.Bytes(), nil
+	default:
+		out := make([]exportEntry, 0, len(entries))
+		for _, e := range entries {
+			out = append(out, exportEntry{IP: e.IP, Subnet: e.Subnet, Negated: e.Negated, Comment: e.Comment})
+		}
+		return marshalIndent(out)
+	}
+}
+
+func marshalIndent(v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
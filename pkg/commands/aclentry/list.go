@@ -24,7 +24,7 @@ func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
 	c.CmdClause.Flag("acl-id", "Alphanumeric string identifying a ACL").Required().StringVar(&c.aclID)
 
 	// Optional Flags
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 
 	return &c
 }
@@ -56,6 +56,10 @@ func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, as)
+	}
+
 	if c.Globals.Verbose() {
 		c.printVerbose(out, serviceID, as)
 	} else {
@@ -0,0 +1,329 @@
+package aclentry
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// NewSyncCommand returns a usable command registered under the parent.
+func NewSyncCommand(parent cmd.Registerer, globals *config.Data) *SyncCommand {
+	var c SyncCommand
+	c.CmdClause = parent.Command("sync", "Sync the entries of an ACL with a file of IP addresses and CIDR ranges")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.CmdClause.Flag("acl-id", "Alphanumeric string identifying a ACL").Required().StringVar(&c.aclID)
+	c.CmdClause.Flag("file", "Path to a file with an IP address per line, with an optional /subnet and ! prefix to negate the match").Required().StringVar(&c.file)
+
+	// Optional flags
+	c.CmdClause.Flag("dry-run", "Print the changes that would be made without making them").BoolVar(&c.dryRun)
+	c.CmdClause.Flag("prune", "Delete entries that are absent from the file").BoolVar(&c.prune)
+	c.RegisterServiceIDFlag(&c.manifest)
+
+	return &c
+}
+
+// SyncCommand calls the Fastly API to make an ACL match a file of entries.
+type SyncCommand struct {
+	cmd.Base
+
+	aclID    string
+	dryRun   bool
+	file     string
+	manifest manifest.Data
+	prune    bool
+}
+
+// Entry is an ACL entry as it's written in a sync file.
+type Entry struct {
+	IP     string
+	Subnet int
+	// HasSubnet is set when a subnet is given, so that an explicit /0 can be
+	// told apart from an entry without a subnet.
+	HasSubnet bool
+	Negated   bool
+}
+
+// String formats the entry as it's written in a sync file.
+func (e Entry) String() string {
+	s := e.IP
+	if e.Negated {
+		s = "!" + s
+	}
+	if e.HasSubnet {
+		s = fmt.Sprintf("%s/%d", s, e.Subnet)
+	}
+	return s
+}
+
+// key identifies the address range of the entry, regardless of negation.
+//
+// The API reports an entry without a subnet as having a subnet of 0, so such
+// an entry shares its key with an explicit /0.
+func (e Entry) key() string {
+	return fmt.Sprintf("%s/%d", e.IP, e.Subnet)
+}
+
+// Exec invokes the application logic for the command.
+func (c *SyncCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		err := errors.ErrNoServiceID
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	want, err := ReadEntries(c.file)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return err
+	}
+
+	entries, err := api.ListAllACLEntries(c.Globals.Client, &fastly.ListACLEntriesInput{
+		ServiceID: serviceID,
+		ACLID:     c.aclID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"ACL ID":     c.aclID,
+		})
+		return err
+	}
+
+	ops, summary := diffEntries(entries, want, c.prune)
+	if len(ops) == 0 {
+		text.Info(out, "ACL %s on service %s is already in sync with %s", c.aclID, serviceID, c.file)
+		return nil
+	}
+
+	if c.dryRun || c.Globals.Verbose() {
+		for _, line := range summary {
+			text.Output(out, line)
+		}
+	}
+
+	added, updated, removed := countOperations(ops)
+	if c.dryRun {
+		text.Info(out, "Dry run: %d entries would be added, %d updated and %d removed", added, updated, removed)
+		return nil
+	}
+
+	for start := 0; start < len(ops); start += fastly.BatchModifyMaximumOperations {
+		end := start + fastly.BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+		err := c.Globals.Client.BatchModifyACLEntries(&fastly.BatchModifyACLEntriesInput{
+			ServiceID: serviceID,
+			ACLID:     c.aclID,
+			Entries:   ops[start:end],
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":  serviceID,
+				"ACL ID":      c.aclID,
+				"Batch start": start,
+			})
+			if start > 0 {
+				return fmt.Errorf("error after applying %d of %d changes: %w", start, len(ops), err)
+			}
+			return err
+		}
+	}
+
+	text.Success(out, "Synced ACL %s on service %s (%d added, %d updated, %d removed)", c.aclID, serviceID, added, updated, removed)
+	return nil
+}
+
+// diffEntries returns the batch operations that turn the existing entries into
+// the entries we want, along with a human readable summary of each operation.
+// Entries absent from want are only removed when prune is set.
+func diffEntries(entries []*fastly.ACLEntry, want []Entry, prune bool) ([]*fastly.BatchACLEntry, []string) {
+	have := make(map[string]*fastly.ACLEntry, len(entries))
+	for _, e := range entries {
+		if e.DeletedAt != nil {
+			continue
+		}
+		have[entryFromAPI(e).key()] = e
+	}
+
+	type change struct {
+		op      *fastly.BatchACLEntry
+		summary string
+		sortKey string
+	}
+	var changes []change
+
+	wanted := make(map[string]bool, len(want))
+	for _, e := range want {
+		wanted[e.key()] = true
+		current, ok := have[e.key()]
+		switch {
+		case !ok:
+			op := &fastly.BatchACLEntry{
+				Operation: fastly.CreateBatchOperation,
+				IP:        fastly.String(e.IP),
+				Negated:   fastly.Bool(e.Negated),
+			}
+			if e.HasSubnet {
+				op.Subnet = fastly.Int(e.Subnet)
+			}
+			changes = append(changes, change{op, "+ " + e.String(), e.key()})
+		case current.Negated != e.Negated:
+			op := &fastly.BatchACLEntry{
+				Operation: fastly.UpdateBatchOperation,
+				ID:        fastly.String(current.ID),
+				Negated:   fastly.Bool(e.Negated),
+			}
+			changes = append(changes, change{op, fmt.Sprintf("~ %s -> %s", entryFromAPI(current), e), e.key()})
+		}
+	}
+
+	if prune {
+		for k, e := range have {
+			if wanted[k] {
+				continue
+			}
+			op := &fastly.BatchACLEntry{
+				Operation: fastly.DeleteBatchOperation,
+				ID:        fastly.String(e.ID),
+			}
+			changes = append(changes, change{op, "- " + entryFromAPI(e).String(), k})
+		}
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].sortKey < changes[j].sortKey
+	})
+
+	ops := make([]*fastly.BatchACLEntry, len(changes))
+	summary := make([]string, len(changes))
+	for i, ch := range changes {
+		ops[i] = ch.op
+		summary[i] = ch.summary
+	}
+	return ops, summary
+}
+
+func countOperations(ops []*fastly.BatchACLEntry) (added, updated, removed int) {
+	for _, op := range ops {
+		switch op.Operation {
+		case fastly.CreateBatchOperation:
+			added++
+		case fastly.UpdateBatchOperation:
+			updated++
+		case fastly.DeleteBatchOperation:
+			removed++
+		}
+	}
+	return added, updated, removed
+}
+
+// entryFromAPI normalises an ACL entry returned by the API so that it can be
+// compared with entries read from a file.
+func entryFromAPI(e *fastly.ACLEntry) Entry {
+	ip := e.IP
+	if parsed := net.ParseIP(ip); parsed != nil {
+		ip = parsed.String()
+	}
+	return Entry{IP: ip, Subnet: e.Subnet, HasSubnet: e.Subnet > 0, Negated: e.Negated}
+}
+
+// ReadEntries reads ACL entries from a file with an IPv4 or IPv6 address per
+// line, each optionally followed by a /subnet and preceded by ! to negate the
+// match. Blank lines and anything following a # are ignored.
+func ReadEntries(path string) ([]Entry, error) {
+	/* #nosec */
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var (
+		entries []Entry
+		seen    = make(map[string]Entry)
+		s       = bufio.NewScanner(f)
+		line    int
+	)
+	for s.Scan() {
+		line++
+		text := s.Text()
+		if i := strings.Index(text, "#"); i >= 0 {
+			text = text[:i]
+		}
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		e, err := parseEntry(text)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing %s line %d: %w", path, line, err)
+		}
+		if prev, ok := seen[e.key()]; ok {
+			if prev.Negated != e.Negated || prev.HasSubnet != e.HasSubnet {
+				return nil, fmt.Errorf("error parsing %s line %d: %s conflicts with %s", path, line, e, prev)
+			}
+			continue
+		}
+		seen[e.key()] = e
+		entries = append(entries, e)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+func parseEntry(s string) (Entry, error) {
+	var e Entry
+	if strings.HasPrefix(s, "!") {
+		e.Negated = true
+		s = strings.TrimSpace(s[1:])
+	}
+
+	addr := s
+	if i := strings.Index(s, "/"); i >= 0 {
+		addr = s[:i]
+		subnet, err := strconv.Atoi(s[i+1:])
+		if err != nil {
+			return e, fmt.Errorf("invalid subnet '%s'", s[i+1:])
+		}
+		e.Subnet = subnet
+		e.HasSubnet = true
+	}
+
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return e, fmt.Errorf("invalid IP address '%s'", addr)
+	}
+	bits := 128
+	if ip.To4() != nil {
+		bits = 32
+	}
+	if e.Subnet < 0 || e.Subnet > bits {
+		return e, fmt.Errorf("invalid subnet '%d' for %s (must be between 0 and %d)", e.Subnet, addr, bits)
+	}
+	e.IP = ip.String()
+	return e, nil
+}
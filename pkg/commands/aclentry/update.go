@@ -30,7 +30,7 @@ func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateComman
 	c.CmdClause.Flag("id", "Alphanumeric string identifying an ACL Entry").Action(c.id.Set).StringVar(&c.id.Value)
 	c.CmdClause.Flag("ip", "An IP address").Action(c.ip.Set).StringVar(&c.ip.Value)
 	c.CmdClause.Flag("negated", "Whether to negate the match").Action(c.negated.Set).BoolVar(&c.negated.Value)
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 	c.CmdClause.Flag("subnet", "Number of bits for the subnet mask applied to the IP address").Action(c.subnet.Set).IntVar(&c.subnet.Value)
 
 	return &c
@@ -65,15 +65,29 @@ func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
 			return err
 		}
 
-		err = c.Globals.Client.BatchModifyACLEntries(input)
-		if err != nil {
-			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
-				"Service ID": serviceID,
-			})
-			return err
+		// The API accepts a limited number of operations per request, so files
+		// such as those written by export are replayed in chunks.
+		entries := input.Entries
+		for start := 0; start < len(entries); start += fastly.BatchModifyMaximumOperations {
+			end := start + fastly.BatchModifyMaximumOperations
+			if end > len(entries) {
+				end = len(entries)
+			}
+			input.Entries = entries[start:end]
+			err = c.Globals.Client.BatchModifyACLEntries(input)
+			if err != nil {
+				c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+					"Service ID":  serviceID,
+					"Batch start": start,
+				})
+				if start > 0 {
+					return fmt.Errorf("error after applying %d of %d changes: %w", start, len(entries), err)
+				}
+				return err
+			}
 		}
 
-		text.Success(out, "Updated %d ACL entries (service: %s)", len(input.Entries), serviceID)
+		text.Success(out, "Updated %d ACL entries (service: %s)", len(entries), serviceID)
 		return nil
 	}
 
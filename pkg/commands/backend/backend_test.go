@@ -141,6 +141,14 @@ func TestBackendList(t *testing.T) {
 			},
 			WantOutput: listBackendsVerboseOutput,
 		},
+		{
+			Args: args("backend list --service-id 123 --version 1 --json"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: listBackendsOK,
+			},
+			WantOutput: listBackendsJSONOutput,
+		},
 		{
 			Args: args("backend list --service-id 123 --version 1"),
 			API: mock.API{
@@ -185,6 +193,14 @@ func TestBackendDescribe(t *testing.T) {
 			},
 			WantOutput: describeBackendOutput,
 		},
+		{
+			Args: args("backend describe --service-id 123 --version 1 --name www.test.com --json"),
+			API: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetBackendFn:   getBackendOK,
+			},
+			WantOutput: describeBackendJSONOutput,
+		},
 	}
 	for _, testcase := range scenarios {
 		t.Run(testcase.Name, func(t *testing.T) {
@@ -386,6 +402,11 @@ var listBackendsVerboseOutput = strings.Join([]string{
 	"		SSL ciphers: []",
 }, "\n") + "\n\n"
 
+var listBackendsJSONOutput = strings.Join([]string{
+	`{"ServiceID":"123","ServiceVersion":1,"Name":"test.com","Comment":"test","Address":"www.test.com","Port":80,"OverrideHost":"","ConnectTimeout":0,"MaxConn":0,"ErrorThreshold":0,"FirstByteTimeout":0,"BetweenBytesTimeout":0,"AutoLoadbalance":false,"Weight":0,"RequestCondition":"","HealthCheck":"","Hostname":"","Shield":"","UseSSL":false,"SSLCheckCert":false,"SSLCACert":"","SSLClientCert":"","SSLClientKey":"","SSLHostname":"","SSLCertHostname":"","SSLSNIHostname":"","MinTLSVersion":"","MaxTLSVersion":"","SSLCiphers":null,"CreatedAt":null,"UpdatedAt":null,"DeletedAt":null}`,
+	`{"ServiceID":"123","ServiceVersion":1,"Name":"example.com","Comment":"example","Address":"www.example.com","Port":443,"OverrideHost":"","ConnectTimeout":0,"MaxConn":0,"ErrorThreshold":0,"FirstByteTimeout":0,"BetweenBytesTimeout":0,"AutoLoadbalance":false,"Weight":0,"RequestCondition":"","HealthCheck":"","Hostname":"","Shield":"","UseSSL":false,"SSLCheckCert":false,"SSLCACert":"","SSLClientCert":"","SSLClientKey":"","SSLHostname":"","SSLCertHostname":"","SSLSNIHostname":"","MinTLSVersion":"","MaxTLSVersion":"","SSLCiphers":null,"CreatedAt":null,"UpdatedAt":null,"DeletedAt":null}`,
+}, "\n") + "\n"
+
 func getBackendOK(i *fastly.GetBackendInput) (*fastly.Backend, error) {
 	return &fastly.Backend{
 		ServiceID:      i.ServiceID,
@@ -429,6 +450,8 @@ var describeBackendOutput = strings.Join([]string{
 	"SSL ciphers: []",
 }, "\n") + "\n"
 
+var describeBackendJSONOutput = `{"ServiceID":"123","ServiceVersion":1,"Name":"test.com","Comment":"test","Address":"www.test.com","Port":80,"OverrideHost":"","ConnectTimeout":0,"MaxConn":0,"ErrorThreshold":0,"FirstByteTimeout":0,"BetweenBytesTimeout":0,"AutoLoadbalance":false,"Weight":0,"RequestCondition":"","HealthCheck":"","Hostname":"","Shield":"","UseSSL":false,"SSLCheckCert":false,"SSLCACert":"","SSLClientCert":"","SSLClientKey":"","SSLHostname":"","SSLCertHostname":"","SSLSNIHostname":"","MinTLSVersion":"","MaxTLSVersion":"","SSLCiphers":null,"CreatedAt":null,"UpdatedAt":null,"DeletedAt":null}` + "\n"
+
 func updateBackendOK(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
 	return &fastly.Backend{
 		ServiceID:      i.ServiceID,
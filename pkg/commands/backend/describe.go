@@ -27,7 +27,7 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCo
 	c.manifest.File.SetOutput(c.Globals.Output)
 	c.manifest.File.Read(manifest.Filename)
 	c.CmdClause = parent.Command("describe", "Show detailed information about a backend on a Fastly service version").Alias("get")
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
 		Dst: &c.serviceVersion.Value,
 	})
@@ -65,6 +65,10 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.JSONOutput() {
+		return c.WriteJSON(out, backend)
+	}
+
 	fmt.Fprintf(out, "Service ID: %s\n", backend.ServiceID)
 	fmt.Fprintf(out, "Version: %d\n", backend.ServiceVersion)
 	text.PrintBackend(out, "", backend)
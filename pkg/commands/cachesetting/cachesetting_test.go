@@ -0,0 +1,350 @@
+package cachesetting_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestCacheSettingCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("cache-setting create --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args:      args("cache-setting create --service-id 123 --version 1 --name static --action cache-all"),
+			wantError: "error parsing arguments: enum value must be one of cache,pass,restart, got 'cache-all'",
+		},
+		{
+			args: args("cache-setting create --service-id 123 --version 1 --name static --autoclone"),
+			api: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				CreateCacheSettingFn: createCacheSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("cache-setting create --service-id 123 --version 1 --name static --action pass --ttl 3600 --stale-ttl 86400 --cache-condition is-static --autoclone"),
+			api: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				CreateCacheSettingFn: createCacheSettingOK,
+			},
+			wantOutput: "Created cache setting static (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestCacheSettingList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("cache-setting list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			wantOutput: listCacheSettingsShortOutput,
+		},
+		{
+			args: args("cache-setting list --service-id 123 --version 1 --verbose"),
+			api: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsOK,
+			},
+			wantOutput: listCacheSettingsVerboseOutput,
+		},
+		{
+			args: args("cache-setting list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				ListCacheSettingsFn: listCacheSettingsError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCacheSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("cache-setting describe --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("cache-setting describe --service-id 123 --version 1 --name static"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("cache-setting describe --service-id 123 --version 1 --name static"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				GetCacheSettingFn: getCacheSettingOK,
+			},
+			wantOutput: describeCacheSettingOutput,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCacheSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("cache-setting update --service-id 123 --version 1 --new-name assets"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("cache-setting update --service-id 123 --version 1 --name static --new-name assets"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "service version 1 is not editable",
+		},
+		{
+			args: args("cache-setting update --service-id 123 --version 1 --name static --new-name assets --autoclone"),
+			api: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("cache-setting update --service-id 123 --version 1 --name static --new-name assets --action restart --ttl 60 --autoclone"),
+			api: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				UpdateCacheSettingFn: updateCacheSettingOK,
+			},
+			wantOutput: "Updated cache setting assets (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestCacheSettingDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("cache-setting delete --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("cache-setting delete --service-id 123 --version 1 --name static --autoclone"),
+			api: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("cache-setting delete --service-id 123 --version 1 --name static --autoclone"),
+			api: mock.API{
+				ListVersionsFn:       testutil.ListVersions,
+				CloneVersionFn:       testutil.CloneVersionResult(4),
+				DeleteCacheSettingFn: deleteCacheSettingOK,
+			},
+			wantOutput: "Deleted cache setting static (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createCacheSettingOK(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	if i.Action != fastly.CacheSettingActionPass || i.TTL != 3600 || i.StaleTTL != 86400 || i.CacheCondition != "is-static" {
+		return nil, errTest
+	}
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createCacheSettingError(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+func listCacheSettingsOK(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return []*fastly.CacheSetting{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "static",
+			Action:         fastly.CacheSettingActionCache,
+			TTL:            3600,
+			StaleTTL:       86400,
+			CacheCondition: "is-static",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "no-cache",
+			Action:         fastly.CacheSettingActionPass,
+			CacheCondition: "is-private",
+		},
+	}, nil
+}
+
+func listCacheSettingsError(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+var listCacheSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME      ACTION  TTL   STALE TTL  CACHE CONDITION
+123      1        static    cache   3600  86400      is-static
+123      1        no-cache  pass    0     0          is-private
+`) + "\n"
+
+var listCacheSettingsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"\tCache setting 1/2",
+	"\t\tName: static",
+	"\t\tAction: cache",
+	"\t\tTTL: 3600",
+	"\t\tStale TTL: 86400",
+	"\t\tCache condition: is-static",
+	"\tCache setting 2/2",
+	"\t\tName: no-cache",
+	"\t\tAction: pass",
+	"\t\tTTL: 0",
+	"\t\tStale TTL: 0",
+	"\t\tCache condition: is-private",
+}, "\n") + "\n\n"
+
+func getCacheSettingOK(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "static",
+		Action:         fastly.CacheSettingActionCache,
+		TTL:            3600,
+		StaleTTL:       86400,
+		CacheCondition: "is-static",
+	}, nil
+}
+
+func getCacheSettingError(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+var describeCacheSettingOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: static",
+	"Action: cache",
+	"TTL: 3600",
+	"Stale TTL: 86400",
+	"Cache condition: is-static",
+}, "\n") + "\n"
+
+func updateCacheSettingOK(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	if i.Action != fastly.CacheSettingActionRestart || *i.TTL != 60 || i.StaleTTL != nil {
+		return nil, errTest
+	}
+	return &fastly.CacheSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateCacheSettingError(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return nil, errTest
+}
+
+func deleteCacheSettingOK(i *fastly.DeleteCacheSettingInput) error {
+	return nil
+}
+
+func deleteCacheSettingError(i *fastly.DeleteCacheSettingInput) error {
+	return errTest
+}
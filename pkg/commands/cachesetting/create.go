@@ -0,0 +1,86 @@
+package cachesetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Actions is the list of supported cache setting actions.
+var Actions = []string{"cache", "pass", "restart"}
+
+// CreateCommand calls the Fastly API to create cache settings.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateCacheSettingInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	// We must store the action separately to the input structure so it can
+	// be casted to go-fastly's custom `CacheSettingAction` type later.
+	Action string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a cache setting on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Cache setting name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("action", "Action to take when the cache condition is met. Valid values are: cache, pass, restart").HintOptions(Actions...).EnumVar(&c.Action, Actions...)
+	c.CmdClause.Flag("ttl", "Maximum time in seconds to consider the object fresh in the cache").UintVar(&c.Input.TTL)
+	c.CmdClause.Flag("stale-ttl", "Maximum time in seconds to continue to use a stale version of the object if future requests to your backend server fail").UintVar(&c.Input.StaleTTL)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will apply the cache setting").StringVar(&c.Input.CacheCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Action = fastly.CacheSettingAction(c.Action)
+
+	cs, err := c.Globals.Client.CreateCacheSetting(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created cache setting %s (service %s version %d)", cs.Name, cs.ServiceID, cs.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,77 @@
+package cachesetting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a cache setting.
+type DescribeCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.GetCacheSettingInput
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("describe", "Show detailed information about a cache setting on a Fastly service version").Alias("get")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.CmdClause.Flag("name", "Name of cache setting").Short('n').Required().StringVar(&c.Input.Name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+
+	cacheSetting, err := c.Globals.Client.GetCacheSetting(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSON(out, cacheSetting)
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", cacheSetting.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", cacheSetting.ServiceVersion)
+	text.PrintCacheSetting(out, "", cacheSetting)
+
+	return nil
+}
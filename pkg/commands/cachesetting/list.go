@@ -0,0 +1,90 @@
+package cachesetting
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list cache settings.
+type ListCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.ListCacheSettingsInput
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("list", "List cache settings on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+
+	cacheSettings, err := c.Globals.Client.ListCacheSettings(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, cacheSettings)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("SERVICE", "VERSION", "NAME", "ACTION", "TTL", "STALE TTL", "CACHE CONDITION")
+		for _, cacheSetting := range cacheSettings {
+			tw.AddLine(cacheSetting.ServiceID, cacheSetting.ServiceVersion, cacheSetting.Name, cacheSetting.Action, cacheSetting.TTL, cacheSetting.StaleTTL, cacheSetting.CacheCondition)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Service ID: %s\n", c.Input.ServiceID)
+	fmt.Fprintf(out, "Version: %d\n", c.Input.ServiceVersion)
+	for i, cacheSetting := range cacheSettings {
+		fmt.Fprintf(out, "\tCache setting %d/%d\n", i+1, len(cacheSettings))
+		text.PrintCacheSetting(out, "\t\t", cacheSetting)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package cachesetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update cache settings.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateCacheSettingInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName        cmd.OptionalString
+	Action         cmd.OptionalString
+	TTL            cmd.OptionalUint
+	StaleTTL       cmd.OptionalUint
+	CacheCondition cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a cache setting on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Cache setting name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New cache setting name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("action", "Action to take when the cache condition is met. Valid values are: cache, pass, restart").Action(c.Action.Set).HintOptions(Actions...).EnumVar(&c.Action.Value, Actions...)
+	c.CmdClause.Flag("ttl", "Maximum time in seconds to consider the object fresh in the cache").Action(c.TTL.Set).UintVar(&c.TTL.Value)
+	c.CmdClause.Flag("stale-ttl", "Maximum time in seconds to continue to use a stale version of the object if future requests to your backend server fail").Action(c.StaleTTL.Set).UintVar(&c.StaleTTL.Value)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will apply the cache setting").Action(c.CacheCondition.Set).StringVar(&c.CacheCondition.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Action.WasSet {
+		c.input.Action = fastly.CacheSettingAction(c.Action.Value)
+	}
+
+	if c.TTL.WasSet {
+		c.input.TTL = fastly.Uint(c.TTL.Value)
+	}
+
+	if c.StaleTTL.WasSet {
+		c.input.StaleTTL = fastly.Uint(c.StaleTTL.Value)
+	}
+
+	if c.CacheCondition.WasSet {
+		c.input.CacheCondition = fastly.String(c.CacheCondition.Value)
+	}
+
+	cs, err := c.Globals.Client.UpdateCacheSetting(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated cache setting %s (service %s version %d)", cs.Name, cs.ServiceID, cs.ServiceVersion)
+	return nil
+}
@@ -75,6 +75,7 @@ type BuildCommand struct {
 	IncludeSrc  bool
 	Force       bool
 	Timeout     int
+	Env         string
 }
 
 // NewBuildCommand returns a usable command registered under the parent.
@@ -87,6 +88,7 @@ func NewBuildCommand(parent cmd.Registerer, client api.HTTPClient, globals *conf
 	// NOTE: when updating these flags, be sure to update the composite command:
 	// `compute publish`.
 	c.CmdClause.Flag("name", "Package name").StringVar(&c.PackageName)
+	c.CmdClause.Flag("env", "The environment configuration to use (e.g. stage reads fastly.stage.toml)").StringVar(&c.Env)
 	c.CmdClause.Flag("language", "Language type").StringVar(&c.Lang)
 	c.CmdClause.Flag("include-source", "Include source code in built package").BoolVar(&c.IncludeSrc)
 	c.CmdClause.Flag("force", "Skip verification steps and force build").BoolVar(&c.Force)
@@ -119,6 +121,12 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		c.Globals.ErrLog.Add(err)
 		return fmt.Errorf("error reading package manifest: %w", err)
 	}
+	if c.Env != "" {
+		if err := m.ReadEnv(c.Env); err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+	}
 
 	// Language from flag takes priority, otherwise infer from manifest and
 	// error if neither are provided. Sanitize by trim and lowercase.
@@ -127,6 +135,8 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		lang = c.Lang
 	} else if m.Language != "" {
 		lang = m.Language
+	} else if m.Scripts.Build != "" {
+		lang = "other"
 	} else {
 		return fmt.Errorf("language cannot be empty, please provide a language")
 	}
@@ -145,31 +155,9 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	}
 	name = sanitize.BaseName(name)
 
-	var language *Language
-	switch lang {
-	case "assemblyscript":
-		language = NewLanguage(&LanguageOptions{
-			Name:            "assemblyscript",
-			SourceDirectory: "assembly",
-			IncludeFiles:    []string{"package.json"},
-			Toolchain:       NewAssemblyScript(c.Timeout),
-		})
-	case "javascript":
-		language = NewLanguage(&LanguageOptions{
-			Name:            "javascript",
-			SourceDirectory: "src",
-			IncludeFiles:    []string{"package.json"},
-			Toolchain:       NewJavaScript(c.Timeout),
-		})
-	case "rust":
-		language = NewLanguage(&LanguageOptions{
-			Name:            "rust",
-			SourceDirectory: "src",
-			IncludeFiles:    []string{"Cargo.toml"},
-			Toolchain:       NewRust(c.client, c.Globals, c.Timeout),
-		})
-	default:
-		return fmt.Errorf("unsupported language %s", lang)
+	language, err := c.newLanguage(lang, m.Scripts)
+	if err != nil {
+		return err
 	}
 
 	if !c.Force {
@@ -218,7 +206,7 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	files = append(files, binFiles...)
 
 	if c.IncludeSrc {
-		srcFiles, err := GetNonIgnoredFiles(language.SourceDirectory, ignoreFiles)
+		srcFiles, err := GetSourceFiles(language.SourceDirectory, ignoreFiles)
 		if err != nil {
 			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
 				"Source directory": language.SourceDirectory,
@@ -244,6 +232,56 @@ func (c *BuildCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	return nil
 }
 
+// newLanguage returns the Language, and its toolchain, for the given language
+// name. A build script in the manifest takes the place of the language's own
+// toolchain, in which case the language needn't be one the CLI supports.
+func (c *BuildCommand) newLanguage(lang string, scripts manifest.Scripts) (*Language, error) {
+	var language *Language
+	switch lang {
+	case "assemblyscript":
+		language = NewLanguage(&LanguageOptions{
+			Name:            "assemblyscript",
+			SourceDirectory: "assembly",
+			IncludeFiles:    []string{"package.json"},
+			Toolchain:       NewAssemblyScript(c.Timeout),
+		})
+	case "go":
+		language = NewLanguage(&LanguageOptions{
+			Name:            "go",
+			SourceDirectory: ".",
+			IncludeFiles:    []string{"go.mod"},
+			Toolchain:       NewGo(c.Globals, c.Timeout),
+		})
+	case "javascript":
+		language = NewLanguage(&LanguageOptions{
+			Name:            "javascript",
+			SourceDirectory: "src",
+			IncludeFiles:    []string{"package.json"},
+			Toolchain:       NewJavaScript(c.Timeout),
+		})
+	case "rust":
+		language = NewLanguage(&LanguageOptions{
+			Name:            "rust",
+			SourceDirectory: "src",
+			IncludeFiles:    []string{"Cargo.toml"},
+			Toolchain:       NewRust(c.client, c.Globals, c.Timeout),
+		})
+	default:
+		if scripts.Build == "" {
+			return nil, fmt.Errorf("unsupported language %s", lang)
+		}
+		language = NewLanguage(&LanguageOptions{
+			Name:            lang,
+			SourceDirectory: "src",
+		})
+	}
+
+	if scripts.Build != "" {
+		language.Toolchain = NewCustom(scripts.Build, c.Timeout)
+	}
+	return language, nil
+}
+
 // CreatePackageArchive packages build artifacts as a Fastly package, which
 // must be a GZipped Tar archive such as: package-name.tar.gz.
 //
@@ -350,6 +388,27 @@ func GetIgnoredFiles(filePath string) (files map[string]bool, err error) {
 	return files, nil
 }
 
+// GetSourceFiles returns the files in a language's source directory that
+// aren't ignored. As the source directory may be the package root (as it is for
+// Go), the bin and pkg build output directories and hidden directories such as
+// .git are skipped.
+func GetSourceFiles(base string, ignoredFiles map[string]bool) ([]string, error) {
+	files, err := GetNonIgnoredFiles(base, ignoredFiles)
+	if err != nil {
+		return nil, err
+	}
+
+	src := files[:0]
+	for _, f := range files {
+		dir := strings.SplitN(filepath.ToSlash(f), "/", 2)[0]
+		if dir != f && (dir == "bin" || dir == "pkg" || strings.HasPrefix(dir, ".")) {
+			continue
+		}
+		src = append(src, f)
+	}
+	return src, nil
+}
+
 // GetNonIgnoredFiles walks a filepath and returns all files don't exist in the
 // provided ignore files map.
 func GetNonIgnoredFiles(base string, ignoredFiles map[string]bool) ([]string, error) {
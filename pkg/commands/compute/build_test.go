@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"os"
 	"path/filepath"
+	"runtime"
 	"testing"
 
 	"github.com/fastly/cli/pkg/api"
@@ -528,3 +529,219 @@ func TestBuildJavaScript(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildGo(t *testing.T) {
+	args := testutil.Args
+	if os.Getenv("TEST_COMPUTE_BUILD_GO") == "" && os.Getenv("TEST_COMPUTE_BUILD") == "" {
+		t.Log("skipping test")
+		t.Skip("Set TEST_COMPUTE_BUILD_GO or TEST_COMPUTE_BUILD to run this test")
+	}
+
+	// We're going to chdir to a build environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create test environment
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: filepath.Join("testdata", "build", "go", "go.mod"), Dst: "go.mod"},
+			{Src: filepath.Join("testdata", "build", "go", "main.go"), Dst: "main.go"},
+		},
+		Exec: []string{"go", "mod", "download"},
+	})
+	defer os.RemoveAll(rootdir)
+
+	// Before running the test, chdir into the build environment.
+	// When we're done, chdir back to our original location.
+	// This is so we can reliably copy the testdata/ fixtures.
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	for _, testcase := range []struct {
+		name                 string
+		args                 []string
+		applicationConfig    config.File
+		fastlyManifest       string
+		wantError            string
+		wantRemediationError string
+		wantOutputContains   string
+	}{
+		{
+			name: "empty name",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			language = "go"`,
+			wantError: "name cannot be empty, please provide a name",
+		},
+		{
+			name: "go constraint not met",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			name = "test"
+			language = "go"`,
+			applicationConfig: config.File{
+				Language: config.Language{
+					Go: config.Go{
+						ToolchainConstraint: "< 1.0.0",
+						TinyGoConstraint:    ">= 0.24.0",
+					},
+				},
+			},
+			wantError:            "is incompatible with the constraint < 1.0.0",
+			wantRemediationError: "To fix this error, install a version of go within the given range < 1.0.0",
+		},
+		{
+			name: "Go success",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			name = "test"
+			language = "go"`,
+			applicationConfig: config.File{
+				Language: config.Language{
+					Go: config.Go{
+						ToolchainConstraint: ">= 1.17",
+						TinyGoConstraint:    ">= 0.24.0",
+					},
+				},
+			},
+			wantOutputContains: "Built go package test",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			if testcase.fastlyManifest != "" {
+				if err := os.WriteFile(filepath.Join(rootdir, manifest.Filename), []byte(testcase.fastlyManifest), 0777); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.ConfigFile = testcase.applicationConfig
+			err = app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertRemediationErrorContains(t, err, testcase.wantRemediationError)
+			if testcase.wantOutputContains != "" {
+				testutil.AssertStringContains(t, stdout.String(), testcase.wantOutputContains)
+			}
+		})
+	}
+}
+
+func TestBuildCustom(t *testing.T) {
+	args := testutil.Args
+	if runtime.GOOS == "windows" {
+		t.Skip("build scripts in this test are written for a POSIX shell")
+	}
+
+	for _, testcase := range []struct {
+		name                 string
+		args                 []string
+		fastlyManifest       string
+		wantError            string
+		wantRemediationError string
+		wantOutputContains   string
+	}{
+		{
+			name: "build script success",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			name = "test"
+			language = "other"
+			[scripts]
+			build = "echo wasm > bin/main.wasm"`,
+			wantOutputContains: "Built other package test",
+		},
+		{
+			name: "build script without language",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			name = "test"
+			[scripts]
+			build = "echo wasm > bin/main.wasm"`,
+			wantOutputContains: "Built other package test",
+		},
+		{
+			name: "build script replaces language toolchain",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			name = "test"
+			language = "rust"
+			[scripts]
+			build = "echo wasm > bin/main.wasm"`,
+			wantOutputContains: "Built rust package test",
+		},
+		{
+			name: "build script failure",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			name = "test"
+			language = "other"
+			[scripts]
+			build = "exit 1"`,
+			wantError: "error during execution process",
+		},
+		{
+			name: "build script without wasm binary",
+			args: args("compute build"),
+			fastlyManifest: `
+			manifest_version = 1
+			name = "test"
+			language = "other"
+			[scripts]
+			build = "true"`,
+			wantError:            "build script did not produce bin/main.wasm",
+			wantRemediationError: "so that it writes the compiled Wasm binary to",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			// We're going to chdir to a build environment,
+			// so save the PWD to return to, afterwards.
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Create test environment
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T: t,
+				Copy: []testutil.FileIO{
+					{Src: filepath.Join("testdata", "build", "rust", "Cargo.toml"), Dst: "Cargo.toml"},
+				},
+				Write: []testutil.FileIO{
+					{Src: testcase.fastlyManifest, Dst: manifest.Filename},
+				},
+			})
+			defer os.RemoveAll(rootdir)
+
+			// Before running the test, chdir into the build environment.
+			// When we're done, chdir back to our original location.
+			// This is so we can reliably copy the testdata/ fixtures.
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(pwd)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			err = app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertRemediationErrorContains(t, err, testcase.wantRemediationError)
+			if testcase.wantOutputContains != "" {
+				testutil.AssertStringContains(t, stdout.String(), testcase.wantOutputContains)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+const (
+	defaultCanaryThreshold = "0.05"
+	defaultCanaryWindow    = "5m"
+
+	// canaryMinRequests is the number of requests that must be seen before a
+	// canary can fail, so that the first few errors after activation, or a
+	// handful of errors on a quiet service, don't trigger a rollback on their
+	// own.
+	canaryMinRequests = 100
+)
+
+// canaryPollInterval is the minimum time between requests for real-time stats,
+// so that requests which return straight away (e.g. because they failed, or
+// there was no new data) don't turn the canary into a busy loop.
+var canaryPollInterval = time.Second
+
+// Canary represents the configuration parameters for a canary deployment,
+// which watches the error ratio of a newly activated version and reactivates
+// the previously active version if it crosses the threshold.
+type Canary struct {
+	Enabled   bool
+	Threshold float64
+	Window    time.Duration
+}
+
+// validate checks the canary flags are usable together.
+func (c Canary) validate(noActivate bool) error {
+	if !c.Enabled {
+		return nil
+	}
+	if noActivate {
+		return fmt.Errorf("error parsing arguments: the --canary flag is mutually exclusive with the --no-activate flag")
+	}
+	if c.Threshold < 0 || c.Threshold >= 1 {
+		return fmt.Errorf("error parsing arguments: --canary-threshold must be at least 0 and less than 1")
+	}
+	if c.Window <= 0 {
+		return fmt.Errorf("error parsing arguments: --canary-window must be greater than zero")
+	}
+	return nil
+}
+
+// canaryStats is the subset of a real-time stats response that a canary
+// needs.
+type canaryStats struct {
+	Timestamp uint64 `json:"timestamp"`
+	Data      []struct {
+		Aggregated struct {
+			Requests  uint64 `json:"requests"`
+			Status5xx uint64 `json:"status_5xx"`
+		} `json:"aggregated"`
+	} `json:"data"`
+}
+
+// canaryResult is the total requests seen by a canary, and how many of them
+// were errors (i.e. had a 5xx response status).
+type canaryResult struct {
+	errors   uint64
+	requests uint64
+}
+
+func (r canaryResult) ratio() float64 {
+	if r.requests == 0 {
+		return 0
+	}
+	return float64(r.errors) / float64(r.requests)
+}
+
+// enough reports whether enough requests have been seen to judge the error
+// ratio.
+func (r canaryResult) enough() bool {
+	return r.requests >= canaryMinRequests
+}
+
+// failed reports whether enough requests have been seen and the error ratio
+// has crossed the threshold.
+func (r canaryResult) failed(threshold float64) bool {
+	return r.enough() && r.ratio() > threshold
+}
+
+func (r canaryResult) String() string {
+	return fmt.Sprintf("%d 5xx responses from %d requests (error ratio %.4f)", r.errors, r.requests, r.ratio())
+}
+
+// watch polls real-time stats from the given timestamp until the window has
+// passed, or until enough requests have been seen to fail the canary early.
+//
+// Errors fetching stats are reported but otherwise ignored, as the version is
+// already active and giving up would leave it unwatched.
+func (c Canary) watch(client api.RealtimeStatsInterface, serviceID string, timestamp uint64, out io.Writer) canaryResult {
+	var r canaryResult
+
+	deadline := time.Now().Add(c.Window)
+	for time.Now().Before(deadline) {
+		next := time.Now().Add(canaryPollInterval)
+		if next.After(deadline) {
+			next = deadline
+		}
+
+		var stats canaryStats
+		err := client.GetRealtimeStatsJSON(&fastly.GetRealtimeStatsInput{
+			ServiceID: serviceID,
+			Timestamp: timestamp,
+		}, &stats)
+		if err != nil {
+			text.Warning(out, "error fetching real-time stats: %s", err)
+		} else {
+			timestamp = stats.Timestamp
+
+			for _, d := range stats.Data {
+				r.errors += d.Aggregated.Status5xx
+				r.requests += d.Aggregated.Requests
+			}
+			if r.failed(c.Threshold) {
+				return r
+			}
+		}
+
+		time.Sleep(time.Until(next))
+	}
+
+	return r
+}
+
+// latestStatsTimestamp returns the timestamp of the most recent real-time
+// stats for a service.
+func latestStatsTimestamp(client api.RealtimeStatsInterface, serviceID string) (uint64, error) {
+	var stats canaryStats
+	err := client.GetRealtimeStatsJSON(&fastly.GetRealtimeStatsInput{
+		ServiceID: serviceID,
+	}, &stats)
+	if err != nil {
+		return 0, fmt.Errorf("error fetching real-time stats: %w", err)
+	}
+	return stats.Timestamp, nil
+}
+
+// activeVersion returns the active version of a service.
+func activeVersion(client api.Interface, serviceID string) (*fastly.Version, error) {
+	vs, err := client.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing service versions: %w", err)
+	}
+
+	v, err := cmd.GetActiveVersion(vs)
+	if err != nil {
+		return nil, errors.RemediationError{
+			Inner:       err,
+			Remediation: "A canary deployment needs an active version to roll back to. Deploy without --canary first.",
+		}
+	}
+	return v, nil
+}
+
+// rollback reactivates the previous version after a canary has failed.
+func rollback(progress text.Progress, client api.Interface, serviceID string, version, previous int, result canaryResult, threshold float64) error {
+	progress.Step(fmt.Sprintf("Reactivating version %d...", previous))
+
+	_, err := client.ActivateVersion(&fastly.ActivateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: previous,
+	})
+	if err != nil {
+		return fmt.Errorf("error reactivating version %d after canary failed with %s: %w", previous, result, err)
+	}
+
+	return errors.RemediationError{
+		Inner:       fmt.Errorf("canary failed: %s crossed the threshold of %g, so version %d was reactivated", result, threshold, previous),
+		Remediation: fmt.Sprintf("Version %d remains on the service but is no longer active. To investigate its errors, run `fastly logs tail`, then fix the package and deploy again.", version),
+	}
+}
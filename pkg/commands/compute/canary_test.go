@@ -0,0 +1,181 @@
+package compute
+
+import (
+	"bytes"
+	"encoding/json"
+	errs "errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// errTest is the error returned by the mocks.
+var errTest = errs.New("test error")
+
+// TestCanaryWatch validates that real-time stats are polled at most once per
+// interval until the window has passed, or until the canary fails.
+func TestCanaryWatch(t *testing.T) {
+	defer func(d time.Duration) {
+		canaryPollInterval = d
+	}(canaryPollInterval)
+	canaryPollInterval = 20 * time.Millisecond
+
+	for _, testcase := range []struct {
+		name         string
+		requests     int
+		status5xx    int
+		err          error
+		wantMaxPolls int
+		wantFailed   bool
+		wantOutput   string
+	}{
+		{
+			name:         "passes",
+			requests:     200,
+			status5xx:    1,
+			wantMaxPolls: 6,
+		},
+		{
+			name:         "fails early",
+			requests:     200,
+			status5xx:    50,
+			wantMaxPolls: 1,
+			wantFailed:   true,
+		},
+		{
+			name:         "not enough traffic",
+			requests:     10,
+			status5xx:    5,
+			wantMaxPolls: 6,
+		},
+		{
+			name:         "stats errors",
+			err:          errTest,
+			wantMaxPolls: 6,
+			wantOutput:   "error fetching real-time stats: test error",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var polls int
+			client := mock.RTS{
+				GetRealtimeStatsJSONFn: func(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+					polls++
+					if testcase.err != nil {
+						return testcase.err
+					}
+					// Each poll continues from the timestamp of the previous one.
+					if i.Timestamp != uint64(polls) {
+						t.Errorf("want timestamp %d, have %d", polls, i.Timestamp)
+					}
+					data := fmt.Sprintf(`{"Timestamp": %d, "Data": [{"aggregated": {"requests": %d, "status_5xx": %d}}]}`, i.Timestamp+1, testcase.requests, testcase.status5xx)
+					return json.Unmarshal([]byte(data), dst)
+				},
+			}
+
+			var stdout bytes.Buffer
+			c := Canary{Enabled: true, Threshold: 0.05, Window: 100 * time.Millisecond}
+			r := c.watch(client, "123", 1, &stdout)
+
+			if polls < 1 || polls > testcase.wantMaxPolls {
+				t.Errorf("want between 1 and %d polls, have %d", testcase.wantMaxPolls, polls)
+			}
+			if want := uint64(polls * testcase.requests); r.requests != want {
+				t.Errorf("want %d requests, have %d", want, r.requests)
+			}
+			if r.failed(c.Threshold) != testcase.wantFailed {
+				t.Errorf("want failed %t, have %s", testcase.wantFailed, r)
+			}
+			if !strings.Contains(stdout.String(), testcase.wantOutput) {
+				t.Errorf("want %q in output, have:\n%s", testcase.wantOutput, stdout.String())
+			}
+		})
+	}
+}
+
+// TestCanaryResult validates that a canary only fails once enough requests
+// have been seen to judge the error ratio.
+func TestCanaryResult(t *testing.T) {
+	for _, testcase := range []struct {
+		result     canaryResult
+		wantEnough bool
+		wantFailed bool
+	}{
+		{result: canaryResult{}},
+		{result: canaryResult{errors: 1, requests: 1}},
+		{result: canaryResult{errors: 50, requests: canaryMinRequests - 1}},
+		{result: canaryResult{errors: 1, requests: canaryMinRequests}, wantEnough: true},
+		{result: canaryResult{errors: 50, requests: canaryMinRequests}, wantEnough: true, wantFailed: true},
+	} {
+		t.Run(testcase.result.String(), func(t *testing.T) {
+			if testcase.result.enough() != testcase.wantEnough {
+				t.Errorf("want enough %t, have %t", testcase.wantEnough, testcase.result.enough())
+			}
+			if testcase.result.failed(0.05) != testcase.wantFailed {
+				t.Errorf("want failed %t, have %t", testcase.wantFailed, testcase.result.failed(0.05))
+			}
+		})
+	}
+}
+
+// TestCanaryRollback validates that the previous version is reactivated after
+// a canary fails.
+func TestCanaryRollback(t *testing.T) {
+	result := canaryResult{errors: 50, requests: 200}
+
+	for _, testcase := range []struct {
+		name            string
+		activate        func(*fastly.ActivateVersionInput) (*fastly.Version, error)
+		wantError       string
+		wantRemediation string
+	}{
+		{
+			name: "reactivated",
+			activate: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+				return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion, Active: true}, nil
+			},
+			wantError:       "canary failed: 50 5xx responses from 200 requests (error ratio 0.2500) crossed the threshold of 0.05, so version 1 was reactivated",
+			wantRemediation: "Version 3 remains on the service but is no longer active. To investigate its errors, run `fastly logs tail`, then fix the package and deploy again.",
+		},
+		{
+			name: "reactivation fails",
+			activate: func(*fastly.ActivateVersionInput) (*fastly.Version, error) {
+				return nil, errTest
+			},
+			wantError: "error reactivating version 1 after canary failed with 50 5xx responses from 200 requests (error ratio 0.2500): test error",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var activated []int
+			client := mock.API{
+				ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+					if i.ServiceID != "123" {
+						t.Errorf("want service 123, have %s", i.ServiceID)
+					}
+					activated = append(activated, i.ServiceVersion)
+					return testcase.activate(i)
+				},
+			}
+
+			err := rollback(text.NewQuietProgress(io.Discard), client, "123", 3, 1, result, 0.05)
+			if err == nil || !strings.Contains(err.Error(), testcase.wantError) {
+				t.Errorf("want error %q, have %v", testcase.wantError, err)
+			}
+			if !reflect.DeepEqual(activated, []int{1}) {
+				t.Errorf("want version 1 reactivated, have %v", activated)
+			}
+
+			re, _ := err.(errors.RemediationError)
+			if re.Remediation != testcase.wantRemediation {
+				t.Errorf("want remediation %q, have %q", testcase.wantRemediation, re.Remediation)
+			}
+		})
+	}
+}
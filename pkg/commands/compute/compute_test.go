@@ -41,13 +41,19 @@ func TestPublishFlagDivergence(t *testing.T) {
 		have   []string
 	)
 
+	// Flags such as --env are shared by build and deploy, and so are only
+	// expected once on publish.
+	seen := make(map[string]bool)
 	iter := buildFlags.MapRange()
 	for iter.Next() {
-		expect = append(expect, fmt.Sprintf("%s", iter.Key()))
+		seen[fmt.Sprintf("%s", iter.Key())] = true
 	}
 	iter = deployFlags.MapRange()
 	for iter.Next() {
-		expect = append(expect, fmt.Sprintf("%s", iter.Key()))
+		seen[fmt.Sprintf("%s", iter.Key())] = true
+	}
+	for k := range seen {
+		expect = append(expect, k)
 	}
 
 	iter = publishFlags.MapRange()
@@ -301,6 +307,80 @@ func TestGetNonIgnoredFiles(t *testing.T) {
 	}
 }
 
+func TestGetSourceFiles(t *testing.T) {
+	// We're going to chdir to a build environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Create test environment
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Copy: []testutil.FileIO{
+			{Src: filepath.Join("testdata", "build", "go", "go.mod"), Dst: "go.mod"},
+			{Src: filepath.Join("testdata", "build", "go", "main.go"), Dst: "main.go"},
+			{Src: filepath.Join("testdata", "build", "go", "main.go"), Dst: filepath.Join("bin", "main.wasm")},
+			{Src: filepath.Join("testdata", "build", "go", "main.go"), Dst: filepath.Join("pkg", "test.tar.gz")},
+			{Src: filepath.Join("testdata", "build", "go", "main.go"), Dst: filepath.Join(".git", "HEAD")},
+			{Src: filepath.Join("testdata", "build", "go", "main.go"), Dst: filepath.Join("handler", "handler.go")},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	// Before running the test, chdir into the build environment.
+	// When we're done, chdir back to our original location.
+	// This is so we can reliably copy the testdata/ fixtures.
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	for _, testcase := range []struct {
+		name         string
+		path         string
+		ignoredFiles map[string]bool
+		wantFiles    []string
+	}{
+		{
+			name:         "package root skips build output and hidden directories",
+			path:         ".",
+			ignoredFiles: map[string]bool{},
+			wantFiles: []string{
+				"go.mod",
+				filepath.Join("handler/handler.go"),
+				"main.go",
+			},
+		},
+		{
+			name: "ignored file",
+			path: ".",
+			ignoredFiles: map[string]bool{
+				"go.mod": true,
+			},
+			wantFiles: []string{
+				filepath.Join("handler/handler.go"),
+				"main.go",
+			},
+		},
+		{
+			name:         "source directory",
+			path:         "handler",
+			ignoredFiles: map[string]bool{},
+			wantFiles: []string{
+				filepath.Join("handler/handler.go"),
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			output, err := compute.GetSourceFiles(testcase.path, testcase.ignoredFiles)
+			testutil.AssertNoError(t, err)
+			testutil.AssertEqual(t, testcase.wantFiles, output)
+		})
+	}
+}
+
 func TestGetLatestCrateVersion(t *testing.T) {
 	for _, testcase := range []struct {
 		name        string
@@ -47,6 +47,8 @@ type DeployCommand struct {
 	Backend        Backend
 	Comment        cmd.OptionalString
 	ServiceVersion cmd.OptionalServiceVersion
+	Activate       cmd.OptionalBool
+	Canary         Canary
 }
 
 // Backend represents the configuration parameters for a backend
@@ -67,14 +69,18 @@ func NewDeployCommand(parent cmd.Registerer, client api.HTTPClient, globals *con
 
 	// NOTE: when updating these flags, be sure to update the composite command:
 	// `compute publish`.
-	c.RegisterServiceIDFlag(&c.Manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.Manifest)
 	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
 		Action:   c.ServiceVersion.Set,
 		Dst:      &c.ServiceVersion.Value,
 		Optional: true,
 	})
+	c.CmdClause.Flag("activate", "Activate the version the package is uploaded to (use --no-activate to leave it as a draft)").Action(c.Activate.Set).NegatableBoolVar(&c.Activate.Value)
 	c.CmdClause.Flag("backend", "A hostname, IPv4, or IPv6 address for the package backend").StringVar(&c.Backend.Address)
 	c.CmdClause.Flag("backend-port", "A port number for the package backend").UintVar(&c.Backend.Port)
+	c.CmdClause.Flag("canary", "After activating, watch the ratio of 5xx responses and reactivate the previously active version if it crosses --canary-threshold").BoolVar(&c.Canary.Enabled)
+	c.CmdClause.Flag("canary-threshold", "The ratio of 5xx responses to requests (between 0 and 1) above which --canary rolls back").Default(defaultCanaryThreshold).Float64Var(&c.Canary.Threshold)
+	c.CmdClause.Flag("canary-window", "How long --canary watches real-time stats for after activating (e.g. 30s, 5m)").Default(defaultCanaryWindow).DurationVar(&c.Canary.Window)
 	c.CmdClause.Flag("comment", "Human-readable comment").Action(c.Comment.Set).StringVar(&c.Comment.Value)
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").StringVar(&c.Domain)
 	c.CmdClause.Flag("override-host", "The hostname to override the Host header").StringVar(&c.Backend.OverrideHost)
@@ -91,6 +97,12 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return errors.ErrNoToken
 	}
 
+	noActivate := c.Activate.WasSet && !c.Activate.Value
+	if err := c.Canary.validate(noActivate); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
 	// The first thing we want to do is validate that a package has been built.
 	// There is no point prompting a user for info if we know we're going to
 	// fail any way because the user didn't build a package first.
@@ -116,10 +128,19 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		backend        Backend
 		invalidService bool
 		invalidType    invalidResource
+		previous       *fastly.Version
 		version        *fastly.Version
 	)
 
 	serviceID, sidSrc := c.Manifest.ServiceID()
+	if sidSrc == manifest.SourceUndefined && c.Canary.Enabled {
+		err := errors.RemediationError{
+			Inner:       fmt.Errorf("--canary requires an existing service"),
+			Remediation: "A new service has no active version to roll back to. Deploy it once without --canary, or provide a Service ID with --service-id.",
+		}
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
 	if sidSrc == manifest.SourceUndefined {
 		text.Output(out, "There is no Fastly service associated with this package. To connect to an existing service add the Service ID to the fastly.toml file, otherwise follow the prompts to create a service now.")
 		text.Break(out)
@@ -156,6 +177,18 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			return err
 		}
 
+		// The version to roll back to is the one that's active before we
+		// activate the package, so it's looked up before anything is changed.
+		if c.Canary.Enabled {
+			previous, err = activeVersion(c.Globals.Client, serviceID)
+			if err != nil {
+				c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+					"Service ID": serviceID,
+				})
+				return err
+			}
+		}
+
 		// Unlike other CLI commands that are a direct mapping to an API endpoint,
 		// the compute deploy command is a composite of behaviours, and so as we
 		// already automatically activate a version we should autoclone without
@@ -273,8 +306,9 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if sidSrc == manifest.SourceUndefined {
 		// There is no service and so we'll do a one time creation of the service
 		// and the associated domain/backend and store the Service ID within the
-		// manifest. On subsequent runs of the deploy subcommand we'll skip the
-		// service/domain/backend creation.
+		// manifest (the --env environment's manifest if given, so that each
+		// environment has its own service). On subsequent runs of the deploy
+		// subcommand we'll skip the service/domain/backend creation.
 		//
 		// NOTE: we're shadowing the `version` and `serviceID` variable.
 		version, serviceID, err = createService(progress, c.Globals.Client, name, desc)
@@ -288,7 +322,7 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 
 		undoStack.Push(func() error {
 			clearServiceID := ""
-			return updateManifestServiceID(&c.Manifest.File, manifest.Filename, nil, clearServiceID)
+			return updateManifestServiceID(&c.Manifest.File, c.Manifest.Flag.Env, nil, clearServiceID)
 		})
 
 		undoStack.Push(func() error {
@@ -323,7 +357,7 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			})
 			return err
 		}
-		err = updateManifestServiceID(&c.Manifest.File, manifest.Filename, progress, serviceID)
+		err = updateManifestServiceID(&c.Manifest.File, c.Manifest.Flag.Env, progress, serviceID)
 		if err != nil {
 			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
 				"Service ID": serviceID,
@@ -420,6 +454,34 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
+	if noActivate {
+		progress.Done()
+
+		text.Break(out)
+
+		text.Description(out, "Manage this service at", fmt.Sprintf("%s%s", manageServiceBaseURL, serviceID))
+
+		text.Success(out, "Uploaded package to draft version %d (service %s)", version.Number, serviceID)
+		text.Info(out, "To activate it, run:\n\n\t$ fastly service-version activate --service-id %s --version %d", serviceID, version.Number)
+		return nil
+	}
+
+	var (
+		result    canaryResult
+		timestamp uint64
+	)
+	if c.Canary.Enabled {
+		// Stats recorded before the version is activated were served by the
+		// previous version, so we start watching from the latest timestamp.
+		timestamp, err = latestStatsTimestamp(c.Globals.RTSClient, serviceID)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID": serviceID,
+			})
+			return err
+		}
+	}
+
 	progress.Step("Activating version...")
 
 	_, err = c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
@@ -434,6 +496,23 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return fmt.Errorf("error activating version: %w", err)
 	}
 
+	if c.Canary.Enabled {
+		progress.Step(fmt.Sprintf("Watching error ratio for %s...", c.Canary.Window))
+
+		result = c.Canary.watch(c.Globals.RTSClient, serviceID, timestamp, out)
+		if result.failed(c.Canary.Threshold) {
+			err = rollback(progress, c.Globals.Client, serviceID, version.Number, previous.Number, result, c.Canary.Threshold)
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":       serviceID,
+				"Service Version":  version.Number,
+				"Previous Version": previous.Number,
+				"Errors":           result.errors,
+				"Requests":         result.requests,
+			})
+			return err
+		}
+	}
+
 	progress.Done()
 
 	text.Break(out)
@@ -448,6 +527,14 @@ func (c *DeployCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		text.Description(out, "View this service at", fmt.Sprintf("https://%s", domains[0].Name))
 	}
 
+	if c.Canary.Enabled {
+		if result.enough() {
+			text.Info(out, "Canary passed: %s over %s", result, c.Canary.Window)
+		} else {
+			text.Warning(out, "Canary inconclusive: not enough traffic to judge the error ratio (%s over %s, at least %d requests are needed). Version %d remains active.", result, c.Canary.Window, canaryMinRequests, version.Number)
+		}
+	}
+
 	text.Success(out, "Deployed package (service %s, version %v)", serviceID, version.Number)
 	return nil
 }
@@ -557,8 +644,18 @@ func createService(progress text.Progress, client api.Interface, name string, de
 // error in the deploy flow, and for which the Service ID will be set to an
 // empty string (otherwise the service itself will be deleted while the
 // manifest will continue to hold a reference to it).
-func updateManifestServiceID(m *manifest.File, manifestFilename string, progress text.Progress, serviceID string) error {
-	if err := m.Read(manifestFilename); err != nil {
+//
+// If an environment is given, the Service ID is written to its manifest file
+// alone, which is read afresh so that the parameters overlaid from the base
+// fastly.toml manifest aren't copied into it.
+func updateManifestServiceID(m *manifest.File, env string, progress text.Progress, serviceID string) error {
+	f := m
+	if env != "" {
+		f = &manifest.File{}
+		if err := f.ReadEnvFile(env); err != nil {
+			return fmt.Errorf("error reading package manifest: %w", err)
+		}
+	} else if err := f.Read(manifest.Filename); err != nil {
 		return fmt.Errorf("error reading package manifest: %w", err)
 	}
 
@@ -566,12 +663,13 @@ func updateManifestServiceID(m *manifest.File, manifestFilename string, progress
 		fmt.Fprintf(progress, "Setting service ID in manifest to %q...\n", serviceID)
 	}
 
-	m.ServiceID = serviceID
+	f.ServiceID = serviceID
 
-	if err := m.Write(manifestFilename); err != nil {
+	if err := f.Write(manifest.EnvFilename(env)); err != nil {
 		return fmt.Errorf("error saving package manifest: %w", err)
 	}
 
+	m.ServiceID = serviceID
 	return nil
 }
 
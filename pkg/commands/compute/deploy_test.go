@@ -1,7 +1,10 @@
 package compute_test
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -54,6 +57,7 @@ func TestDeploy(t *testing.T) {
 		name             string
 		args             []string
 		api              mock.API
+		rts              mock.RTS
 		wantError        string
 		wantOutput       []string
 		manifestIncludes string
@@ -394,6 +398,102 @@ func TestDeploy(t *testing.T) {
 				"Deployed package (service 123, version 4)",
 			},
 		},
+		{
+			name: "success with --no-activate",
+			args: args("compute deploy --service-id 123 --token 123 --no-activate"),
+			api: mock.API{
+				GetServiceFn:    getServiceOK,
+				ListVersionsFn:  testutil.ListVersions,
+				ListDomainsFn:   listDomainsOk,
+				ListBackendsFn:  listBackendsOk,
+				GetPackageFn:    getPackageOk,
+				UpdatePackageFn: updatePackageOk,
+			},
+			wantOutput: []string{
+				"Uploading package...",
+				"Manage this service at:",
+				"Uploaded package to draft version 3 (service 123)",
+				"fastly service-version activate --service-id 123 --version 3",
+			},
+		},
+		{
+			name:      "--canary with --no-activate",
+			args:      args("compute deploy --service-id 123 --token 123 --canary --no-activate"),
+			wantError: "the --canary flag is mutually exclusive with the --no-activate flag",
+		},
+		{
+			name:      "--canary with invalid threshold",
+			args:      args("compute deploy --service-id 123 --token 123 --canary --canary-threshold 1.5"),
+			wantError: "--canary-threshold must be at least 0 and less than 1",
+		},
+		{
+			name:      "--canary with no service ID",
+			args:      args("compute deploy --token 123 --canary"),
+			wantError: "--canary requires an existing service",
+		},
+		{
+			name: "--canary error ratio crosses threshold",
+			args: args("compute deploy --service-id 123 --token 123 --canary --canary-window 1m"),
+			api: mock.API{
+				GetServiceFn:      getServiceOK,
+				ListVersionsFn:    testutil.ListVersions,
+				ListDomainsFn:     listDomainsOk,
+				ListBackendsFn:    listBackendsOk,
+				GetPackageFn:      getPackageOk,
+				UpdatePackageFn:   updatePackageOk,
+				ActivateVersionFn: activateVersionOk,
+			},
+			rts: mock.RTS{
+				GetRealtimeStatsJSONFn: getRealtimeStats(200, 50),
+			},
+			wantError: "canary failed: 50 5xx responses from 200 requests (error ratio 0.2500) crossed the threshold of 0.05, so version 1 was reactivated",
+			wantOutput: []string{
+				"Activating version...",
+				"Reactivating version 1...",
+			},
+		},
+		{
+			name: "success with --canary",
+			args: args("compute deploy --service-id 123 --token 123 --canary --canary-window 10ms"),
+			api: mock.API{
+				GetServiceFn:      getServiceOK,
+				ListVersionsFn:    testutil.ListVersions,
+				ListDomainsFn:     listDomainsOk,
+				ListBackendsFn:    listBackendsOk,
+				GetPackageFn:      getPackageOk,
+				UpdatePackageFn:   updatePackageOk,
+				ActivateVersionFn: activateVersionOk,
+			},
+			rts: mock.RTS{
+				GetRealtimeStatsJSONFn: getRealtimeStats(200, 1),
+			},
+			wantOutput: []string{
+				"Activating version...",
+				"Canary passed:",
+				"Deployed package (service 123, version 3)",
+			},
+		},
+		{
+			name: "--canary with not enough traffic",
+			args: args("compute deploy --service-id 123 --token 123 --canary --canary-window 10ms"),
+			api: mock.API{
+				GetServiceFn:      getServiceOK,
+				ListVersionsFn:    testutil.ListVersions,
+				ListDomainsFn:     listDomainsOk,
+				ListBackendsFn:    listBackendsOk,
+				GetPackageFn:      getPackageOk,
+				UpdatePackageFn:   updatePackageOk,
+				ActivateVersionFn: activateVersionOk,
+			},
+			rts: mock.RTS{
+				GetRealtimeStatsJSONFn: getRealtimeStats(20, 10),
+			},
+			wantOutput: []string{
+				"Activating version...",
+				"Canary inconclusive: not enough traffic to judge the error ratio",
+				"Deployed package (service 123, version 3)",
+			},
+		},
 		{
 			name: "success with --backend and no --backend-port",
 			args: args("compute deploy --backend host.com --token 123"),
@@ -474,6 +574,7 @@ func TestDeploy(t *testing.T) {
 			var stdout bytes.Buffer
 			opts := testutil.NewRunOpts(testcase.args, &stdout)
 			opts.APIClient = mock.APIClient(testcase.api)
+			opts.RTSClient = mock.RTSClient(testcase.rts)
 
 			// we need to define stdin as the deploy process prompts the user multiple
 			// times, but we don't need to provide any values as all our prompts will
@@ -499,6 +600,108 @@ func TestDeploy(t *testing.T) {
 	}
 }
 
+// TestDeployEnv validates that deploying with --env stores the Service ID of
+// a new service in the environment's manifest file alone.
+func TestDeployEnv(t *testing.T) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name          string
+		api           mock.API
+		wantError     string
+		wantServiceID string
+	}{
+		{
+			name: "new service",
+			api: mock.API{
+				CreateServiceFn:   createServiceOK,
+				GetPackageFn:      getPackageOk,
+				UpdatePackageFn:   updatePackageOk,
+				CreateDomainFn:    createDomainOK,
+				CreateBackendFn:   createBackendExpect("host.com", 80, "", ""),
+				ActivateVersionFn: activateVersionOk,
+				ListDomainsFn:     listDomainsOk,
+			},
+			wantServiceID: `service_id = "12345"`,
+		},
+		{
+			name: "new service is undone",
+			api: mock.API{
+				CreateServiceFn: createServiceOK,
+				DeleteServiceFn: deleteServiceOK,
+				CreateDomainFn:  createDomainError,
+				DeleteDomainFn:  deleteDomainOK,
+			},
+			wantError:     "error creating domain: test error",
+			wantServiceID: `service_id = ""`,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			rootdir := testutil.NewEnv(testutil.EnvOpts{
+				T: t,
+				Write: []testutil.FileIO{
+					{Src: "manifest_version = 1\nname = \"package\"\nservice_id = \"base\"\n", Dst: manifest.Filename},
+					{Src: "[local_server.backends.origin]\nurl = \"https://stage.example.com\"\n", Dst: "fastly.stage.toml"},
+					{Src: packageArchive(t), Dst: "package.tar.gz"},
+				},
+			})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(pwd)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(args("compute deploy --env stage --path package.tar.gz --backend host.com --token 123"), &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.Stdin = strings.NewReader("")
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+
+			content, err := os.ReadFile(filepath.Join(rootdir, "fastly.stage.toml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.AssertStringContains(t, string(content), testcase.wantServiceID)
+			testutil.AssertStringContains(t, string(content), "https://stage.example.com")
+			if strings.Contains(string(content), `name = "package"`) {
+				t.Errorf("want only the stage manifest's parameters, have:\n%s", content)
+			}
+
+			content, err = os.ReadFile(filepath.Join(rootdir, manifest.Filename))
+			if err != nil {
+				t.Fatal(err)
+			}
+			testutil.AssertStringContains(t, string(content), `service_id = "base"`)
+		})
+	}
+}
+
+// packageArchive returns the content of a minimal package that passes
+// validation.
+func packageArchive(t *testing.T) string {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for _, name := range []string{"package/fastly.toml", "package/bin/main.wasm"} {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0644}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
 func createServiceOK(i *fastly.CreateServiceInput) (*fastly.Service, error) {
 	return &fastly.Service{
 		ID:   "12345",
@@ -541,6 +744,15 @@ func getPackageIdentical(i *fastly.GetPackageInput) (*fastly.Package, error) {
 	}, nil
 }
 
+// getRealtimeStats returns a mock of the real-time stats API that reports
+// the given number of requests and 5xx responses on every call.
+func getRealtimeStats(requests, status5xx int) func(*fastly.GetRealtimeStatsInput, interface{}) error {
+	return func(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+		data := fmt.Sprintf(`{"Timestamp": %d, "Data": [{"aggregated": {"requests": %d, "status_5xx": %d}}]}`, i.Timestamp+1, requests, status5xx)
+		return json.Unmarshal([]byte(data), dst)
+	}
+}
+
 func activateVersionError(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
 	return nil, testutil.Err
 }
@@ -132,6 +132,12 @@ func (c *InitCommand) Exec(in io.Reader, out io.Writer) (err error) {
 			StarterKits: c.Globals.File.StarterKits.JavaScript,
 			Toolchain:   NewJavaScript(0),
 		}),
+		NewLanguage(&LanguageOptions{
+			Name:        "go",
+			DisplayName: "Go (beta)",
+			StarterKits: c.Globals.File.StarterKits.Go,
+			Toolchain:   NewGo(c.Globals, 0),
+		}),
 		NewLanguage(&LanguageOptions{
 			Name:        "other",
 			DisplayName: "Other ('bring your own' Wasm binary)",
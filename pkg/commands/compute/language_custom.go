@@ -0,0 +1,92 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/errors"
+	fstexec "github.com/fastly/cli/pkg/exec"
+	"github.com/fastly/cli/pkg/filesystem"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Custom implements a Toolchain for packages that are built by the command in
+// the [scripts] section of the fastly.toml manifest, rather than by one of the
+// language toolchains the CLI knows about.
+type Custom struct {
+	build   string
+	timeout int
+}
+
+// NewCustom constructs a new Custom.
+func NewCustom(build string, timeout int) *Custom {
+	return &Custom{
+		build:   build,
+		timeout: timeout,
+	}
+}
+
+// Initialize implements the Toolchain interface and initializes a newly cloned
+// package. It is a noop as the build script is responsible for its own
+// dependencies.
+func (c Custom) Initialize(out io.Writer) error { return nil }
+
+// Verify implements the Toolchain interface. There is nothing the CLI can
+// verify about an arbitrary toolchain, so it only reports the build script
+// that will be run.
+func (c Custom) Verify(out io.Writer) error {
+	fmt.Fprintf(out, "Using build script from %s: %s\n", manifest.Filename, c.build)
+	return nil
+}
+
+// Build implements the Toolchain interface and runs the build script using the
+// system shell, then checks that it produced a Wasm binary.
+func (c Custom) Build(out io.Writer, verbose bool) error {
+	dir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current working directory: %w", err)
+	}
+	binDir := filepath.Join(dir, "bin")
+	if err := filesystem.MakeDirectoryIfNotExists(binDir); err != nil {
+		return fmt.Errorf("making bin directory: %w", err)
+	}
+
+	// Remove the binary from any previous build, so that a build script that
+	// doesn't write one isn't mistaken for one that did.
+	bin := filepath.Join(binDir, "main.wasm")
+	if err := os.Remove(bin); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("removing previous wasm binary: %w", err)
+	}
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd.exe", "/C"
+	}
+
+	cmd := fstexec.Streaming{
+		Command: shell,
+		Args:    []string{flag, c.build},
+		Env:     os.Environ(),
+		Output:  out,
+	}
+	if c.timeout > 0 {
+		cmd.Timeout = time.Duration(c.timeout) * time.Second
+	}
+	if err := cmd.Exec(); err != nil {
+		return err
+	}
+
+	if !filesystem.FileExists(bin) {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("build script did not produce %s", filepath.Join("bin", "main.wasm")),
+			Remediation: fmt.Sprintf("To fix this error, change the %s command in %s so that it writes the compiled Wasm binary to %s.", text.Bold("[scripts] build"), manifest.Filename, text.Bold(filepath.Join("bin", "main.wasm"))),
+		}
+	}
+
+	return nil
+}
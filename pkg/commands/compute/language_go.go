@@ -0,0 +1,204 @@
+package compute
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	fstexec "github.com/fastly/cli/pkg/exec"
+	"github.com/fastly/cli/pkg/filesystem"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// Go implements a Toolchain for the Go language.
+//
+// Go packages are compiled to Wasm by TinyGo, which in turn requires a Go
+// installation to build with.
+type Go struct {
+	config  *config.Data
+	timeout int
+}
+
+// NewGo constructs a new Go.
+func NewGo(config *config.Data, timeout int) *Go {
+	return &Go{
+		config:  config,
+		timeout: timeout,
+	}
+}
+
+// Verify implements the Toolchain interface and verifies whether the Go
+// language toolchain is correctly configured on the host.
+func (g Go) Verify(out io.Writer) error {
+	// 1) Check `go` is on $PATH and meets the version constraint.
+	//
+	// TinyGo compiles against the standard library of the installed Go
+	// toolchain, and each TinyGo release only supports a range of Go versions.
+	fmt.Fprintf(out, "Checking if go is installed...\n")
+
+	p, err := exec.LookPath("go")
+	if err != nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("`go` not found in $PATH"),
+			Remediation: fmt.Sprintf("To fix this error, install Go by visiting:\n\n\t$ %s", text.Bold("https://go.dev/doc/install")),
+		}
+	}
+
+	fmt.Fprintf(out, "Found go at %s\n", p)
+	fmt.Fprintf(out, "Checking if Go %s is installed...\n", g.config.File.Language.Go.ToolchainConstraint)
+
+	// output looks like: `go version go1.18.3 linux/amd64`
+	goVersion, err := commandVersion("go", 2)
+	if err != nil {
+		return err
+	}
+	goVersion = strings.TrimPrefix(goVersion, "go")
+
+	err = checkVersionConstraint("go", goVersion, g.config.File.Language.Go.ToolchainConstraint, "https://go.dev/doc/install")
+	if err != nil {
+		return err
+	}
+
+	// 2) Check `tinygo` is on $PATH and meets the version constraint.
+	fmt.Fprintf(out, "Checking if tinygo is installed...\n")
+
+	p, err = exec.LookPath("tinygo")
+	if err != nil {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("`tinygo` not found in $PATH"),
+			Remediation: fmt.Sprintf("To fix this error, install TinyGo by visiting:\n\n\t$ %s", text.Bold("https://tinygo.org/getting-started/install/")),
+		}
+	}
+
+	fmt.Fprintf(out, "Found tinygo at %s\n", p)
+	fmt.Fprintf(out, "Checking if TinyGo %s is installed...\n", g.config.File.Language.Go.TinyGoConstraint)
+
+	// output looks like: `tinygo version 0.24.0 linux/amd64 (using go version go1.18.3 and LLVM version 14.0.0)`
+	tinygoVersion, err := commandVersion("tinygo", 2)
+	if err != nil {
+		return err
+	}
+
+	err = checkVersionConstraint("tinygo", tinygoVersion, g.config.File.Language.Go.TinyGoConstraint, "https://tinygo.org/getting-started/install/")
+	if err != nil {
+		return err
+	}
+
+	// 3) Check go.mod file exists in $PWD
+	//
+	// The Compute@Edge Go SDK is a module dependency, so the package must be a
+	// Go module for TinyGo to resolve it.
+	fpath, err := filepath.Abs("go.mod")
+	if err != nil {
+		return fmt.Errorf("error getting go.mod path: %w", err)
+	}
+
+	if !filesystem.FileExists(fpath) {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("go.mod not found"),
+			Remediation: fmt.Sprintf("To fix this error, run the following command:\n\n\t$ %s", text.Bold("go mod init <module path>")),
+		}
+	}
+
+	fmt.Fprintf(out, "Found go.mod at %s\n", fpath)
+
+	return nil
+}
+
+// Initialize implements the Toolchain interface and initializes a newly cloned
+// package. It is a noop for Go as the Go toolchain downloads the package's
+// module dependencies when it builds.
+func (g Go) Initialize(out io.Writer) error { return nil }
+
+// Build implements the Toolchain interface and attempts to compile the package
+// Go source to a Wasm binary.
+func (g Go) Build(out io.Writer, verbose bool) error {
+	// Check if bin directory exists and create if not.
+	pwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("getting current working directory: %w", err)
+	}
+	binDir := filepath.Join(pwd, "bin")
+	if err := filesystem.MakeDirectoryIfNotExists(binDir); err != nil {
+		return fmt.Errorf("making bin directory: %w", err)
+	}
+
+	args := []string{
+		"build",
+		"-target=wasi",
+		"-wasm-abi=generic",
+		"-gc=conservative",
+		"-o",
+		filepath.Join(binDir, "main.wasm"),
+	}
+	if verbose {
+		args = append(args, "-x")
+	}
+	args = append(args, "./")
+
+	cmd := fstexec.Streaming{
+		Command: "tinygo",
+		Args:    args,
+		Env:     os.Environ(),
+		Output:  out,
+	}
+	if g.timeout > 0 {
+		cmd.Timeout = time.Duration(g.timeout) * time.Second
+	}
+	if err := cmd.Exec(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// commandVersion runs `<command> version` and returns the field of its first
+// line at the given index.
+func commandVersion(command string, field int) (string, error) {
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with variable
+	// Disabling as the command is one of our own constants.
+	/* #nosec */
+	cmd := exec.Command(command, "version")
+	stdoutStderr, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("error executing `%s version`: %w", command, err)
+	}
+
+	line := strings.SplitN(string(stdoutStderr), "\n", 2)[0]
+	parts := strings.Fields(line)
+	if len(parts) <= field {
+		return "", fmt.Errorf("error parsing `%s version` output: %s", command, line)
+	}
+	return parts[field], nil
+}
+
+// checkVersionConstraint returns a RemediationError if the installed version of
+// a command doesn't meet the given constraint.
+func checkVersionConstraint(command, installed, constraint, installURL string) error {
+	c, err := semver.NewConstraint(constraint)
+	if err != nil {
+		return fmt.Errorf("error parsing %s constraint: %w", command, err)
+	}
+
+	version, err := semver.NewVersion(installed)
+	if err != nil {
+		return fmt.Errorf("error parsing %s version: %w", command, err)
+	}
+
+	if !c.Check(version) {
+		return errors.RemediationError{
+			Inner:       fmt.Errorf("%s %s is incompatible with the constraint %s", command, version, constraint),
+			Remediation: fmt.Sprintf("To fix this error, install a version of %s within the given range %s by visiting:\n\n\t$ %s", command, constraint, text.Bold(installURL)),
+		}
+	}
+
+	return nil
+}
@@ -198,6 +198,7 @@ type File struct {
 	Language        string      `toml:"language"`
 	ServiceID       string      `toml:"service_id"`
 	LocalServer     LocalServer `toml:"local_server"`
+	Scripts         Scripts     `toml:"scripts,omitempty"`
 
 	exists bool
 	output io.Writer
@@ -209,6 +210,14 @@ type LocalServer struct {
 	Backends map[string]Backend `toml:"backends"`
 }
 
+// Scripts represents commands that replace the CLI's own steps for building a
+// package, so that toolchains the CLI doesn't know about can be used.
+type Scripts struct {
+	// Build is run by the system shell in place of the language toolchain and
+	// is expected to write the compiled package to bin/main.wasm.
+	Build string `toml:"build,omitempty"`
+}
+
 // Backend represents a backend to be mocked by the local testing server.
 type Backend struct {
 	URL string `toml:"url"`
@@ -283,6 +292,69 @@ func (f *File) Read(fpath string) error {
 	return nil
 }
 
+// EnvFilename returns the name of the manifest file for an environment, e.g.
+// fastly.stage.toml for stage, or Filename if no environment is given.
+func EnvFilename(env string) string {
+	if env == "" {
+		return Filename
+	}
+	return fmt.Sprintf("fastly.%s.toml", env)
+}
+
+// ReadEnv overlays the manifest file for an environment onto the manifest, so
+// that each environment can deploy to its own service.
+//
+// The service_id always comes from the environment's manifest file, so that
+// an environment without one gets a service of its own rather than the base
+// fastly.toml manifest's service. The name and local_server backends are only
+// taken from it where they're set. The other parameters always come from the
+// base fastly.toml manifest.
+func (f *File) ReadEnv(env string) error {
+	var overlay File
+	if err := overlay.ReadEnvFile(env); err != nil {
+		return err
+	}
+
+	f.ServiceID = overlay.ServiceID
+	if overlay.Name != "" {
+		f.Name = overlay.Name
+	}
+	for name, backend := range overlay.LocalServer.Backends {
+		if f.LocalServer.Backends == nil {
+			f.LocalServer.Backends = make(map[string]Backend)
+		}
+		f.LocalServer.Backends[name] = backend
+	}
+
+	f.exists = true
+	return nil
+}
+
+// ReadEnvFile loads the manifest file for an environment from disk, without
+// overlaying it onto the base fastly.toml manifest, so that it can be updated
+// and written back on its own.
+func (f *File) ReadEnvFile(env string) error {
+	fpath := EnvFilename(env)
+
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable.
+	// Disabling as we need to load the environment manifest from the user's
+	// file system. This file is decoded into a predefined struct, any
+	// unrecognised fields are dropped.
+	/* #nosec */
+	bs, err := os.ReadFile(fpath)
+	if err != nil {
+		return fmt.Errorf("error reading %s manifest: %w", env, err)
+	}
+
+	if err := toml.Unmarshal(bs, f); err != nil {
+		return fmt.Errorf("error parsing %s: %w", fpath, err)
+	}
+
+	f.exists = true
+	return nil
+}
+
 // containsManifestSection loads the slice of bytes into a toml tree structure
 // before checking if the manifest_version is defined as a toml section block.
 func containsManifestSection(bs []byte) (bool, error) {
@@ -379,4 +451,5 @@ type Flag struct {
 	Description string
 	Authors     []string
 	ServiceID   string
+	Env         string
 }
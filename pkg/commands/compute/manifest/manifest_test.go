@@ -1,7 +1,6 @@
 package manifest_test
 
 import (
-	"errors"
 	"os"
 	"path/filepath"
 	"strings"
@@ -100,9 +99,10 @@ func TestManifest(t *testing.T) {
 			} else {
 				// otherwise if we expect the manifest to be invalid/unrecognised then
 				// the error should match our expectations.
-				if !errors.As(err, &tc.expectedError) {
-					t.Fatalf("incorrect error type: %T, expected: %T", err, tc.expectedError)
-				}
+				//
+				// NOTE: the toml decoder doesn't wrap the error it's given, so only
+				// the message can be compared.
+				testutil.AssertErrorContains(t, err, tc.expectedError.Error())
 			}
 		})
 	}
@@ -222,6 +222,113 @@ func TestDataServiceID(t *testing.T) {
 	}
 }
 
+func TestFileReadEnv(t *testing.T) {
+	// We're going to chdir to an environment with manifests,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: `service_id = "stage"
+[local_server.backends.origin]
+url = "https://stage.example.com"`, Dst: "fastly.stage.toml"},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	f := manifest.File{
+		Name:      "test",
+		Language:  "rust",
+		ServiceID: "base",
+		LocalServer: manifest.LocalServer{
+			Backends: map[string]manifest.Backend{
+				"origin": {URL: "https://example.com"},
+				"auth":   {URL: "https://auth.example.com"},
+			},
+		},
+	}
+	if err := f.ReadEnv("stage"); err != nil {
+		t.Fatal(err)
+	}
+
+	testutil.AssertString(t, "stage", f.ServiceID)
+	testutil.AssertString(t, "test", f.Name)
+	testutil.AssertString(t, "rust", f.Language)
+	testutil.AssertString(t, "https://stage.example.com", f.LocalServer.Backends["origin"].URL)
+	testutil.AssertString(t, "https://auth.example.com", f.LocalServer.Backends["auth"].URL)
+
+	// The service_id isn't taken from the base manifest when the environment's
+	// manifest file doesn't set one.
+	if err := os.WriteFile("fastly.prod.toml", []byte(`name = "prod"`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.ReadEnv("prod"); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, "", f.ServiceID)
+	testutil.AssertString(t, "prod", f.Name)
+
+	testutil.AssertErrorContains(t, f.ReadEnv("dev"), "error reading dev manifest")
+	testutil.AssertString(t, "fastly.toml", manifest.EnvFilename(""))
+	testutil.AssertString(t, "fastly.prod.toml", manifest.EnvFilename("prod"))
+}
+
+func TestFileReadEnvFile(t *testing.T) {
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rootdir := testutil.NewEnv(testutil.EnvOpts{
+		T: t,
+		Write: []testutil.FileIO{
+			{Src: `manifest_version = 1
+name = "base"
+language = "rust"`, Dst: "fastly.toml"},
+			{Src: `[local_server.backends.origin]
+url = "https://stage.example.com"`, Dst: "fastly.stage.toml"},
+		},
+	})
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	var f manifest.File
+	if err := f.ReadEnvFile("stage"); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, "", f.Name)
+
+	f.ServiceID = "123"
+	if err := f.Write(manifest.EnvFilename("stage")); err != nil {
+		t.Fatal(err)
+	}
+
+	var m manifest.File
+	if err := m.Read(manifest.Filename); err != nil {
+		t.Fatal(err)
+	}
+	if err := m.ReadEnv("stage"); err != nil {
+		t.Fatal(err)
+	}
+	testutil.AssertString(t, "123", m.ServiceID)
+	testutil.AssertString(t, "base", m.Name)
+	testutil.AssertString(t, "rust", m.Language)
+	testutil.AssertString(t, "https://stage.example.com", m.LocalServer.Backends["origin"].URL)
+}
+
 // This test validates that manually added changes, such as the toml
 // syntax for Viceroy local testing, are not accidentally deleted after
 // decoding and encoding flows.
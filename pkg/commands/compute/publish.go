@@ -2,6 +2,7 @@ package compute
 
 import (
 	"io"
+	"time"
 
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/commands/compute/manifest"
@@ -17,8 +18,10 @@ type PublishCommand struct {
 	deploy   *DeployCommand
 
 	// Deploy fields
+	activate       cmd.OptionalBool
 	backend        cmd.OptionalString
 	backendPort    cmd.OptionalUint
+	canary         cmd.OptionalBool
 	comment        cmd.OptionalString
 	domain         cmd.OptionalString
 	overrideHost   cmd.OptionalString
@@ -26,6 +29,11 @@ type PublishCommand struct {
 	serviceVersion cmd.OptionalServiceVersion
 	sslSNIHostname cmd.OptionalString
 
+	// The canary window and threshold have defaults, which are only applied to
+	// the flags of the command being run, so they're always passed to deploy.
+	canaryThreshold float64
+	canaryWindow    time.Duration
+
 	// Build fields
 	name       cmd.OptionalString
 	lang       cmd.OptionalString
@@ -52,14 +60,18 @@ func NewPublishCommand(parent cmd.Registerer, globals *config.Data, build *Build
 	c.CmdClause.Flag("timeout", "Timeout, in seconds, for the build compilation step").Action(c.timeout.Set).IntVar(&c.timeout.Value)
 
 	// Deploy flags
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
 		Action:   c.serviceVersion.Set,
 		Dst:      &c.serviceVersion.Value,
 		Optional: true,
 	})
+	c.CmdClause.Flag("activate", "Activate the version the package is uploaded to (use --no-activate to leave it as a draft)").Action(c.activate.Set).NegatableBoolVar(&c.activate.Value)
 	c.CmdClause.Flag("backend", "A hostname, IPv4, or IPv6 address for the package backend").Action(c.backend.Set).StringVar(&c.backend.Value)
 	c.CmdClause.Flag("backend-port", "A port number for the package backend").Action(c.backendPort.Set).UintVar(&c.backendPort.Value)
+	c.CmdClause.Flag("canary", "After activating, watch the ratio of 5xx responses and reactivate the previously active version if it crosses --canary-threshold").Action(c.canary.Set).BoolVar(&c.canary.Value)
+	c.CmdClause.Flag("canary-threshold", "The ratio of 5xx responses to requests (between 0 and 1) above which --canary rolls back").Default(defaultCanaryThreshold).Float64Var(&c.canaryThreshold)
+	c.CmdClause.Flag("canary-window", "How long --canary watches real-time stats for after activating (e.g. 30s, 5m)").Default(defaultCanaryWindow).DurationVar(&c.canaryWindow)
 	c.CmdClause.Flag("comment", "Human-readable comment").Action(c.comment.Set).StringVar(&c.comment.Value)
 	c.CmdClause.Flag("domain", "The name of the domain associated to the package").Action(c.domain.Set).StringVar(&c.domain.Value)
 	c.CmdClause.Flag("override-host", "The hostname to override the Host header").Action(c.backendPort.Set).StringVar(&c.overrideHost.Value)
@@ -93,6 +105,9 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.timeout.WasSet {
 		c.build.Timeout = c.timeout.Value
 	}
+	if c.manifest.Flag.Env != "" {
+		c.build.Env = c.manifest.Flag.Env
+	}
 
 	err = c.build.Exec(in, out)
 	if err != nil {
@@ -127,6 +142,14 @@ func (c *PublishCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	if c.comment.WasSet {
 		c.deploy.Comment = c.comment
 	}
+	if c.activate.WasSet {
+		c.deploy.Activate = c.activate
+	}
+	if c.canary.WasSet {
+		c.deploy.Canary.Enabled = c.canary.Value
+	}
+	c.deploy.Canary.Threshold = c.canaryThreshold
+	c.deploy.Canary.Window = c.canaryWindow
 	c.deploy.Manifest = c.manifest
 
 	err = c.deploy.Exec(in, out)
@@ -6,9 +6,12 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"syscall"
+	"time"
 
 	"github.com/blang/semver"
 	"github.com/fastly/cli/pkg/cmd"
@@ -21,13 +24,16 @@ import (
 	"github.com/fastly/cli/pkg/text"
 )
 
+// viceroyStopTimeout is how long the local server is given to exit after being
+// asked to, before it's killed.
+var viceroyStopTimeout = 5 * time.Second
+
 // ServeCommand produces and runs an artifact from files on the local disk.
 type ServeCommand struct {
 	cmd.Base
 
 	addr             string
 	build            *BuildCommand
-	env              cmd.OptionalString
 	file             string
 	force            cmd.OptionalBool
 	includeSrc       cmd.OptionalBool
@@ -36,6 +42,7 @@ type ServeCommand struct {
 	name             cmd.OptionalString
 	skipBuild        bool
 	viceroyVersioner update.Versioner
+	watch            bool
 }
 
 // NewServeCommand returns a usable command registered under the parent.
@@ -52,34 +59,38 @@ func NewServeCommand(parent cmd.Registerer, globals *config.Data, build *BuildCo
 	c.manifest.File.Read(manifest.Filename)
 
 	c.CmdClause.Flag("addr", "The IPv4 address and port to listen on").Default("127.0.0.1:7676").StringVar(&c.addr)
-	c.CmdClause.Flag("env", "The environment configuration to use (e.g. stage)").Action(c.env.Set).StringVar(&c.env.Value)
+	c.RegisterEnvFlag(&c.manifest)
 	c.CmdClause.Flag("file", "The Wasm file to run").Default("bin/main.wasm").StringVar(&c.file)
 	c.CmdClause.Flag("force", "Skip verification steps and force build").Action(c.force.Set).BoolVar(&c.force.Value)
 	c.CmdClause.Flag("include-source", "Include source code in built package").Action(c.includeSrc.Set).BoolVar(&c.includeSrc.Value)
 	c.CmdClause.Flag("language", "Language type").Action(c.lang.Set).StringVar(&c.lang.Value)
 	c.CmdClause.Flag("name", "Package name").Action(c.name.Set).StringVar(&c.name.Value)
 	c.CmdClause.Flag("skip-build", "Skip the build step").BoolVar(&c.skipBuild)
+	c.CmdClause.Flag("watch", "Watch for changes to the package source, then rebuild and restart the local server").BoolVar(&c.watch)
 
 	return &c
 }
 
 // Exec implements the command interface.
 func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
-	if !c.skipBuild {
-		// Reset the fields on the BuildCommand based on ServeCommand values.
-		if c.name.WasSet {
-			c.build.PackageName = c.name.Value
-		}
-		if c.lang.WasSet {
-			c.build.Lang = c.lang.Value
-		}
-		if c.includeSrc.WasSet {
-			c.build.IncludeSrc = c.includeSrc.Value
-		}
-		if c.force.WasSet {
-			c.build.Force = c.force.Value
-		}
+	// Reset the fields on the BuildCommand based on ServeCommand values.
+	if c.name.WasSet {
+		c.build.PackageName = c.name.Value
+	}
+	if c.lang.WasSet {
+		c.build.Lang = c.lang.Value
+	}
+	if c.includeSrc.WasSet {
+		c.build.IncludeSrc = c.includeSrc.Value
+	}
+	if c.force.WasSet {
+		c.build.Force = c.force.Value
+	}
+	if c.manifest.Flag.Env != "" {
+		c.build.Env = c.manifest.Flag.Env
+	}
 
+	if !c.skipBuild {
 		err = c.build.Exec(in, out)
 		if err != nil {
 			return err
@@ -103,7 +114,18 @@ func (c *ServeCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	progress.Step("Running local server...")
 	progress.Done()
 
-	err = local(bin, c.file, progress, out, c.addr, c.env.Value, c.Globals.Verbose())
+	manifestPath, cleanup, err := c.viceroyManifest()
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+	defer cleanup()
+
+	if c.watch {
+		return c.watchAndServe(in, out, bin, manifestPath)
+	}
+
+	err = local(bin, c.file, progress, out, c.addr, manifestPath, c.Globals.Verbose())
 	if err != nil {
 		if err == errors.ErrSignalInterrupt || err == errors.ErrSignalKilled {
 			text.Break(out)
@@ -273,44 +295,216 @@ func updateViceroy(progress text.Progress, version string, out io.Writer, versio
 }
 
 // local spawns a subprocess that runs the compiled binary.
-func local(bin string, file string, progress text.Progress, out io.Writer, addr string, env string, verbose bool) error {
-	if env != "" {
-		env = "." + env
+func local(bin string, file string, progress text.Progress, out io.Writer, addr string, manifestPath string, verbose bool) error {
+	cmd, err := viceroy(bin, file, out, addr, manifestPath, verbose)
+	if err != nil {
+		return err
+	}
+	cmd.MonitorSignals()
+
+	text.Break(out)
+
+	if err := cmd.Exec(); err != nil {
+		e := strings.TrimSpace(err.Error())
+		if strings.Contains(e, "interrupt") {
+			return errors.ErrSignalInterrupt
+		}
+		if strings.Contains(e, "killed") {
+			return errors.ErrSignalKilled
+		}
+		return err
 	}
 
-	wd, err := os.Getwd()
+	return nil
+}
+
+// viceroyManifest returns the path of the manifest for Viceroy to read, and a
+// function that removes it once Viceroy has stopped.
+//
+// An environment's manifest file (see manifest.File.ReadEnv) only holds the
+// parameters that differ from the fastly.toml manifest, so with --env the
+// merged manifest is written to a temporary file instead.
+func (c *ServeCommand) viceroyManifest() (string, func(), error) {
+	if c.manifest.Flag.Env == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return "", nil, err
+		}
+		return filepath.Join(wd, manifest.Filename), func() {}, nil
+	}
+
+	f, err := os.CreateTemp("", "fastly.*.toml")
 	if err != nil {
+		return "", nil, fmt.Errorf("error creating %s manifest for the local server: %w", c.manifest.Flag.Env, err)
+	}
+	cleanup := func() {
+		os.Remove(f.Name())
+	}
+	if err := f.Close(); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("error creating %s manifest for the local server: %w", c.manifest.Flag.Env, err)
+	}
+	if err := c.manifest.File.Write(f.Name()); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("error writing %s manifest for the local server: %w", c.manifest.Flag.Env, err)
+	}
+	return f.Name(), cleanup, nil
+}
+
+// refreshViceroyManifest re-reads the fastly.toml manifest and, with --env,
+// the environment's manifest file, then rewrites the merged manifest returned
+// by viceroyManifest so that a restarted local server sees any changes.
+func (c *ServeCommand) refreshViceroyManifest(manifestPath string) error {
+	if c.manifest.Flag.Env == "" {
+		return nil
+	}
+
+	var m manifest.File
+	m.SetOutput(c.Globals.Output)
+	if err := m.Read(manifest.Filename); err != nil {
+		return fmt.Errorf("error reading package manifest: %w", err)
+	}
+	if err := m.ReadEnv(c.manifest.Flag.Env); err != nil {
 		return err
 	}
+	if err := m.Write(manifestPath); err != nil {
+		return fmt.Errorf("error writing %s manifest for the local server: %w", c.manifest.Flag.Env, err)
+	}
+	c.manifest.File = m
+	return nil
+}
 
-	manifest := filepath.Join(wd, fmt.Sprintf("fastly%s.toml", env))
-	args := []string{"-C", manifest, "--addr", addr, file}
+// viceroy returns a command that runs the compiled binary using Viceroy.
+func viceroy(bin string, file string, out io.Writer, addr string, manifestPath string, verbose bool) (*fstexec.Streaming, error) {
+	args := []string{"-C", manifestPath, "--addr", addr, file}
 
 	if verbose {
 		text.Output(out, "Wasm file: %s", file)
-		text.Output(out, "Manifest: %s", manifest)
+		text.Output(out, "Manifest: %s", manifestPath)
 	}
 
-	cmd := fstexec.Streaming{
+	return &fstexec.Streaming{
 		Command: bin,
 		Args:    args,
 		Env:     os.Environ(),
 		Output:  out,
+	}, nil
+}
+
+// watchAndServe runs the compiled binary and, whenever the package source
+// changes, rebuilds the package and restarts the local server.
+//
+// A failed build is reported and the local server is left running the
+// previous build until the next change, as is a server that exits by itself.
+func (c *ServeCommand) watchAndServe(in io.Reader, out io.Writer, bin string, manifestPath string) error {
+	lang := c.manifest.File.Language
+	if c.lang.WasSet {
+		lang = c.lang.Value
+	}
+	language, err := c.build.newLanguage(strings.ToLower(strings.TrimSpace(lang)), c.manifest.File.Scripts)
+	if err != nil {
+		return err
 	}
-	cmd.MonitorSignals()
 
-	text.Break(out)
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(signals)
 
-	if err := cmd.Exec(); err != nil {
-		e := strings.TrimSpace(err.Error())
-		if strings.Contains(e, "interrupt") {
-			return errors.ErrSignalInterrupt
+	stop := make(chan struct{})
+	defer close(stop)
+	changes := newWatcher(language, c.manifest.Flag.Env).watch(stop)
+
+	var (
+		server *fstexec.Streaming
+		done   chan error
+	)
+	start := func() error {
+		server, err = viceroy(bin, c.file, out, c.addr, manifestPath, c.Globals.Verbose())
+		if err != nil {
+			return err
 		}
-		if strings.Contains(e, "killed") {
-			return errors.ErrSignalKilled
+		text.Break(out)
+		text.Info(out, "Watching %s for changes. Press Ctrl-C to stop.", language.SourceDirectory)
+		text.Break(out)
+
+		// The server is started before the goroutine, so that it can be
+		// signalled as soon as start returns.
+		if err := server.Start(); err != nil {
+			return err
 		}
+		done = make(chan error, 1)
+		go func(s *fstexec.Streaming) {
+			done <- s.Wait()
+		}(server)
+		return nil
+	}
+	if err := start(); err != nil {
 		return err
 	}
 
+	for {
+		select {
+		case <-signals:
+			if done != nil {
+				if err := stopServer(server, done); err != nil {
+					return err
+				}
+			}
+			text.Break(out)
+			text.Info(out, "Local server stopped")
+			return nil
+		case err := <-done:
+			done = nil
+			text.Break(out)
+			if err != nil {
+				errors.Deduce(err).Print(out)
+			}
+			text.Warning(out, "Local server exited. Waiting for changes...")
+		case files := <-changes:
+			text.Break(out)
+			text.Info(out, "Changed: %s", strings.Join(files, ", "))
+			text.Break(out)
+
+			if err := c.build.Exec(in, out); err != nil {
+				text.Break(out)
+				errors.Deduce(err).Print(out)
+				text.Warning(out, "Build failed. Waiting for changes...")
+				continue
+			}
+			if err := c.refreshViceroyManifest(manifestPath); err != nil {
+				text.Break(out)
+				errors.Deduce(err).Print(out)
+				text.Warning(out, "Reading the manifest failed. Waiting for changes...")
+				continue
+			}
+
+			if done != nil {
+				if err := stopServer(server, done); err != nil {
+					return err
+				}
+			}
+			if err := start(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// stopServer asks the local server to exit, then kills it if it hasn't exited
+// within viceroyStopTimeout (or can't be asked, e.g. on Windows), waiting for
+// it to exit either way.
+func stopServer(server *fstexec.Streaming, done <-chan error) error {
+	if err := server.Signal(syscall.SIGTERM); err == nil {
+		select {
+		case <-done:
+			return nil
+		case <-time.After(viceroyStopTimeout):
+		}
+	}
+
+	if err := server.Signal(os.Kill); err != nil {
+		return err
+	}
+	<-done
 	return nil
 }
@@ -2,11 +2,19 @@ package compute
 
 import (
 	"bytes"
+	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"reflect"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	fstexec "github.com/fastly/cli/pkg/exec"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/text"
 )
@@ -97,4 +105,211 @@ func makeEnvironment(downloadedFilename string, t *testing.T) (string, string, s
 	return downloadDir, installDir, fpath
 }
 
+// TestWatcher validates that changes to the package source and the
+// environment's manifest are reported once they settle, and that files matched
+// by .fastlyignore are not watched.
+func TestWatcher(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	for name, content := range map[string]string{
+		"fastly.toml":       "name = \"test\"",
+		"fastly.stage.toml": "service_id = \"123\"",
+		"Cargo.toml":        "[package]",
+		"src/main.rs":       "fn main() {}",
+		"src/ignored.rs":    "// ignored",
+		".fastlyignore":     "src/ignored.rs",
+	} {
+		if err := os.MkdirAll(filepath.Dir(name), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(name, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	w := newWatcher(&Language{SourceDirectory: "src", IncludeFiles: []string{"Cargo.toml"}}, "stage")
+	w.interval = 10 * time.Millisecond
+	w.debounce = 100 * time.Millisecond
+
+	stop := make(chan struct{})
+	defer close(stop)
+	changes := w.watch(stop)
+	time.Sleep(50 * time.Millisecond)
+
+	if err := os.WriteFile("src/ignored.rs", []byte("// still ignored"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := os.WriteFile("src/main.rs", []byte(fmt.Sprintf("fn main() { %d }", i)), 0600); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	if err := os.Remove("Cargo.toml"); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile("fastly.stage.toml", []byte("service_id = \"456\""), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case files := <-changes:
+		want := []string{"Cargo.toml", "fastly.stage.toml", "src/main.rs"}
+		if !reflect.DeepEqual(files, want) {
+			t.Fatalf("want %v, have %v", want, files)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no changes reported")
+	}
+
+	select {
+	case files := <-changes:
+		t.Fatalf("unexpected changes reported: %v", files)
+	case <-time.After(300 * time.Millisecond):
+	}
+}
+
+// TestStopServer validates that the local server is asked to exit before it's
+// killed, and that it's killed if it doesn't exit in time.
+func TestStopServer(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("processes can only be killed, not asked to exit, on Windows")
+	}
+
+	defer func(d time.Duration) {
+		viceroyStopTimeout = d
+	}(viceroyStopTimeout)
+	viceroyStopTimeout = 200 * time.Millisecond
+
+	for _, testcase := range []struct {
+		name    string
+		script  string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:    "exits when asked",
+			script:  "trap 'echo stopping; exit 0' TERM; while true; do sleep 0.01; done",
+			wantMax: 150 * time.Millisecond,
+		},
+		{
+			name:    "killed when it ignores the request",
+			script:  "trap '' TERM; while true; do sleep 0.01; done",
+			wantMin: 200 * time.Millisecond,
+			wantMax: 5 * time.Second,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var out bytes.Buffer
+			server := &fstexec.Streaming{
+				Command: "sh",
+				Args:    []string{"-c", testcase.script},
+				Output:  &out,
+			}
+			if err := server.Start(); err != nil {
+				t.Fatal(err)
+			}
+			done := make(chan error, 1)
+			go func() {
+				done <- server.Wait()
+			}()
+			// Give the shell time to install its trap.
+			time.Sleep(100 * time.Millisecond)
+
+			begin := time.Now()
+			if err := stopServer(server, done); err != nil {
+				t.Fatal(err)
+			}
+			if d := time.Since(begin); d < testcase.wantMin || d > testcase.wantMax {
+				t.Errorf("want stopped in %s to %s, took %s", testcase.wantMin, testcase.wantMax, d)
+			}
+		})
+	}
+}
+
+// TestViceroyManifest validates that with --env the local server is given the
+// merged manifest, rather than the environment's partial manifest file.
+func TestViceroyManifest(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	var c ServeCommand
+	c.Globals = &config.Data{Output: io.Discard}
+	c.manifest.File = manifest.File{
+		Name: "test",
+		LocalServer: manifest.LocalServer{
+			Backends: map[string]manifest.Backend{
+				"origin": {URL: "https://stage.example.com"},
+				"auth":   {URL: "https://auth.example.com"},
+			},
+		},
+	}
+
+	path, cleanup, err := c.viceroyManifest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cleanup()
+	if want := filepath.Join(dir, manifest.Filename); path != want {
+		t.Fatalf("want %s, have %s", want, path)
+	}
+
+	c.manifest.Flag.Env = "stage"
+	path, cleanup, err = c.viceroyManifest()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"https://stage.example.com", "https://auth.example.com"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("want %q in manifest, have:\n%s", want, b)
+		}
+	}
+
+	// A restarted local server is given the manifests as they are on disk.
+	for name, content := range map[string]string{
+		manifest.Filename:   "manifest_version = 1\nname = \"test\"\n[local_server.backends.auth]\nurl = \"https://auth2.example.com\"\n",
+		"fastly.stage.toml": "[local_server.backends.origin]\nurl = \"https://stage2.example.com\"\n",
+	} {
+		if err := os.WriteFile(name, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := c.refreshViceroyManifest(path); err != nil {
+		t.Fatal(err)
+	}
+	b, err = os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"https://stage2.example.com", "https://auth2.example.com"} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("want %q in refreshed manifest, have:\n%s", want, b)
+		}
+	}
+
+	cleanup()
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("want %s removed, have %v", path, err)
+	}
+}
+
 // TODO: Write tests for the other functions in serve.go
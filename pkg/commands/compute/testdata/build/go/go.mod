@@ -0,0 +1,5 @@
+module github.com/fastly/compute-starter-kit-go-default
+
+go 1.17
+
+require github.com/fastly/compute-sdk-go v0.1.1
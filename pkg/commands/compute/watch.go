@@ -0,0 +1,155 @@
+package compute
+
+import (
+	"os"
+	"sort"
+	"time"
+
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+)
+
+// watchInterval is how often the watched files are polled for changes.
+var watchInterval = 250 * time.Millisecond
+
+// watchDebounce is how long the watched files must stop changing for before a
+// change is reported, so that a burst of saves only triggers one rebuild.
+var watchDebounce = 500 * time.Millisecond
+
+// fileState is the state of a watched file that's compared between polls.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// watcher polls a set of files for changes.
+type watcher struct {
+	files    func() ([]string, error)
+	interval time.Duration
+	debounce time.Duration
+}
+
+// newWatcher returns a watcher of the files that make up a Compute@Edge
+// package: the manifest (and the environment's manifest file, if any), the
+// language's include files and everything in its source directory, less any
+// files matched by the .fastlyignore file.
+func newWatcher(language *Language, env string) *watcher {
+	return &watcher{
+		files: func() ([]string, error) {
+			ignored, err := GetIgnoredFiles(IgnoreFilePath)
+			if err != nil {
+				return nil, err
+			}
+
+			manifests := []string{manifest.Filename}
+			if env != "" {
+				manifests = append(manifests, manifest.EnvFilename(env))
+			}
+
+			var files []string
+			for _, f := range append(manifests, language.IncludeFiles...) {
+				if !ignored[f] {
+					files = append(files, f)
+				}
+			}
+
+			src, err := GetSourceFiles(language.SourceDirectory, ignored)
+			if err != nil && !os.IsNotExist(err) {
+				return nil, err
+			}
+			return append(files, src...), nil
+		},
+		interval: watchInterval,
+		debounce: watchDebounce,
+	}
+}
+
+// snapshot returns the current state of the watched files. Files that don't
+// exist are omitted, so that deleting a file is seen as a change.
+func (w *watcher) snapshot() (map[string]fileState, error) {
+	files, err := w.files()
+	if err != nil {
+		return nil, err
+	}
+
+	s := make(map[string]fileState, len(files))
+	for _, f := range files {
+		info, err := os.Stat(f)
+		if err != nil {
+			continue
+		}
+		s[f] = fileState{modTime: info.ModTime(), size: info.Size()}
+	}
+	return s, nil
+}
+
+// watch polls the watched files until stop is closed. Once files have changed
+// and then stopped changing for the debounce period, the sorted names of the
+// changed files are sent on the returned channel.
+//
+// Polls that fail, for example because .fastlyignore is mid-edit, are skipped.
+func (w *watcher) watch(stop <-chan struct{}) <-chan []string {
+	changes := make(chan []string)
+
+	go func() {
+		prev, _ := w.snapshot()
+		pending := make(map[string]bool)
+		var last time.Time
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case now := <-ticker.C:
+				cur, err := w.snapshot()
+				if err != nil {
+					continue
+				}
+				if changed := diffSnapshots(prev, cur); len(changed) > 0 {
+					for _, f := range changed {
+						pending[f] = true
+					}
+					prev = cur
+					last = now
+					continue
+				}
+				if len(pending) == 0 || now.Sub(last) < w.debounce {
+					continue
+				}
+
+				files := make([]string, 0, len(pending))
+				for f := range pending {
+					files = append(files, f)
+				}
+				sort.Strings(files)
+				select {
+				case changes <- files:
+					pending = make(map[string]bool)
+				case <-stop:
+					return
+				}
+			}
+		}
+	}()
+
+	return changes
+}
+
+// diffSnapshots returns the files that were added, removed or modified
+// between two snapshots.
+func diffSnapshots(prev, cur map[string]fileState) []string {
+	var changed []string
+	for f, s := range cur {
+		if p, ok := prev[f]; !ok || !p.modTime.Equal(s.modTime) || p.size != s.size {
+			changed = append(changed, f)
+		}
+	}
+	for f := range prev {
+		if _, ok := cur[f]; !ok {
+			changed = append(changed, f)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,351 @@
+package condition_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestConditionCreate(t *testing.T) {
+	statementFile := testutil.MakeTempFile(t, `req.url ~ "^/api/"`)
+	defer os.Remove(statementFile)
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("condition create --service-id 123 --version 1 --type REQUEST --statement true"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args:      args("condition create --service-id 123 --version 1 --name api --type DELIVER --statement true"),
+			wantError: "error parsing arguments: enum value must be one of REQUEST,RESPONSE,CACHE,PREFETCH, got 'DELIVER'",
+		},
+		{
+			args: args("condition create --service-id 123 --version 1 --name api --type REQUEST --statement true --autoclone"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateConditionFn: createConditionError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("condition create --service-id 123 --version 1 --name api --type REQUEST --priority 5 --statement " + statementFile + " --autoclone"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				CreateConditionFn: createConditionOK,
+			},
+			wantOutput: "Created condition api (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestConditionList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("condition list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			wantOutput: listConditionsShortOutput,
+		},
+		{
+			args: args("condition list --service-id 123 --version 1 --verbose"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsOK,
+			},
+			wantOutput: listConditionsVerboseOutput,
+		},
+		{
+			args: args("condition list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				ListConditionsFn: listConditionsError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestConditionDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("condition describe --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("condition describe --service-id 123 --version 1 --name api"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetConditionFn: getConditionError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("condition describe --service-id 123 --version 1 --name api"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetConditionFn: getConditionOK,
+			},
+			wantOutput: describeConditionOutput,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestConditionUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("condition update --service-id 123 --version 1 --priority 10"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("condition update --service-id 123 --version 1 --name api --priority 10 --autoclone"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				UpdateConditionFn: updateConditionError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("condition update --service-id 123 --version 1 --name api --type RESPONSE --statement false --priority 10 --autoclone"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				UpdateConditionFn: updateConditionOK,
+			},
+			wantOutput: "Updated condition api (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestConditionDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("condition delete --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("condition delete --service-id 123 --version 1 --name api --autoclone"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				DeleteConditionFn: deleteConditionError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("condition delete --service-id 123 --version 1 --name api --autoclone"),
+			api: mock.API{
+				ListVersionsFn:    testutil.ListVersions,
+				CloneVersionFn:    testutil.CloneVersionResult(4),
+				DeleteConditionFn: deleteConditionOK,
+			},
+			wantOutput: "Deleted condition api (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createConditionOK(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	if i.Statement != `req.url ~ "^/api/"` || i.Type != "REQUEST" || i.Priority != 5 {
+		return nil, errTest
+	}
+	return &fastly.Condition{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Statement:      i.Statement,
+		Type:           i.Type,
+		Priority:       i.Priority,
+	}, nil
+}
+
+func createConditionError(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return nil, errTest
+}
+
+func listConditionsOK(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return []*fastly.Condition{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "api",
+			Statement:      `req.url ~ "^/api/"`,
+			Type:           "REQUEST",
+			Priority:       10,
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "not-found",
+			Comment:        "cache 404s",
+			Statement:      "beresp.status == 404",
+			Type:           "CACHE",
+			Priority:       100,
+		},
+	}, nil
+}
+
+func listConditionsError(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return nil, errTest
+}
+
+var listConditionsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME       TYPE     PRIORITY  STATEMENT
+123      1        api        REQUEST  10        req.url ~ "^/api/"
+123      1        not-found  CACHE    100       beresp.status == 404
+`) + "\n"
+
+var listConditionsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Condition 1/2",
+	"		Name: api",
+	"		Comment: ",
+	"		Type: REQUEST",
+	"		Priority: 10",
+	`		Statement: req.url ~ "^/api/"`,
+	"	Condition 2/2",
+	"		Name: not-found",
+	"		Comment: cache 404s",
+	"		Type: CACHE",
+	"		Priority: 100",
+	"		Statement: beresp.status == 404",
+}, "\n") + "\n\n"
+
+func getConditionOK(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return &fastly.Condition{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "api",
+		Comment:        "API requests",
+		Statement:      `req.url ~ "^/api/"`,
+		Type:           "REQUEST",
+		Priority:       10,
+	}, nil
+}
+
+func getConditionError(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return nil, errTest
+}
+
+var describeConditionOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: api",
+	"Comment: API requests",
+	"Type: REQUEST",
+	"Priority: 10",
+	`Statement: req.url ~ "^/api/"`,
+}, "\n") + "\n"
+
+func updateConditionOK(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	if *i.Type != "RESPONSE" || *i.Statement != "false" || *i.Priority != 10 {
+		return nil, errTest
+	}
+	return &fastly.Condition{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func updateConditionError(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return nil, errTest
+}
+
+func deleteConditionOK(i *fastly.DeleteConditionInput) error {
+	return nil
+}
+
+func deleteConditionError(i *fastly.DeleteConditionInput) error {
+	return errTest
+}
@@ -0,0 +1,81 @@
+package condition
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Types is the list of supported condition types.
+var Types = []string{"REQUEST", "RESPONSE", "CACHE", "PREFETCH"}
+
+// CreateCommand calls the Fastly API to create conditions.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateConditionInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a condition on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Condition name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("type", "Type of the condition. Valid values are: REQUEST, RESPONSE, CACHE, PREFETCH").Required().HintOptions(Types...).EnumVar(&c.Input.Type, Types...)
+	c.CmdClause.Flag("statement", "VCL conditional expression passed as file path or content, e.g. $(< condition.vcl)").Required().StringVar(&c.Input.Statement)
+	c.CmdClause.Flag("priority", "Priority determines execution order. Lower numbers execute first").IntVar(&c.Input.Priority)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Statement = cmd.Content(c.Input.Statement)
+
+	cond, err := c.Globals.Client.CreateCondition(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created condition %s (service %s version %d)", cond.Name, cond.ServiceID, cond.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,99 @@
+package condition
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update conditions.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateConditionInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	Comment   cmd.OptionalString
+	Type      cmd.OptionalString
+	Statement cmd.OptionalString
+	Priority  cmd.OptionalInt
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a condition on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Condition name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("comment", "A descriptive note").Action(c.Comment.Set).StringVar(&c.Comment.Value)
+	c.CmdClause.Flag("type", "Type of the condition. Valid values are: REQUEST, RESPONSE, CACHE, PREFETCH").Action(c.Type.Set).HintOptions(Types...).EnumVar(&c.Type.Value, Types...)
+	c.CmdClause.Flag("statement", "VCL conditional expression passed as file path or content, e.g. $(< condition.vcl)").Action(c.Statement.Set).StringVar(&c.Statement.Value)
+	c.CmdClause.Flag("priority", "Priority determines execution order. Lower numbers execute first").Action(c.Priority.Set).IntVar(&c.Priority.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.Comment.WasSet {
+		c.input.Comment = fastly.String(c.Comment.Value)
+	}
+
+	if c.Type.WasSet {
+		c.input.Type = fastly.String(c.Type.Value)
+	}
+
+	if c.Statement.WasSet {
+		c.input.Statement = fastly.String(cmd.Content(c.Statement.Value))
+	}
+
+	if c.Priority.WasSet {
+		c.input.Priority = fastly.Int(c.Priority.Value)
+	}
+
+	cond, err := c.Globals.Client.UpdateCondition(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated condition %s (service %s version %d)", cond.Name, cond.ServiceID, cond.ServiceVersion)
+	return nil
+}
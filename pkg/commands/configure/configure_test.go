@@ -67,6 +67,10 @@ func TestConfigure(t *testing.T) {
 
 [language]
 
+  [language.go]
+    tinygo_constraint = ""
+    toolchain_constraint = ""
+
   [language.rust]
     fastly_sys_constraint = ""
     rustup_constraint = ""
@@ -115,6 +119,10 @@ func TestConfigure(t *testing.T) {
 
 [language]
 
+  [language.go]
+    tinygo_constraint = ""
+    toolchain_constraint = ""
+
   [language.rust]
     fastly_sys_constraint = ""
     rustup_constraint = ""
@@ -160,6 +168,10 @@ func TestConfigure(t *testing.T) {
 
 [language]
 
+  [language.go]
+    tinygo_constraint = ""
+    toolchain_constraint = ""
+
   [language.rust]
     fastly_sys_constraint = ""
     rustup_constraint = ""
@@ -208,6 +220,10 @@ func TestConfigure(t *testing.T) {
 
 [language]
 
+  [language.go]
+    tinygo_constraint = ""
+    toolchain_constraint = ""
+
   [language.rust]
     fastly_sys_constraint = ""
     rustup_constraint = ""
@@ -254,6 +270,10 @@ func TestConfigure(t *testing.T) {
 
 [language]
 
+  [language.go]
+    tinygo_constraint = ""
+    toolchain_constraint = ""
+
   [language.rust]
     fastly_sys_constraint = ""
     rustup_constraint = ""
@@ -303,6 +323,10 @@ func TestConfigure(t *testing.T) {
 
 [language]
 
+  [language.go]
+    tinygo_constraint = ""
+    toolchain_constraint = ""
+
   [language.rust]
     fastly_sys_constraint = ""
     rustup_constraint = ""
@@ -319,6 +343,65 @@ func TestConfigure(t *testing.T) {
 [user]
   email = "test@example.com"
   token = "new_token"
+`,
+		},
+		{
+			name: "token saved to the default profile",
+			args: args("configure --token abcdef"),
+			file: config.File{
+				User: config.User{Token: "user_token"},
+				Profiles: map[string]*config.Profile{
+					"work": {Default: true, Token: "old_token"},
+				},
+			},
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			wantOutput: []string{
+				"Fastly API token provided via --token",
+				"Configured the Fastly CLI (profile: work)",
+			},
+			wantFile: `config_version = 0
+
+[cli]
+  last_checked = ""
+  remote_config = ""
+  ttl = ""
+  version = ""
+
+[fastly]
+  api_endpoint = "https://api.fastly.com"
+
+[language]
+
+  [language.go]
+    tinygo_constraint = ""
+    toolchain_constraint = ""
+
+  [language.rust]
+    fastly_sys_constraint = ""
+    rustup_constraint = ""
+    toolchain_constraint = ""
+    toolchain_version = ""
+    wasm_wasi_target = ""
+
+[legacy]
+  email = ""
+  token = ""
+
+[profile]
+
+  [profile.work]
+    default = true
+    email = "test@example.com"
+    token = "abcdef"
+
+[starter-kits]
+
+[user]
+  email = ""
+  token = "user_token"
 `,
 		},
 		{
@@ -11,7 +11,10 @@ import (
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/cmd"
 	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credstore"
 	"github.com/fastly/cli/pkg/env"
+	fsterr "github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/filesystem"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/go-fastly/v3/fastly"
 )
@@ -74,24 +77,22 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 	// Get the token provided by the user, if it was explicitly provided. If it
 	// wasn't provided, or if it only exists in the config file, take it
 	// interactively.
-	token, source := c.Globals.Token()
-	switch source { // TODO(pb): this can be duplicate output if --verbose is passed
-	case config.SourceFlag:
+	//
+	// NOTE: config.Data.Token() isn't used as it would run the token helper.
+	var token string
+	switch {
+	case c.Globals.Flag.Token != "":
+		token = c.Globals.Flag.Token
 		text.Output(out, "Fastly API token provided via --token")
-	case config.SourceEnvironment:
+	case c.Globals.Env.Token != "":
+		token = c.Globals.Env.Token
 		text.Output(out, "Fastly API token provided via %s", env.Token)
 	default:
-		text.Output(out, `
-			An API token is used to authenticate requests to the Fastly API.
-			To create a token, visit https://manage.fastly.com/account/personal/tokens
-		`)
-		text.Break(out)
-		token, err = text.InputSecure(out, "Fastly API token: ", in, validateTokenNotEmpty)
+		token, err = PromptToken(in, out)
 		if err != nil {
 			c.Globals.ErrLog.Add(err)
 			return err
 		}
-		text.Break(out)
 	}
 
 	text.Break(out)
@@ -106,37 +107,98 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 
 	progress.Step("Validating token...")
 
-	client, err := c.clientFactory(token, endpoint)
+	user, err := ValidateToken(token, endpoint, c.clientFactory, c.Globals.ErrLog)
 	if err != nil {
-		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+		return err
+	}
+
+	progress.Step("Persisting configuration...")
+
+	// Set everything in the File struct based on provided user input.
+	//
+	// NOTE: the token of the profile in use takes precedence over the [user]
+	// token, so that profile is updated instead.
+	profileName, _ := c.Globals.Profile()
+	profile := c.Globals.File.Profiles[profileName]
+	if profile != nil {
+		profile.Token = token
+		profile.Email = user.Login
+	} else {
+		c.Globals.File.User.Token = token
+		c.Globals.File.User.Email = user.Login
+	}
+	c.Globals.File.Fastly.APIEndpoint = endpoint
+
+	if err := WriteConfig(&c.Globals.File, c.configFilePath, c.Globals.ErrLog); err != nil {
+		return err
+	}
+
+	// Escape any spaces in filepath before output.
+	filePath := strings.ReplaceAll(c.configFilePath, " ", `\ `)
+
+	progress.Done()
+	text.Break(out)
+	text.Description(out, "You can find your configuration file at", filePath)
+	if profile != nil {
+		text.Success(out, "Configured the Fastly CLI (profile: %s)", profileName)
+	} else {
+		text.Success(out, "Configured the Fastly CLI")
+	}
+
+	if filesystem.FileExists(credstore.Path(c.configFilePath)) {
+		text.Break(out)
+		text.Info(out, "The token was saved in plaintext. Move it into the encrypted credential store using `fastly credentials migrate`.")
+	}
+
+	return nil
+}
+
+// PromptToken interactively asks the user for a Fastly API token.
+func PromptToken(in io.Reader, out io.Writer) (string, error) {
+	text.Output(out, `
+		An API token is used to authenticate requests to the Fastly API.
+		To create a token, visit https://manage.fastly.com/account/personal/tokens
+	`)
+	text.Break(out)
+	token, err := text.InputSecure(out, "Fastly API token: ", in, validateTokenNotEmpty)
+	if err != nil {
+		return "", err
+	}
+	text.Break(out)
+	return token, nil
+}
+
+// ValidateToken regenerates the Fastly API client using the given token and
+// endpoint, and validates the token by looking up the user it belongs to.
+func ValidateToken(token, endpoint string, cf APIClientFactory, errLog fsterr.LogInterface) (*fastly.User, error) {
+	client, err := cf(token, endpoint)
+	if err != nil {
+		errLog.AddWithContext(err, map[string]interface{}{
 			"Endpoint": endpoint,
 		})
-		return fmt.Errorf("error regenerating Fastly API client: %w", err)
+		return nil, fmt.Errorf("error regenerating Fastly API client: %w", err)
 	}
 	t, err := client.GetTokenSelf()
 	if err != nil {
-		c.Globals.ErrLog.Add(err)
-		return fmt.Errorf("error validating token: %w", err)
+		errLog.Add(err)
+		return nil, fmt.Errorf("error validating token: %w", err)
 	}
 	user, err := client.GetUser(&fastly.GetUserInput{
 		ID: t.UserID,
 	})
 	if err != nil {
-		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+		errLog.AddWithContext(err, map[string]interface{}{
 			"User ID": t.UserID,
 		})
-		return fmt.Errorf("error fetching token user: %w", err)
+		return nil, fmt.Errorf("error fetching token user: %w", err)
 	}
+	return user, nil
+}
 
-	progress.Step("Persisting configuration...")
-
-	// Set everything in the File struct based on provided user input.
-	c.Globals.File.User.Token = token
-	c.Globals.File.User.Email = user.Login
-	c.Globals.File.Fastly.APIEndpoint = endpoint
-
-	// Make sure the config file directory exists.
-	dir := filepath.Dir(c.configFilePath)
+// WriteConfig persists the config file to disk, making sure the config file
+// directory exists first.
+func WriteConfig(file *config.File, configFilePath string, errLog fsterr.LogInterface) error {
+	dir := filepath.Dir(configFilePath)
 	fi, err := os.Stat(dir)
 	switch {
 	case err == nil && fi.IsDir():
@@ -145,7 +207,7 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		return fmt.Errorf("config file path %s isn't a directory", dir)
 	case err != nil && os.IsNotExist(err):
 		if err := os.MkdirAll(dir, config.DirectoryPermissions); err != nil {
-			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			errLog.AddWithContext(err, map[string]interface{}{
 				"Directory":   dir,
 				"Permissions": config.DirectoryPermissions,
 			})
@@ -153,19 +215,10 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) (err error) {
 		}
 	}
 
-	if err := c.Globals.File.Write(c.configFilePath); err != nil {
-		c.Globals.ErrLog.Add(err)
+	if err := file.Write(configFilePath); err != nil {
+		errLog.Add(err)
 		return fmt.Errorf("error saving config file: %w", err)
 	}
-
-	// Escape any spaces in filepath before output.
-	filePath := strings.ReplaceAll(c.configFilePath, " ", `\ `)
-
-	progress.Done()
-	text.Break(out)
-	text.Description(out, "You can find your configuration file at", filePath)
-	text.Success(out, "Configured the Fastly CLI")
-
 	return nil
 }
 
@@ -0,0 +1,53 @@
+package credentials
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credstore"
+)
+
+// AgentCommand runs the credential agent in the foreground. It's started in
+// the background by the unlock command and isn't intended to be run directly.
+type AgentCommand struct {
+	cmd.Base
+
+	configFilePath string
+	timeout        time.Duration
+}
+
+// NewAgentCommand returns a usable command registered under the parent.
+func NewAgentCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *AgentCommand {
+	var c AgentCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("agent", "Run the credential agent, reading the credential store key from stdin").Hidden()
+	c.CmdClause.Flag("timeout", "How long the agent holds the key for").Default(credstore.DefaultTimeout.String()).DurationVar(&c.timeout)
+	c.CmdClause.Flag("config-path", "Path of the config file the credential store belongs to").Default(configFilePath).StringVar(&c.configFilePath)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *AgentCommand) Exec(in io.Reader, out io.Writer) error {
+	line, err := bufio.NewReader(in).ReadString('\n')
+	if err != nil && line == "" {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error reading credential store key: %w", err)
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(line))
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error decoding credential store key: %w", err)
+	}
+
+	if err := credstore.Serve(credstore.SocketPath(c.configFilePath), key, c.timeout); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+	return nil
+}
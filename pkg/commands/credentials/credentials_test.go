@@ -0,0 +1,249 @@
+package credentials_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credstore"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+const passphrase = "correct horse battery staple"
+
+func TestMigrate(t *testing.T) {
+	args := testutil.Args
+	plaintext := func() config.File {
+		return config.File{
+			User: config.User{Token: "user-token"},
+			Profiles: map[string]*config.Profile{
+				"production": {Default: true, Token: "prod-token"},
+				"staging":    {Token: config.CredentialStoreToken},
+			},
+		}
+	}
+
+	for _, testcase := range []struct {
+		name       string
+		file       config.File
+		store      map[string]string
+		passphrase string
+		wantError  string
+		wantOutput []string
+		wantFile   []string
+		wantStore  map[string]string
+	}{
+		{
+			name:       "nothing to migrate",
+			file:       config.File{User: config.User{Token: config.CredentialStoreToken}},
+			wantOutput: []string{"There are no plaintext tokens in the config file to migrate."},
+		},
+		{
+			name:       "new store",
+			file:       plaintext(),
+			passphrase: passphrase,
+			wantOutput: []string{
+				"Migrated token: profile.production",
+				"Migrated token: user",
+				"Moved 2 token(s) into the credential store",
+			},
+			wantFile: []string{`token = "credential-store"`},
+			wantStore: map[string]string{
+				"user":               "user-token",
+				"profile.production": "prod-token",
+			},
+		},
+		{
+			name:       "existing store",
+			file:       plaintext(),
+			store:      map[string]string{"profile.staging": "staging-token"},
+			passphrase: passphrase,
+			wantOutput: []string{"Moved 2 token(s) into the credential store"},
+			wantStore: map[string]string{
+				"user":               "user-token",
+				"profile.production": "prod-token",
+				"profile.staging":    "staging-token",
+			},
+		},
+		{
+			name:       "existing store with incorrect passphrase",
+			file:       plaintext(),
+			store:      map[string]string{"profile.staging": "staging-token"},
+			passphrase: "wrong",
+			wantError:  "incorrect passphrase for the credential store",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.toml")
+			if testcase.store != nil {
+				writeStore(t, configPath, testcase.store)
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(args("credentials migrate"), &stdout)
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configPath
+			opts.Env = config.Environment{CredentialsPassphrase: testcase.passphrase}
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantError != "" || testcase.wantStore == nil {
+				return
+			}
+
+			p, err := os.ReadFile(configPath)
+			testutil.AssertNoError(t, err)
+			for _, s := range testcase.wantFile {
+				testutil.AssertStringContains(t, string(p), s)
+			}
+			for _, s := range testcase.wantStore {
+				if strings.Contains(string(p), s) {
+					t.Errorf("config file still contains plaintext token %q", s)
+				}
+			}
+			testutil.AssertEqual(t, testcase.wantStore, readStore(t, configPath))
+		})
+	}
+}
+
+func TestStoredToken(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		env        config.Environment
+		stdin      string
+		wantError  string
+		wantOutput string
+	}{
+		{
+			name:       "passphrase from environment",
+			args:       args("pops -v"),
+			env:        config.Environment{CredentialsPassphrase: passphrase},
+			wantOutput: "Fastly API token provided via credential store",
+		},
+		{
+			name:       "passphrase prompt",
+			args:       args("pops -v"),
+			stdin:      passphrase,
+			wantOutput: "Fastly API token provided via credential store",
+		},
+		{
+			name:      "incorrect passphrase",
+			args:      args("pops"),
+			env:       config.Environment{CredentialsPassphrase: "wrong"},
+			wantError: "incorrect passphrase for the credential store",
+		},
+		{
+			name:       "configuration commands don't unlock the store",
+			args:       args("profile list"),
+			wantOutput: "production",
+		},
+		{
+			name:       "lock without an agent",
+			args:       args("credentials lock"),
+			wantOutput: "The credential store is already locked.",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.toml")
+			writeStore(t, configPath, map[string]string{"profile.production": "prod-token"})
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = func(token, endpoint string) (api.Interface, error) {
+				// The token is only available once the store is unlocked.
+				if token != "" && token != "prod-token" {
+					t.Errorf("want token %q, have %q", "prod-token", token)
+				}
+				return mock.API{
+					AllDatacentersFn: func() ([]fastly.Datacenter, error) { return nil, nil },
+				}, nil
+			}
+			opts.ConfigFile = config.File{
+				Profiles: map[string]*config.Profile{
+					"production": {Default: true, Token: config.CredentialStoreToken},
+				},
+			}
+			opts.ConfigPath = configPath
+			opts.Env = testcase.env
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestDeleteStoredProfile(t *testing.T) {
+	for _, testcase := range []struct {
+		name       string
+		passphrase string
+		wantError  string
+		wantStore  map[string]string
+	}{
+		{
+			name:       "token removed from the store",
+			passphrase: passphrase,
+			wantStore:  map[string]string{"profile.production": "prod-token"},
+		},
+		{
+			name:       "incorrect passphrase keeps the profile",
+			passphrase: "wrong",
+			wantError:  "incorrect passphrase for the credential store",
+			wantStore:  map[string]string{"profile.production": "prod-token", "profile.staging": "staging-token"},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config.toml")
+			writeStore(t, configPath, map[string]string{"profile.production": "prod-token", "profile.staging": "staging-token"})
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("profile delete --name staging"), &stdout)
+			opts.ConfigFile = config.File{
+				Profiles: map[string]*config.Profile{
+					"production": {Default: true, Token: config.CredentialStoreToken},
+					"staging":    {Token: config.CredentialStoreToken},
+				},
+			}
+			opts.ConfigPath = configPath
+			opts.Env = config.Environment{CredentialsPassphrase: testcase.passphrase}
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			if testcase.wantError == "" {
+				testutil.AssertStringContains(t, stdout.String(), "Deleted profile 'staging'")
+			}
+			testutil.AssertEqual(t, testcase.wantStore, readStore(t, configPath))
+		})
+	}
+}
+
+func writeStore(t *testing.T, configPath string, tokens map[string]string) {
+	t.Helper()
+	s, err := credstore.New()
+	testutil.AssertNoError(t, err)
+	key, err := s.Key(passphrase)
+	testutil.AssertNoError(t, err)
+	testutil.AssertNoError(t, s.Seal(key, tokens))
+	testutil.AssertNoError(t, s.Write(credstore.Path(configPath)))
+}
+
+func readStore(t *testing.T, configPath string) map[string]string {
+	t.Helper()
+	s, err := credstore.Read(credstore.Path(configPath))
+	testutil.AssertNoError(t, err)
+	key, err := s.Key(passphrase)
+	testutil.AssertNoError(t, err)
+	tokens, err := s.Open(key)
+	testutil.AssertNoError(t, err)
+	return tokens
+}
@@ -0,0 +1,15 @@
+//go:build !windows
+// +build !windows
+
+package credentials
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detach runs the command in a session of its own, so that the agent isn't
+// stopped along with the terminal the CLI was run from.
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setsid: true}
+}
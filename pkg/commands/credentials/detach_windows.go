@@ -0,0 +1,12 @@
+package credentials
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// detach runs the command in a process group of its own, so that the agent
+// isn't stopped along with the console the CLI was run from.
+func detach(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
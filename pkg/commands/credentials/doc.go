@@ -0,0 +1,3 @@
+// Package credentials contains commands to manage the encrypted credential
+// store that holds Fastly API tokens outside of the CLI global configuration.
+package credentials
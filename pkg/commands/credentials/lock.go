@@ -0,0 +1,42 @@
+package credentials
+
+import (
+	"errors"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credstore"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// LockCommand stops the credential agent, forgetting the credential store key.
+type LockCommand struct {
+	cmd.Base
+
+	configFilePath string
+}
+
+// NewLockCommand returns a usable command registered under the parent.
+func NewLockCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *LockCommand {
+	var c LockCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("lock", "Lock the credential store, so the passphrase is required again")
+	c.configFilePath = configFilePath
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *LockCommand) Exec(in io.Reader, out io.Writer) error {
+	err := credstore.StopAgent(credstore.SocketPath(c.configFilePath))
+	if errors.Is(err, credstore.ErrNoAgent) {
+		text.Info(out, "The credential store is already locked.")
+		return nil
+	}
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+	text.Success(out, "Locked the credential store")
+	return nil
+}
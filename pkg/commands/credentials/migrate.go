@@ -0,0 +1,126 @@
+package credentials
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/configure"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credstore"
+	"github.com/fastly/cli/pkg/filesystem"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// MigrateCommand moves plaintext tokens out of the config file and into the
+// encrypted credential store.
+type MigrateCommand struct {
+	cmd.Base
+
+	configFilePath string
+}
+
+// NewMigrateCommand returns a usable command registered under the parent.
+func NewMigrateCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *MigrateCommand {
+	var c MigrateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("migrate", "Move plaintext API tokens from the config file into the encrypted credential store, creating it if necessary")
+	c.configFilePath = configFilePath
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *MigrateCommand) Exec(in io.Reader, out io.Writer) error {
+	plaintext := c.plaintextTokens()
+	if len(plaintext) == 0 {
+		text.Info(out, "There are no plaintext tokens in the config file to migrate.")
+		return nil
+	}
+
+	var (
+		store  *credstore.Store
+		key    []byte
+		tokens map[string]string
+		err    error
+	)
+	path := credstore.Path(c.configFilePath)
+	if filesystem.FileExists(path) {
+		store, key, tokens, err = unlock(in, out, c.configFilePath, c.Globals)
+		if err != nil {
+			return err
+		}
+	} else {
+		store, err = credstore.New()
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		passphrase, err := newPassphrase(in, out, c.Globals)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		key, err = store.Key(passphrase)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error deriving credential store key: %w", err)
+		}
+		tokens = make(map[string]string)
+	}
+
+	for k, v := range plaintext {
+		tokens[k] = v
+	}
+	if err := store.Seal(key, tokens); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error encrypting credential store: %w", err)
+	}
+
+	// The store is written before the tokens are removed from the config file
+	// so that a failure part way through can't lose them.
+	if err := store.Write(path); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Path": path,
+		})
+		return fmt.Errorf("error saving credential store: %w", err)
+	}
+
+	if _, ok := plaintext[config.CredentialKey("")]; ok {
+		c.Globals.File.User.Token = config.CredentialStoreToken
+	}
+	for name, p := range c.Globals.File.Profiles {
+		if _, ok := plaintext[config.CredentialKey(name)]; ok {
+			p.Token = config.CredentialStoreToken
+		}
+	}
+	if err := configure.WriteConfig(&c.Globals.File, c.configFilePath, c.Globals.ErrLog); err != nil {
+		return err
+	}
+
+	keys := make([]string, 0, len(plaintext))
+	for k := range plaintext {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		text.Output(out, "Migrated token: %s", k)
+	}
+	text.Success(out, "Moved %d token(s) into the credential store at %s", len(plaintext), path)
+	return nil
+}
+
+// plaintextTokens returns the tokens held in plaintext in the config file,
+// keyed by config.CredentialKey.
+func (c *MigrateCommand) plaintextTokens() map[string]string {
+	tokens := make(map[string]string)
+	if t := c.Globals.File.User.Token; t != "" && t != config.CredentialStoreToken {
+		tokens[config.CredentialKey("")] = t
+	}
+	for name, p := range c.Globals.File.Profiles {
+		if p != nil && p.Token != "" && p.Token != config.CredentialStoreToken {
+			tokens[config.CredentialKey(name)] = p.Token
+		}
+	}
+	return tokens
+}
@@ -0,0 +1,150 @@
+package credentials
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credstore"
+	"github.com/fastly/cli/pkg/env"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RootCommand is the parent command for all subcommands in this package.
+// It should be installed under the primary root command.
+type RootCommand struct {
+	cmd.Base
+	// no flags
+}
+
+// NewRootCommand returns a new command registered in the parent.
+func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
+	var c RootCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("credentials", "Manage the encrypted credential store for API tokens")
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
+	panic("unreachable")
+}
+
+// Unlock decrypts the credential store that lives alongside the given config
+// file and returns the tokens it holds.
+//
+// The key is requested from the credential agent if one is running, otherwise
+// it's derived from a passphrase which is taken from the environment or, if
+// not set, is prompted for.
+func Unlock(in io.Reader, out io.Writer, configFilePath string, globals *config.Data) (map[string]string, error) {
+	_, _, tokens, err := unlock(in, out, configFilePath, globals)
+	return tokens, err
+}
+
+// Remove deletes the token held under the given key (see config.CredentialKey)
+// from the credential store that lives alongside the given config file.
+func Remove(in io.Reader, out io.Writer, configFilePath string, globals *config.Data, key string) error {
+	store, storeKey, tokens, err := unlock(in, out, configFilePath, globals)
+	if err != nil {
+		return err
+	}
+	if _, ok := tokens[key]; !ok {
+		return nil
+	}
+
+	delete(tokens, key)
+	if err := store.Seal(storeKey, tokens); err != nil {
+		globals.ErrLog.Add(err)
+		return fmt.Errorf("error encrypting credential store: %w", err)
+	}
+	path := credstore.Path(configFilePath)
+	if err := store.Write(path); err != nil {
+		globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Path": path,
+		})
+		return fmt.Errorf("error saving credential store: %w", err)
+	}
+	return nil
+}
+
+// unlock decrypts the credential store, returning the store and its key along
+// with the tokens it holds.
+func unlock(in io.Reader, out io.Writer, configFilePath string, globals *config.Data) (*credstore.Store, []byte, map[string]string, error) {
+	path := credstore.Path(configFilePath)
+	store, err := credstore.Read(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			err = errors.RemediationError{
+				Inner:       fmt.Errorf("credential store not found: %s", path),
+				Remediation: "Move plaintext tokens into the credential store using `fastly credentials migrate`.",
+			}
+		}
+		globals.ErrLog.Add(err)
+		return nil, nil, nil, err
+	}
+
+	if key, err := credstore.AgentKey(credstore.SocketPath(configFilePath)); err == nil {
+		if tokens, err := store.Open(key); err == nil {
+			return store, key, tokens, nil
+		}
+	}
+
+	passphrase := globals.Env.CredentialsPassphrase
+	if passphrase == "" {
+		passphrase, err = text.InputSecure(out, "Credential store passphrase: ", in)
+		if err != nil {
+			globals.ErrLog.Add(err)
+			return nil, nil, nil, err
+		}
+		text.Break(out)
+	}
+
+	key, err := store.Key(passphrase)
+	if err != nil {
+		globals.ErrLog.Add(err)
+		return nil, nil, nil, fmt.Errorf("error deriving credential store key: %w", err)
+	}
+	tokens, err := store.Open(key)
+	if err != nil {
+		globals.ErrLog.Add(err)
+		return nil, nil, nil, errors.RemediationError{
+			Inner:       err,
+			Remediation: errors.CredentialsRemediation,
+		}
+	}
+	return store, key, tokens, nil
+}
+
+// newPassphrase prompts for (and confirms) the passphrase for a new credential
+// store, unless it's provided via the environment.
+func newPassphrase(in io.Reader, out io.Writer, globals *config.Data) (string, error) {
+	if globals.Env.CredentialsPassphrase != "" {
+		text.Output(out, "Credential store passphrase provided via %s", env.CredentialsPassphrase)
+		return globals.Env.CredentialsPassphrase, nil
+	}
+
+	passphrase, err := text.InputSecure(out, "New credential store passphrase: ", in, validatePassphrase)
+	if err != nil {
+		return "", err
+	}
+	text.Break(out)
+	confirm, err := text.InputSecure(out, "Confirm passphrase: ", in)
+	if err != nil {
+		return "", err
+	}
+	text.Break(out)
+	if confirm != passphrase {
+		return "", fmt.Errorf("passphrases don't match")
+	}
+	return passphrase, nil
+}
+
+func validatePassphrase(s string) error {
+	if s == "" {
+		return fmt.Errorf("passphrase cannot be empty")
+	}
+	return nil
+}
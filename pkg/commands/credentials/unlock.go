@@ -0,0 +1,103 @@
+package credentials
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/credstore"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// agentStartTimeout is how long to wait for a newly started agent to accept
+// connections.
+const agentStartTimeout = 5 * time.Second
+
+// UnlockCommand starts a credential agent that holds the credential store key
+// in memory for a limited time.
+type UnlockCommand struct {
+	cmd.Base
+
+	configFilePath string
+	timeout        time.Duration
+}
+
+// NewUnlockCommand returns a usable command registered under the parent.
+func NewUnlockCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *UnlockCommand {
+	var c UnlockCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("unlock", "Unlock the credential store so subsequent commands don't prompt for the passphrase")
+	c.CmdClause.Flag("timeout", "How long the credential store remains unlocked for (e.g. 30m, 8h)").Default(credstore.DefaultTimeout.String()).DurationVar(&c.timeout)
+	c.configFilePath = configFilePath
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UnlockCommand) Exec(in io.Reader, out io.Writer) error {
+	_, key, _, err := unlock(in, out, c.configFilePath, c.Globals)
+	if err != nil {
+		return err
+	}
+
+	socket := credstore.SocketPath(c.configFilePath)
+
+	// Replace any agent that's already running so that the new timeout applies.
+	_ = credstore.StopAgent(socket)
+
+	if err := startAgent(c.configFilePath, c.timeout, key); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	deadline := time.Now().Add(agentStartTimeout)
+	for {
+		if _, err := credstore.AgentKey(socket); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			err := fmt.Errorf("timed out waiting for the credential agent to start")
+			c.Globals.ErrLog.Add(err)
+			return err
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+
+	text.Success(out, "Unlocked the credential store for %s", c.timeout)
+	return nil
+}
+
+// startAgent runs the hidden `credentials agent` command as a detached
+// background process, handing it the key over stdin so it isn't exposed via
+// the process arguments or environment.
+func startAgent(configFilePath string, timeout time.Duration, key []byte) error {
+	bin, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("error locating the fastly binary: %w", err)
+	}
+
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with variable
+	// Disabling as the binary is the currently running executable.
+	/* #nosec */
+	cmd := exec.Command(bin, "credentials", "agent", "--timeout", timeout.String(), "--config-path", configFilePath)
+	detach(cmd)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return fmt.Errorf("error starting credential agent: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("error starting credential agent: %w", err)
+	}
+	if _, err := fmt.Fprintln(stdin, hex.EncodeToString(key)); err != nil {
+		return fmt.Errorf("error starting credential agent: %w", err)
+	}
+	if err := stdin.Close(); err != nil {
+		return fmt.Errorf("error starting credential agent: %w", err)
+	}
+	return cmd.Process.Release()
+}
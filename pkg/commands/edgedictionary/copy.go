@@ -0,0 +1,179 @@
+package edgedictionary
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CopyCommand calls the Fastly API to copy a dictionary and its items from one
+// service to another.
+type CopyCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	fromService string
+	fromVersion cmd.OptionalServiceVersion
+	name        string
+}
+
+// NewCopyCommand returns a usable command registered under the parent.
+func NewCopyCommand(parent cmd.Registerer, globals *config.Data) *CopyCommand {
+	var c CopyCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("copy", "Copy a Fastly edge dictionary and its items to another service, creating it on the destination version if it doesn't exist")
+	c.CmdClause.Flag("name", "Name of Dictionary").Short('n').Required().StringVar(&c.name)
+	c.CmdClause.Flag("from-service", "Service ID to copy the dictionary from").Required().StringVar(&c.fromService)
+	c.CmdClause.Flag("from-version", "'latest', 'active', or the number of a specific version of the service to copy from").Default("active").StringVar(&c.fromVersion.Value)
+	c.CmdClause.Flag("to-service", "Service ID to copy the dictionary to").Required().StringVar(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CopyCommand) Exec(in io.Reader, out io.Writer) error {
+	fromVersion, err := c.fromVersion.Parse(c.fromService, c.Globals.Client)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": c.fromService,
+		})
+		return err
+	}
+
+	src, err := findDictionary(c.Globals.Client, c.fromService, fromVersion.Number, c.name)
+	if err == nil && src == nil {
+		err = fmt.Errorf("dictionary '%s' not found on service %s version %d", c.name, c.fromService, fromVersion.Number)
+	}
+	if err == nil && src.WriteOnly {
+		err = errors.RemediationError{
+			Inner:       fmt.Errorf("dictionary '%s' is write-only and its items can't be read", c.name),
+			Remediation: "Recreate the items on the destination service with the dictionaryitem sync command.",
+		}
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      c.fromService,
+			"Service Version": fromVersion.Number,
+		})
+		return err
+	}
+
+	items, err := api.ListAllDictionaryItems(c.Globals.Client, &fastly.ListDictionaryItemsInput{
+		ServiceID:    c.fromService,
+		DictionaryID: src.ID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":    c.fromService,
+			"Dictionary ID": src.ID,
+		})
+		return err
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	dst, err := findDictionary(c.Globals.Client, serviceID, serviceVersion.Number, c.name)
+	if err == nil && dst == nil {
+		dst, err = c.Globals.Client.CreateDictionary(&fastly.CreateDictionaryInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+			Name:           c.name,
+		})
+		if err == nil {
+			text.Info(out, "Created dictionary %s (service %s version %d)", dst.Name, serviceID, serviceVersion.Number)
+		}
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	var ops []*fastly.BatchDictionaryItem
+	for _, item := range items {
+		if item.DeletedAt == nil {
+			ops = append(ops, &fastly.BatchDictionaryItem{
+				Operation: fastly.UpsertBatchOperation,
+				ItemKey:   item.ItemKey,
+				ItemValue: item.ItemValue,
+			})
+		}
+	}
+
+	for start := 0; start < len(ops); start += fastly.BatchModifyMaximumOperations {
+		end := start + fastly.BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+		err := c.Globals.Client.BatchModifyDictionaryItems(&fastly.BatchModifyDictionaryItemsInput{
+			ServiceID:    serviceID,
+			DictionaryID: dst.ID,
+			Items:        ops[start:end],
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":    serviceID,
+				"Dictionary ID": dst.ID,
+				"Batch start":   start,
+			})
+			if start > 0 {
+				return fmt.Errorf("error after copying %d of %d items: %w", start, len(ops), err)
+			}
+			return err
+		}
+	}
+
+	text.Success(out, "Copied %d item(s) in dictionary %s from service %s version %d to service %s version %d", len(ops), c.name, c.fromService, fromVersion.Number, serviceID, serviceVersion.Number)
+	return nil
+}
+
+// findDictionary returns the dictionary with the given name on a service
+// version, or nil if there isn't one.
+func findDictionary(client api.Interface, serviceID string, serviceVersion int, name string) (*fastly.Dictionary, error) {
+	ds, err := client.ListDictionaries(&fastly.ListDictionariesInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range ds {
+		if d.Name == name {
+			return d, nil
+		}
+	}
+	return nil, nil
+}
@@ -288,6 +288,105 @@ func TestUpdateDictionary(t *testing.T) {
 	}
 }
 
+func TestDictionaryCopy(t *testing.T) {
+	args := testutil.Args
+	var batches [][]*fastly.BatchDictionaryItem
+	api := func(src, dst *fastly.Dictionary) mock.API {
+		return mock.API{
+			ListVersionsFn: testutil.ListVersions,
+			CloneVersionFn: testutil.CloneVersionResult(4),
+			ListDictionariesFn: func(i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) {
+				if i.ServiceID == "123" {
+					return []*fastly.Dictionary{src}, nil
+				}
+				if dst == nil {
+					return nil, nil
+				}
+				return []*fastly.Dictionary{dst}, nil
+			},
+			GetFn: testutil.PaginatedGet([]*fastly.DictionaryItem{
+				{ItemKey: "baz", ItemValue: "qux"},
+				{ItemKey: "foo", ItemValue: "bar"},
+				{ItemKey: "gone", ItemValue: "value", DeletedAt: testutil.MustParseTimeRFC3339("2001-02-03T04:06:08Z")},
+			}),
+			CreateDictionaryFn: func(i *fastly.CreateDictionaryInput) (*fastly.Dictionary, error) {
+				return &fastly.Dictionary{ID: "dst", Name: i.Name, ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion}, nil
+			},
+			BatchModifyDictionaryItemsFn: func(i *fastly.BatchModifyDictionaryItemsInput) error {
+				if i.ServiceID != "456" || i.DictionaryID != "dst" {
+					return errors.New("items copied to the wrong dictionary")
+				}
+				batches = append(batches, i.Items)
+				return nil
+			},
+		}
+	}
+	items := []*fastly.BatchDictionaryItem{
+		{Operation: fastly.UpsertBatchOperation, ItemKey: "baz", ItemValue: "qux"},
+		{Operation: fastly.UpsertBatchOperation, ItemKey: "foo", ItemValue: "bar"},
+	}
+
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput []string
+		wantItems  []*fastly.BatchDictionaryItem
+	}{
+		{
+			args:      args("dictionary copy --name denylist --to-service 456 --version 3"),
+			wantError: "error parsing arguments: required flag --from-service not provided",
+		},
+		{
+			args:      args("dictionary copy --name allowlist --from-service 123 --to-service 456 --version 3"),
+			api:       api(&fastly.Dictionary{ID: "src", Name: "denylist"}, nil),
+			wantError: "dictionary 'allowlist' not found on service 123 version 1",
+		},
+		{
+			args:      args("dictionary copy --name denylist --from-service 123 --to-service 456 --version 3"),
+			api:       api(&fastly.Dictionary{ID: "src", Name: "denylist", WriteOnly: true}, nil),
+			wantError: "dictionary 'denylist' is write-only",
+		},
+		{
+			args:      args("dictionary copy --name denylist --from-service 123 --to-service 456 --version 1"),
+			api:       api(&fastly.Dictionary{ID: "src", Name: "denylist"}, nil),
+			wantError: "service version 1 is not editable",
+		},
+		{
+			args: args("dictionary copy --name denylist --from-service 123 --to-service 456 --version 3"),
+			api:  api(&fastly.Dictionary{ID: "src", Name: "denylist"}, nil),
+			wantOutput: []string{
+				"Created dictionary denylist (service 456 version 3)",
+				"Copied 2 item(s) in dictionary denylist from service 123 version 1 to service 456 version 3",
+			},
+			wantItems: items,
+		},
+		{
+			args: args("dictionary copy --name denylist --from-service 123 --from-version 3 --to-service 456 --version 1 --autoclone"),
+			api:  api(&fastly.Dictionary{ID: "src", Name: "denylist"}, &fastly.Dictionary{ID: "dst", Name: "denylist"}),
+			wantOutput: []string{
+				"Copied 2 item(s) in dictionary denylist from service 123 version 3 to service 456 version 4",
+			},
+			wantItems: items,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			batches = nil
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantItems != nil {
+				testutil.AssertEqual(t, [][]*fastly.BatchDictionaryItem{testcase.wantItems}, batches)
+			}
+		})
+	}
+}
+
 func describeDictionaryOK(i *fastly.GetDictionaryInput) (*fastly.Dictionary, error) {
 	return &fastly.Dictionary{
 		ServiceID:      i.ServiceID,
@@ -30,7 +30,7 @@ func NewBatchCommand(parent cmd.Registerer, globals *config.Data) *BatchCommand
 	c.manifest.File.SetOutput(c.Globals.Output)
 	c.manifest.File.Read(manifest.Filename)
 	c.CmdClause = parent.Command("batchmodify", "Update multiple items in a Fastly edge dictionary")
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 	c.CmdClause.Flag("dictionary-id", "Dictionary ID").Required().StringVar(&c.Input.DictionaryID)
 	c.CmdClause.Flag("file", "Batch update json file").Required().Action(c.file.Set).StringVar(&c.file.Value)
 	return &c
@@ -66,11 +66,27 @@ func (c *BatchCommand) Exec(in io.Reader, out io.Writer) error {
 		return fmt.Errorf("item key not found in file %s", c.file.Value)
 	}
 
-	err = c.Globals.Client.BatchModifyDictionaryItems(&c.Input)
-	if err != nil {
-		c.Globals.ErrLog.Add(err)
-		return err
+	// The API accepts a limited number of operations per request, so files
+	// such as those written by export are replayed in chunks.
+	items := c.Input.Items
+	for start := 0; start < len(items); start += fastly.BatchModifyMaximumOperations {
+		end := start + fastly.BatchModifyMaximumOperations
+		if end > len(items) {
+			end = len(items)
+		}
+		c.Input.Items = items[start:end]
+		err = c.Globals.Client.BatchModifyDictionaryItems(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Batch start": start,
+			})
+			if start > 0 {
+				return fmt.Errorf("error after applying %d of %d changes: %w", start, len(items), err)
+			}
+			return err
+		}
 	}
+	c.Input.Items = items
 
 	text.Success(out, "Made %d modifications of Dictionary %s on service %s", len(c.Input.Items), c.Input.DictionaryID, c.Input.ServiceID)
 	return nil
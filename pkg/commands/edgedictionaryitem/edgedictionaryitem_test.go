@@ -3,11 +3,15 @@ package edgedictionaryitem_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/commands/edgedictionaryitem"
 	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/cli/pkg/testutil"
 	"github.com/fastly/go-fastly/v3/fastly"
@@ -256,6 +260,340 @@ func TestDictionaryItemBatchModify(t *testing.T) {
 	}
 }
 
+func TestDictionaryItemBatchModifyBatched(t *testing.T) {
+	var items []string
+	for i := 0; i < 2500; i++ {
+		items = append(items, fmt.Sprintf(`{"op":"upsert","item_key":"key%04d","item_value":"value"}`, i))
+	}
+	filePath := testutil.MakeTempFile(t, `{"items":[`+strings.Join(items, ",")+`]}`)
+	defer os.RemoveAll(filePath)
+
+	for _, testcase := range []struct {
+		name       string
+		failAt     int
+		wantError  string
+		wantOutput string
+		wantSizes  []int
+	}{
+		{
+			name:       "success",
+			wantOutput: "\nSUCCESS: Made 2500 modifications of Dictionary 456 on service 123\n",
+			wantSizes:  []int{1000, 1000, 500},
+		},
+		{
+			name:      "partial failure",
+			failAt:    3,
+			wantError: "error after applying 2000 of 2500 changes: " + errTest.Error(),
+			wantSizes: []int{1000, 1000, 500},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var sizes []int
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("dictionaryitem batchmodify --service-id 123 --dictionary-id 456 --file "+filePath), &stdout)
+			opts.APIClient = mock.APIClient(mock.API{
+				BatchModifyDictionaryItemsFn: func(i *fastly.BatchModifyDictionaryItemsInput) error {
+					sizes = append(sizes, len(i.Items))
+					if len(sizes) == testcase.failAt {
+						return errTest
+					}
+					return nil
+				},
+			})
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+			testutil.AssertEqual(t, testcase.wantSizes, sizes)
+		})
+	}
+}
+
+func TestDictionaryItemSync(t *testing.T) {
+	args := testutil.Args
+	var batches [][]*fastly.BatchDictionaryItem
+	recordBatch := func(i *fastly.BatchModifyDictionaryItemsInput) error {
+		batches = append(batches, i.Items)
+		return nil
+	}
+
+	var many strings.Builder
+	var manyItems []*fastly.DictionaryItem
+	many.WriteString("{")
+	for i := 0; i < 2500; i++ {
+		manyItems = append(manyItems, &fastly.DictionaryItem{ItemKey: fmt.Sprintf("key%04d", i), ItemValue: "value"})
+		if i > 0 {
+			many.WriteString(",")
+		}
+		fmt.Fprintf(&many, `"key%04d": "value"`, i)
+	}
+	many.WriteString("}")
+
+	for _, testcase := range []struct {
+		name        string
+		args        []string
+		filename    string
+		fileData    string
+		api         mock.API
+		wantError   string
+		wantOutput  []string
+		wantBatches []int
+		wantItems   []*fastly.BatchDictionaryItem
+	}{
+		{
+			name:      "missing file flag",
+			args:      args("dictionaryitem sync --service-id 123 --dictionary-id 456"),
+			wantError: "error parsing arguments: required flag --file not provided",
+		},
+		{
+			name:      "unsupported file type",
+			args:      args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename:  "items.txt",
+			fileData:  "foo=bar",
+			wantError: "unsupported file type",
+		},
+		{
+			name:      "invalid CSV",
+			args:      args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename:  "items.csv",
+			fileData:  "foo,bar,baz\n",
+			wantError: "error parsing CSV",
+		},
+		{
+			name:      "duplicate CSV key",
+			args:      args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename:  "items.csv",
+			fileData:  "foo,bar\nfoo,baz\n",
+			wantError: "duplicate key 'foo' on line 2",
+		},
+		{
+			name:      "list error",
+			args:      args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename:  "items.csv",
+			fileData:  "foo,bar\n",
+			api:       mock.API{GetFn: testutil.GetError},
+			wantError: testutil.Err.Error(),
+		},
+		{
+			name:     "dry run",
+			args:     args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath --dry-run --prune"),
+			filename: "items.csv",
+			fileData: "key,value\nfoo,qux\nnew,item\n",
+			api:      mock.API{GetFn: testutil.PaginatedGet(dictionaryItemsForSync)},
+			wantOutput: []string{
+				"~ foo: bar -> qux",
+				"+ new: item",
+				"- old",
+				"Dry run: 1 item(s) would be created, 1 updated and 1 deleted",
+			},
+		},
+		{
+			name:     "already in sync",
+			args:     args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename: "items.json",
+			fileData: `{"foo": "bar"}`,
+			api:      mock.API{GetFn: testutil.PaginatedGet(dictionaryItemsForSync)},
+			wantOutput: []string{
+				"Dictionary 456 on service 123 is already in sync with",
+			},
+		},
+		{
+			name:     "json without prune",
+			args:     args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename: "items.json",
+			fileData: `{"foo": "qux", "new": "item"}`,
+			api: mock.API{
+				GetFn:                        testutil.PaginatedGet(dictionaryItemsForSync),
+				BatchModifyDictionaryItemsFn: recordBatch,
+			},
+			wantOutput: []string{
+				"Synced dictionary 456 on service 123 (1 created, 1 updated, 0 deleted)",
+			},
+			wantBatches: []int{2},
+			wantItems: []*fastly.BatchDictionaryItem{
+				{Operation: fastly.UpdateBatchOperation, ItemKey: "foo", ItemValue: "qux"},
+				{Operation: fastly.CreateBatchOperation, ItemKey: "new", ItemValue: "item"},
+			},
+		},
+		{
+			name:     "yaml with prune",
+			args:     args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath --prune"),
+			filename: "items.yaml",
+			fileData: "foo: bar\nport: 8080\n",
+			api: mock.API{
+				GetFn:                        testutil.PaginatedGet(dictionaryItemsForSync),
+				BatchModifyDictionaryItemsFn: recordBatch,
+			},
+			wantOutput: []string{
+				"Synced dictionary 456 on service 123 (1 created, 0 updated, 1 deleted)",
+			},
+			wantBatches: []int{2},
+			wantItems: []*fastly.BatchDictionaryItem{
+				{Operation: fastly.DeleteBatchOperation, ItemKey: "old"},
+				{Operation: fastly.CreateBatchOperation, ItemKey: "port", ItemValue: "8080"},
+			},
+		},
+		{
+			name:     "batched",
+			args:     args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename: "items.json",
+			fileData: many.String(),
+			api: mock.API{
+				GetFn:                        testutil.PaginatedGet(dictionaryItemsForSync),
+				BatchModifyDictionaryItemsFn: recordBatch,
+			},
+			wantOutput: []string{
+				"Synced dictionary 456 on service 123 (2500 created, 0 updated, 0 deleted)",
+			},
+			wantBatches: []int{1000, 1000, 500},
+		},
+		{
+			name:     "multiple pages",
+			args:     args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath --prune"),
+			filename: "items.json",
+			fileData: many.String(),
+			api:      mock.API{GetFn: testutil.PaginatedGet(manyItems)},
+			wantOutput: []string{
+				"Dictionary 456 on service 123 is already in sync with",
+			},
+		},
+		{
+			name:     "batch error",
+			args:     args("dictionaryitem sync --service-id 123 --dictionary-id 456 --file filePath"),
+			filename: "items.json",
+			fileData: `{"new": "item"}`,
+			api: mock.API{
+				GetFn:                        testutil.PaginatedGet(dictionaryItemsForSync),
+				BatchModifyDictionaryItemsFn: batchModifyDictionaryItemsError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			batches = nil
+			if testcase.filename != "" {
+				filePath := filepath.Join(t.TempDir(), testcase.filename)
+				if err := os.WriteFile(filePath, []byte(testcase.fileData), 0600); err != nil {
+					t.Fatal(err)
+				}
+				for i, v := range testcase.args {
+					if v == "filePath" {
+						testcase.args[i] = filePath
+					}
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+
+			sizes := make([]int, 0, len(batches))
+			for _, b := range batches {
+				sizes = append(sizes, len(b))
+			}
+			if testcase.wantBatches == nil {
+				testcase.wantBatches = []int{}
+			}
+			testutil.AssertEqual(t, testcase.wantBatches, sizes)
+			if testcase.wantItems != nil {
+				testutil.AssertEqual(t, testcase.wantItems, batches[0])
+			}
+		})
+	}
+}
+
+func TestDictionaryItemExport(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			name:      "list error",
+			args:      args("dictionaryitem export --service-id 123 --dictionary-id 456"),
+			api:       mock.API{GetFn: testutil.GetError},
+			wantError: testutil.Err.Error(),
+		},
+		{
+			name:       "json",
+			args:       args("dictionaryitem export --service-id 123 --dictionary-id 456"),
+			api:        mock.API{GetFn: testutil.PaginatedGet(dictionaryItemsForSync)},
+			wantOutput: "{\n  \"foo\": \"bar\",\n  \"old\": \"value\"\n}\n",
+		},
+		{
+			name:       "csv",
+			args:       args("dictionaryitem export --service-id 123 --dictionary-id 456 --format csv"),
+			api:        mock.API{GetFn: testutil.PaginatedGet(dictionaryItemsForSync)},
+			wantOutput: "key,value\nfoo,bar\nold,value\n",
+		},
+		{
+			name: "batch",
+			args: args("dictionaryitem export --service-id 123 --dictionary-id 456 --format batch"),
+			api:  mock.API{GetFn: testutil.PaginatedGet(dictionaryItemsForSync)},
+			wantOutput: `"op": "upsert",
+      "item_key": "foo",
+      "item_value": "bar"`,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			if testcase.wantError == "" && strings.Contains(stdout.String(), "gone") {
+				t.Errorf("deleted item was exported: %s", stdout.String())
+			}
+		})
+	}
+}
+
+func TestDictionaryItemExportPages(t *testing.T) {
+	var items []*fastly.DictionaryItem
+	for i := 0; i < api.PageSize+1; i++ {
+		items = append(items, &fastly.DictionaryItem{ItemKey: fmt.Sprintf("key%03d", i), ItemValue: "value"})
+	}
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("dictionaryitem export --service-id 123 --dictionary-id 456 --format csv"), &stdout)
+	opts.APIClient = mock.APIClient(mock.API{GetFn: testutil.PaginatedGet(items)})
+	testutil.AssertNoError(t, app.Run(opts))
+	testutil.AssertEqual(t, api.PageSize+2, strings.Count(stdout.String(), "\n"))
+	testutil.AssertStringContains(t, stdout.String(), "key100,value\n")
+}
+
+func TestDictionaryItemExportRoundTrip(t *testing.T) {
+	for _, format := range []string{"csv", "json"} {
+		t.Run(format, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "items."+format)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testutil.Args("dictionaryitem export --service-id 123 --dictionary-id 456 --format "+format+" --file "+filePath), &stdout)
+			opts.APIClient = mock.APIClient(mock.API{GetFn: testutil.PaginatedGet(dictionaryItemsForSync)})
+			testutil.AssertNoError(t, app.Run(opts))
+			testutil.AssertStringContains(t, stdout.String(), "Exported 2 item(s) from dictionary 456 on service 123 to "+filePath)
+
+			items, err := edgedictionaryitem.ReadItems(filePath)
+			testutil.AssertNoError(t, err)
+			testutil.AssertEqual(t, map[string]string{"foo": "bar", "old": "value"}, items)
+		})
+	}
+}
+
+var dictionaryItemsForSync = []*fastly.DictionaryItem{
+	{ItemKey: "foo", ItemValue: "bar"},
+	{ItemKey: "gone", ItemValue: "value", DeletedAt: testutil.MustParseTimeRFC3339("2001-02-03T04:06:08Z")},
+	{ItemKey: "old", ItemValue: "value"},
+}
+
 func describeDictionaryItemOK(i *fastly.GetDictionaryItemInput) (*fastly.DictionaryItem, error) {
 	return &fastly.DictionaryItem{
 		ServiceID:    i.ServiceID,
@@ -0,0 +1,139 @@
+package edgedictionaryitem
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ExportCommand calls the Fastly API to write the items of a dictionary to a
+// file.
+type ExportCommand struct {
+	cmd.Base
+	manifest manifest.Data
+
+	dictionaryID string
+	file         string
+	format       string
+}
+
+// NewExportCommand returns a usable command registered under the parent.
+func NewExportCommand(parent cmd.Registerer, globals *config.Data) *ExportCommand {
+	var c ExportCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("export", "Export the items in a Fastly edge dictionary as CSV, JSON or batchmodify JSON")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.CmdClause.Flag("dictionary-id", "Dictionary ID").Required().StringVar(&c.dictionaryID)
+	c.CmdClause.Flag("file", "Path to write the items to (defaults to stdout)").StringVar(&c.file)
+	c.CmdClause.Flag("format", "Format of the exported items: csv and json can be read by sync, batch by batchmodify").Default("json").HintOptions(exportFormats...).EnumVar(&c.format, exportFormats...)
+	return &c
+}
+
+var exportFormats = []string{"batch", "csv", "json"}
+
+// Exec invokes the application logic for the command.
+func (c *ExportCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	items, err := api.ListAllDictionaryItems(c.Globals.Client, &fastly.ListDictionaryItemsInput{
+		ServiceID:    serviceID,
+		DictionaryID: c.dictionaryID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":    serviceID,
+			"Dictionary ID": c.dictionaryID,
+		})
+		return err
+	}
+
+	var live []*fastly.DictionaryItem
+	for _, item := range items {
+		if item.DeletedAt == nil {
+			live = append(live, item)
+		}
+	}
+
+	data, err := encodeItems(live, c.format)
+	if err != nil {
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	if c.file == "" {
+		_, err = out.Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(c.file, data, 0600); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return err
+	}
+	text.Success(out, "Exported %d item(s) from dictionary %s on service %s to %s", len(live), c.dictionaryID, serviceID, c.file)
+	return nil
+}
+
+// encodeItems encodes dictionary items in the given export format. The csv and
+// json formats are those read by ReadItems, and the batch format is that read
+// by the batchmodify command, upserting each item so that it can be replayed
+// into a dictionary that already contains some of them.
+func encodeItems(items []*fastly.DictionaryItem, format string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch format {
+	case "csv":
+		w := csv.NewWriter(&buf)
+		if err := w.Write([]string{"key", "value"}); err != nil {
+			return nil, err
+		}
+		for _, item := range items {
+			if err := w.Write([]string{item.ItemKey, item.ItemValue}); err != nil {
+				return nil, err
+			}
+		}
+		w.Flush()
+		return buf.Bytes(), w.Error()
+	case "batch":
+		input := fastly.BatchModifyDictionaryItemsInput{
+			Items: make([]*fastly.BatchDictionaryItem, 0, len(items)),
+		}
+		for _, item := range items {
+			input.Items = append(input.Items, &fastly.BatchDictionaryItem{
+				Operation: fastly.UpsertBatchOperation,
+				ItemKey:   item.ItemKey,
+				ItemValue: item.ItemValue,
+			})
+		}
+		return marshalIndent(input)
+	default:
+		m := make(map[string]string, len(items))
+		for _, item := range items {
+			m[item.ItemKey] = item.ItemValue
+		}
+		return marshalIndent(m)
+	}
+}
+
+func marshalIndent(v interface{}) ([]byte, error) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(data, '\n'), nil
+}
@@ -0,0 +1,233 @@
+package edgedictionaryitem
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"gopkg.in/yaml.v2"
+)
+
+// SyncCommand calls the Fastly API to make a dictionary match a file of
+// key/value pairs.
+type SyncCommand struct {
+	cmd.Base
+	manifest manifest.Data
+
+	dictionaryID string
+	dryRun       bool
+	file         string
+	prune        bool
+}
+
+// NewSyncCommand returns a usable command registered under the parent.
+func NewSyncCommand(parent cmd.Registerer, globals *config.Data) *SyncCommand {
+	var c SyncCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("sync", "Sync the items in a Fastly edge dictionary with a CSV, JSON or YAML file of key/value pairs")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.CmdClause.Flag("dictionary-id", "Dictionary ID").Required().StringVar(&c.dictionaryID)
+	c.CmdClause.Flag("file", "Path to a .csv, .json, .yaml or .yml file of key/value pairs").Required().StringVar(&c.file)
+	c.CmdClause.Flag("dry-run", "Print the changes that would be made without making them").BoolVar(&c.dryRun)
+	c.CmdClause.Flag("prune", "Delete items whose keys are absent from the file").BoolVar(&c.prune)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *SyncCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	want, err := ReadItems(c.file)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return err
+	}
+
+	items, err := api.ListAllDictionaryItems(c.Globals.Client, &fastly.ListDictionaryItemsInput{
+		ServiceID:    serviceID,
+		DictionaryID: c.dictionaryID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":    serviceID,
+			"Dictionary ID": c.dictionaryID,
+		})
+		return err
+	}
+
+	have := make(map[string]string, len(items))
+	for _, item := range items {
+		if item.DeletedAt == nil {
+			have[item.ItemKey] = item.ItemValue
+		}
+	}
+
+	ops := diffItems(have, want, c.prune)
+	if len(ops) == 0 {
+		text.Info(out, "Dictionary %s on service %s is already in sync with %s", c.dictionaryID, serviceID, c.file)
+		return nil
+	}
+
+	if c.dryRun || c.Globals.Verbose() {
+		for _, op := range ops {
+			switch op.Operation {
+			case fastly.CreateBatchOperation:
+				text.Output(out, "+ %s: %s", op.ItemKey, op.ItemValue)
+			case fastly.UpdateBatchOperation:
+				text.Output(out, "~ %s: %s -> %s", op.ItemKey, have[op.ItemKey], op.ItemValue)
+			case fastly.DeleteBatchOperation:
+				text.Output(out, "- %s", op.ItemKey)
+			}
+		}
+	}
+
+	created, updated, deleted := countOperations(ops)
+	if c.dryRun {
+		text.Info(out, "Dry run: %d item(s) would be created, %d updated and %d deleted", created, updated, deleted)
+		return nil
+	}
+
+	for start := 0; start < len(ops); start += fastly.BatchModifyMaximumOperations {
+		end := start + fastly.BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+		err := c.Globals.Client.BatchModifyDictionaryItems(&fastly.BatchModifyDictionaryItemsInput{
+			ServiceID:    serviceID,
+			DictionaryID: c.dictionaryID,
+			Items:        ops[start:end],
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":    serviceID,
+				"Dictionary ID": c.dictionaryID,
+				"Batch start":   start,
+			})
+			if start > 0 {
+				return fmt.Errorf("error after applying %d of %d changes: %w", start, len(ops), err)
+			}
+			return err
+		}
+	}
+
+	text.Success(out, "Synced dictionary %s on service %s (%d created, %d updated, %d deleted)", c.dictionaryID, serviceID, created, updated, deleted)
+	return nil
+}
+
+// diffItems returns the batch operations, sorted by key, that turn the items
+// we have into the items we want. Items absent from want are only deleted
+// when prune is set.
+func diffItems(have, want map[string]string, prune bool) []*fastly.BatchDictionaryItem {
+	var ops []*fastly.BatchDictionaryItem
+	for k, v := range want {
+		current, ok := have[k]
+		switch {
+		case !ok:
+			ops = append(ops, &fastly.BatchDictionaryItem{Operation: fastly.CreateBatchOperation, ItemKey: k, ItemValue: v})
+		case current != v:
+			ops = append(ops, &fastly.BatchDictionaryItem{Operation: fastly.UpdateBatchOperation, ItemKey: k, ItemValue: v})
+		}
+	}
+	if prune {
+		for k := range have {
+			if _, ok := want[k]; !ok {
+				ops = append(ops, &fastly.BatchDictionaryItem{Operation: fastly.DeleteBatchOperation, ItemKey: k})
+			}
+		}
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return ops[i].ItemKey < ops[j].ItemKey
+	})
+	return ops
+}
+
+func countOperations(ops []*fastly.BatchDictionaryItem) (created, updated, deleted int) {
+	for _, op := range ops {
+		switch op.Operation {
+		case fastly.CreateBatchOperation:
+			created++
+		case fastly.UpdateBatchOperation:
+			updated++
+		case fastly.DeleteBatchOperation:
+			deleted++
+		}
+	}
+	return created, updated, deleted
+}
+
+// ReadItems reads dictionary items from a file of key/value pairs, the format
+// of which is determined by its extension:
+//
+// .csv files have a key and value per row, optionally preceded by a
+// "key,value" header row. .json files contain a single object, and .yaml or
+// .yml files a single mapping, of keys to values.
+func ReadItems(path string) (map[string]string, error) {
+	/* #nosec */
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	switch ext := strings.ToLower(filepath.Ext(path)); ext {
+	case ".csv":
+		return readCSV(f)
+	case ".json":
+		items := make(map[string]string)
+		if err := json.NewDecoder(f).Decode(&items); err != nil {
+			return nil, fmt.Errorf("error parsing %s: %w", path, err)
+		}
+		return items, nil
+	case ".yaml", ".yml":
+		items := make(map[string]string)
+		if err := yaml.NewDecoder(f).Decode(&items); err != nil && err != io.EOF {
+			return nil, fmt.Errorf("error parsing %s: %w", path, err)
+		}
+		return items, nil
+	default:
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("unsupported file type: %s", path),
+			Remediation: "Provide a file with a .csv, .json, .yaml or .yml extension.",
+		}
+	}
+}
+
+func readCSV(r io.Reader) (map[string]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = 2
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing CSV: %w", err)
+	}
+
+	items := make(map[string]string, len(records))
+	for i, record := range records {
+		if i == 0 && strings.EqualFold(record[0], "key") && strings.EqualFold(record[1], "value") {
+			continue
+		}
+		if _, ok := items[record[0]]; ok {
+			return nil, fmt.Errorf("error parsing CSV: duplicate key '%s' on line %d", record[0], i+1)
+		}
+		items[record[0]] = record[1]
+	}
+	return items, nil
+}
@@ -0,0 +1,342 @@
+package gzip_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestGzipCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("gzip create --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("gzip create --service-id 123 --version 1 --name text --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateGzipFn:   createGzipError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("gzip create --service-id 123 --version 1 --name text --content-types text/html --extensions html --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateGzipFn:   createGzipOK,
+			},
+			wantOutput: "Created gzip configuration text (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestGzipList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("gzip list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			wantOutput: listGzipsShortOutput,
+		},
+		{
+			args: args("gzip list --service-id 123 --version 1 --verbose"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsOK,
+			},
+			wantOutput: listGzipsVerboseOutput,
+		},
+		{
+			args: args("gzip list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListGzipsFn:    listGzipsError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestGzipDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("gzip describe --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("gzip describe --service-id 123 --version 1 --name text"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("gzip describe --service-id 123 --version 1 --name text"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetGzipFn:      getGzipOK,
+			},
+			wantOutput: describeGzipOutput,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestGzipUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("gzip update --service-id 123 --version 1 --new-name assets"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("gzip update --service-id 123 --version 1 --name text --new-name assets"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "service version 1 is not editable",
+		},
+		{
+			args: args("gzip update --service-id 123 --version 1 --name text --new-name assets --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("gzip update --service-id 123 --version 1 --name text --new-name assets --extensions css --cache-condition is-static --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateGzipFn:   updateGzipOK,
+			},
+			wantOutput: "Updated gzip configuration assets (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestGzipDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("gzip delete --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("gzip delete --service-id 123 --version 1 --name text --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("gzip delete --service-id 123 --version 1 --name text --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteGzipFn:   deleteGzipOK,
+			},
+			wantOutput: "Deleted gzip configuration text (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createGzipOK(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	if i.ContentTypes != "text/html" || i.Extensions != "html" || i.CacheCondition != "" {
+		return nil, errTest
+	}
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createGzipError(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+func listGzipsOK(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return []*fastly.Gzip{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "text",
+			ContentTypes:   "text/html text/css",
+			Extensions:     "html css",
+			CacheCondition: "is-text",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "scripts",
+			ContentTypes:   "application/javascript",
+			Extensions:     "js",
+			CacheCondition: "is-static",
+		},
+	}, nil
+}
+
+func listGzipsError(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+var listGzipsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME     CONTENT TYPES           EXTENSIONS  CACHE CONDITION
+123      1        text     text/html text/css      html css    is-text
+123      1        scripts  application/javascript  js          is-static
+`) + "\n"
+
+var listGzipsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"\tGzip configuration 1/2",
+	"\t\tName: text",
+	"\t\tContent types: text/html text/css",
+	"\t\tExtensions: html css",
+	"\t\tCache condition: is-text",
+	"\tGzip configuration 2/2",
+	"\t\tName: scripts",
+	"\t\tContent types: application/javascript",
+	"\t\tExtensions: js",
+	"\t\tCache condition: is-static",
+}, "\n") + "\n\n"
+
+func getGzipOK(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "text",
+		ContentTypes:   "text/html text/css",
+		Extensions:     "html css",
+		CacheCondition: "is-static",
+	}, nil
+}
+
+func getGzipError(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+var describeGzipOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: text",
+	"Content types: text/html text/css",
+	"Extensions: html css",
+	"Cache condition: is-static",
+}, "\n") + "\n"
+
+func updateGzipOK(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	if *i.Extensions != "css" || *i.CacheCondition != "is-static" || i.ContentTypes != nil {
+		return nil, errTest
+	}
+	return &fastly.Gzip{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateGzipError(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return nil, errTest
+}
+
+func deleteGzipOK(i *fastly.DeleteGzipInput) error {
+	return nil
+}
+
+func deleteGzipError(i *fastly.DeleteGzipInput) error {
+	return errTest
+}
@@ -0,0 +1,99 @@
+package gzip
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update gzip configurations.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateGzipInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName        cmd.OptionalString
+	ContentTypes   cmd.OptionalString
+	Extensions     cmd.OptionalString
+	CacheCondition cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a gzip configuration on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Gzip configuration name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New gzip configuration name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("content-types", "Space-separated list of content types to compress (e.g. text/html application/json)").Action(c.ContentTypes.Set).StringVar(&c.ContentTypes.Value)
+	c.CmdClause.Flag("extensions", "Space-separated list of file extensions to compress (e.g. css js html)").Action(c.Extensions.Set).StringVar(&c.Extensions.Value)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will apply the gzip configuration").Action(c.CacheCondition.Set).StringVar(&c.CacheCondition.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.ContentTypes.WasSet {
+		c.input.ContentTypes = fastly.String(c.ContentTypes.Value)
+	}
+
+	if c.Extensions.WasSet {
+		c.input.Extensions = fastly.String(c.Extensions.Value)
+	}
+
+	if c.CacheCondition.WasSet {
+		c.input.CacheCondition = fastly.String(c.CacheCondition.Value)
+	}
+
+	g, err := c.Globals.Client.UpdateGzip(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated gzip configuration %s (service %s version %d)", g.Name, g.ServiceID, g.ServiceVersion)
+	return nil
+}
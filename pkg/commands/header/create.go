@@ -0,0 +1,100 @@
+package header
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Actions is the list of supported header actions.
+var Actions = []string{"set", "append", "delete", "regex", "regex_repeat"}
+
+// Types is the list of supported header types.
+var Types = []string{"request", "fetch", "cache", "response"}
+
+// CreateCommand calls the Fastly API to create headers.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateHeaderInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	// We must store the following flags separately to the input structure so
+	// they can be casted to go-fastly's custom types later.
+	Action      string
+	Type        string
+	IgnoreIfSet bool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a header on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Header name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("action", "Action to perform on the header. Valid values are: set, append, delete, regex, regex_repeat").Required().HintOptions(Actions...).EnumVar(&c.Action, Actions...)
+	c.CmdClause.Flag("type", "When to perform the action. Valid values are: request, fetch, cache, response").Required().HintOptions(Types...).EnumVar(&c.Type, Types...)
+	c.CmdClause.Flag("dst", "Header to set (e.g. http.X-Served-By)").Required().StringVar(&c.Input.Destination)
+	c.CmdClause.Flag("src", "Variable to be used as a source for the header content (does not apply to delete action)").StringVar(&c.Input.Source)
+	c.CmdClause.Flag("regex", "Regular expression to use (only applies to regex and regex_repeat actions)").StringVar(&c.Input.Regex)
+	c.CmdClause.Flag("substitution", "Value to substitute in place of regular expression (only applies to regex and regex_repeat actions)").StringVar(&c.Input.Substitution)
+	c.CmdClause.Flag("ignore-if-set", "Don't add the header if it is added already (only applies to set action)").BoolVar(&c.IgnoreIfSet)
+	c.CmdClause.Flag("priority", "Lower priorities execute first").UintVar(&c.Input.Priority)
+	c.CmdClause.Flag("request-condition", "Condition which, if met, will apply the header to the request").StringVar(&c.Input.RequestCondition)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will apply the header to the cached response").StringVar(&c.Input.CacheCondition)
+	c.CmdClause.Flag("response-condition", "Condition which, if met, will apply the header to the response").StringVar(&c.Input.ResponseCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Action = fastly.HeaderAction(c.Action)
+	c.Input.Type = fastly.HeaderType(c.Type)
+	c.Input.IgnoreIfSet = fastly.Compatibool(c.IgnoreIfSet)
+
+	h, err := c.Globals.Client.CreateHeader(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created header %s (service %s version %d)", h.Name, h.ServiceID, h.ServiceVersion)
+	return nil
+}
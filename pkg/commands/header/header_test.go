@@ -0,0 +1,382 @@
+package header_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestHeaderCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("header create --service-id 123 --version 1 --action set --type request --dst http.X-Test"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args:      args("header create --service-id 123 --version 1 --name test --action replace --type request --dst http.X-Test"),
+			wantError: "error parsing arguments: enum value must be one of set,append,delete,regex,regex_repeat, got 'replace'",
+		},
+		{
+			args: args("header create --service-id 123 --version 1 --name test --action set --type request --dst http.X-Test --src client.ip"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "service version 1 is not editable",
+		},
+		{
+			args: args("header create --service-id 123 --version 1 --name test --action set --type request --dst http.X-Test --src client.ip --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateHeaderFn: createHeaderError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("header create --service-id 123 --version 1 --name test --action set --type request --dst http.X-Test --src client.ip --ignore-if-set --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				CreateHeaderFn: createHeaderOK,
+			},
+			wantOutput: "Created header test (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestHeaderList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("header list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			wantOutput: listHeadersShortOutput,
+		},
+		{
+			args: args("header list --service-id 123 --version 1 --verbose"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersOK,
+			},
+			wantOutput: listHeadersVerboseOutput,
+		},
+		{
+			args: args("header list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListHeadersFn:  listHeadersError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestHeaderDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("header describe --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("header describe --service-id 123 --version 1 --name test"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("header describe --service-id 123 --version 1 --name test"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetHeaderFn:    getHeaderOK,
+			},
+			wantOutput: describeHeaderOutput,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestHeaderUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("header update --service-id 123 --version 1 --new-name example"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("header update --service-id 123 --version 1 --name test --new-name example --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: updateHeaderError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("header update --service-id 123 --version 1 --name test --new-name example --action delete --priority 20 --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateHeaderFn: updateHeaderOK,
+			},
+			wantOutput: "Updated header example (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestHeaderDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("header delete --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("header delete --service-id 123 --version 1 --name test --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("header delete --service-id 123 --version 1 --name test --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				DeleteHeaderFn: deleteHeaderOK,
+			},
+			wantOutput: "Deleted header test (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createHeaderOK(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	if i.Action != fastly.HeaderActionSet || i.Type != fastly.HeaderTypeRequest || !i.IgnoreIfSet {
+		return nil, errTest
+	}
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+		Action:         i.Action,
+		Type:           i.Type,
+		Destination:    i.Destination,
+		Source:         i.Source,
+	}, nil
+}
+
+func createHeaderError(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+func listHeadersOK(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return []*fastly.Header{
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "test",
+			Action:         fastly.HeaderActionSet,
+			Type:           fastly.HeaderTypeRequest,
+			Destination:    "http.X-Test",
+			Source:         "client.ip",
+			Priority:       10,
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "example",
+			Action:         fastly.HeaderActionDelete,
+			Type:           fastly.HeaderTypeResponse,
+			Destination:    "http.Server",
+			Priority:       100,
+		},
+	}, nil
+}
+
+func listHeadersError(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return nil, errTest
+}
+
+var listHeadersShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME     ACTION  TYPE      DESTINATION
+123      1        test     set     request   http.X-Test
+123      1        example  delete  response  http.Server
+`) + "\n"
+
+var listHeadersVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"	Header 1/2",
+	"		Name: test",
+	"		Action: set",
+	"		Type: request",
+	"		Destination: http.X-Test",
+	"		Source: client.ip",
+	"		Regex: ",
+	"		Substitution: ",
+	"		Ignore if set: false",
+	"		Priority: 10",
+	"		Request condition: ",
+	"		Cache condition: ",
+	"		Response condition: ",
+	"	Header 2/2",
+	"		Name: example",
+	"		Action: delete",
+	"		Type: response",
+	"		Destination: http.Server",
+	"		Source: ",
+	"		Regex: ",
+	"		Substitution: ",
+	"		Ignore if set: false",
+	"		Priority: 100",
+	"		Request condition: ",
+	"		Cache condition: ",
+	"		Response condition: ",
+}, "\n") + "\n\n"
+
+func getHeaderOK(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "test",
+		Action:         fastly.HeaderActionRegex,
+		Type:           fastly.HeaderTypeCache,
+		Destination:    "http.Cache-Control",
+		Source:         "beresp.http.Cache-Control",
+		Regex:          "private",
+		Substitution:   "public",
+		Priority:       10,
+		CacheCondition: "is_cacheable",
+	}, nil
+}
+
+func getHeaderError(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+var describeHeaderOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: test",
+	"Action: regex",
+	"Type: cache",
+	"Destination: http.Cache-Control",
+	"Source: beresp.http.Cache-Control",
+	"Regex: private",
+	"Substitution: public",
+	"Ignore if set: false",
+	"Priority: 10",
+	"Request condition: ",
+	"Cache condition: is_cacheable",
+	"Response condition: ",
+}, "\n") + "\n"
+
+func updateHeaderOK(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	if *i.Action != fastly.HeaderActionDelete || *i.Priority != 20 {
+		return nil, errTest
+	}
+	return &fastly.Header{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateHeaderError(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return nil, errTest
+}
+
+func deleteHeaderOK(i *fastly.DeleteHeaderInput) error {
+	return nil
+}
+
+func deleteHeaderError(i *fastly.DeleteHeaderInput) error {
+	return errTest
+}
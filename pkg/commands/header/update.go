@@ -0,0 +1,147 @@
+package header
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update headers.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateHeaderInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName           cmd.OptionalString
+	Action            cmd.OptionalString
+	Type              cmd.OptionalString
+	Destination       cmd.OptionalString
+	Source            cmd.OptionalString
+	Regex             cmd.OptionalString
+	Substitution      cmd.OptionalString
+	IgnoreIfSet       cmd.OptionalBool
+	Priority          cmd.OptionalUint
+	RequestCondition  cmd.OptionalString
+	CacheCondition    cmd.OptionalString
+	ResponseCondition cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a header on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Header name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New header name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("action", "Action to perform on the header. Valid values are: set, append, delete, regex, regex_repeat").Action(c.Action.Set).HintOptions(Actions...).EnumVar(&c.Action.Value, Actions...)
+	c.CmdClause.Flag("type", "When to perform the action. Valid values are: request, fetch, cache, response").Action(c.Type.Set).HintOptions(Types...).EnumVar(&c.Type.Value, Types...)
+	c.CmdClause.Flag("dst", "Header to set (e.g. http.X-Served-By)").Action(c.Destination.Set).StringVar(&c.Destination.Value)
+	c.CmdClause.Flag("src", "Variable to be used as a source for the header content (does not apply to delete action)").Action(c.Source.Set).StringVar(&c.Source.Value)
+	c.CmdClause.Flag("regex", "Regular expression to use (only applies to regex and regex_repeat actions)").Action(c.Regex.Set).StringVar(&c.Regex.Value)
+	c.CmdClause.Flag("substitution", "Value to substitute in place of regular expression (only applies to regex and regex_repeat actions)").Action(c.Substitution.Set).StringVar(&c.Substitution.Value)
+	c.CmdClause.Flag("ignore-if-set", "Don't add the header if it is added already (only applies to set action)").Action(c.IgnoreIfSet.Set).BoolVar(&c.IgnoreIfSet.Value)
+	c.CmdClause.Flag("priority", "Lower priorities execute first").Action(c.Priority.Set).UintVar(&c.Priority.Value)
+	c.CmdClause.Flag("request-condition", "Condition which, if met, will apply the header to the request").Action(c.RequestCondition.Set).StringVar(&c.RequestCondition.Value)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will apply the header to the cached response").Action(c.CacheCondition.Set).StringVar(&c.CacheCondition.Value)
+	c.CmdClause.Flag("response-condition", "Condition which, if met, will apply the header to the response").Action(c.ResponseCondition.Set).StringVar(&c.ResponseCondition.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Action.WasSet {
+		c.input.Action = fastly.PHeaderAction(fastly.HeaderAction(c.Action.Value))
+	}
+
+	if c.Type.WasSet {
+		c.input.Type = fastly.PHeaderType(fastly.HeaderType(c.Type.Value))
+	}
+
+	if c.Destination.WasSet {
+		c.input.Destination = fastly.String(c.Destination.Value)
+	}
+
+	if c.Source.WasSet {
+		c.input.Source = fastly.String(c.Source.Value)
+	}
+
+	if c.Regex.WasSet {
+		c.input.Regex = fastly.String(c.Regex.Value)
+	}
+
+	if c.Substitution.WasSet {
+		c.input.Substitution = fastly.String(c.Substitution.Value)
+	}
+
+	if c.IgnoreIfSet.WasSet {
+		c.input.IgnoreIfSet = fastly.CBool(c.IgnoreIfSet.Value)
+	}
+
+	if c.Priority.WasSet {
+		c.input.Priority = fastly.Uint(c.Priority.Value)
+	}
+
+	if c.RequestCondition.WasSet {
+		c.input.RequestCondition = fastly.String(c.RequestCondition.Value)
+	}
+
+	if c.CacheCondition.WasSet {
+		c.input.CacheCondition = fastly.String(c.CacheCondition.Value)
+	}
+
+	if c.ResponseCondition.WasSet {
+		c.input.ResponseCondition = fastly.String(c.ResponseCondition.Value)
+	}
+
+	h, err := c.Globals.Client.UpdateHeader(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated header %s (service %s version %d)", h.Name, h.ServiceID, h.ServiceVersion)
+	return nil
+}
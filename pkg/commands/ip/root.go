@@ -8,6 +8,7 @@ import (
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/errors"
 	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
 )
 
 // RootCommand is the parent command for all subcommands in this package.
@@ -38,6 +39,13 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 		return err
 	}
 
+	if c.JSONOutput() {
+		return c.WriteJSON(out, struct {
+			IPv4 fastly.IPAddrs `json:"ipv4"`
+			IPv6 fastly.IPAddrs `json:"ipv6"`
+		}{ipv4, ipv6})
+	}
+
 	text.Break(out)
 	fmt.Fprintf(out, "%s\n", text.Bold("IPv4"))
 	for _, ip := range ipv4 {
@@ -0,0 +1,102 @@
+package profile
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/configure"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CreateCommand calls the Fastly API to validate a token and then stores it
+// against a new named profile.
+type CreateCommand struct {
+	cmd.Base
+
+	clientFactory  configure.APIClientFactory
+	configFilePath string
+	def            bool
+	name           string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, configFilePath string, cf configure.APIClientFactory, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Create a named profile")
+	c.CmdClause.Flag("name", "Name of the profile").Short('n').Required().StringVar(&c.name)
+	c.CmdClause.Flag("default", "Make this the default profile").BoolVar(&c.def)
+	c.configFilePath = configFilePath
+	c.clientFactory = cf
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	if _, ok := c.Globals.File.Profiles[c.name]; ok {
+		err := errors.RemediationError{
+			Inner:       fmt.Errorf("profile '%s' already exists", c.name),
+			Remediation: "To change the token for an existing profile use `fastly profile update`.",
+		}
+		c.Globals.ErrLog.Add(err)
+		return err
+	}
+
+	token, endpoint, err := credentials(in, out, c.Globals)
+	if err != nil {
+		return err
+	}
+
+	progress := text.NewQuietProgress(out)
+	defer func() {
+		if err != nil {
+			progress.Fail() // progress.Done is handled inline
+		}
+	}()
+
+	progress.Step("Validating token...")
+
+	user, err := configure.ValidateToken(token, endpoint, c.clientFactory, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+
+	progress.Step("Persisting configuration...")
+
+	if c.Globals.File.Profiles == nil {
+		c.Globals.File.Profiles = make(map[string]*config.Profile)
+	}
+
+	// The first profile to be created becomes the default.
+	def := c.def || len(c.Globals.File.Profiles) == 0
+
+	p := &config.Profile{
+		Email: user.Login,
+		Token: token,
+	}
+	if endpoint != config.DefaultEndpoint {
+		p.APIEndpoint = endpoint
+	}
+	c.Globals.File.Profiles[c.name] = p
+
+	if def {
+		c.Globals.File.SetDefaultProfile(c.name)
+	}
+
+	if err := configure.WriteConfig(&c.Globals.File, c.configFilePath, c.Globals.ErrLog); err != nil {
+		return err
+	}
+
+	progress.Done()
+	text.Break(out)
+
+	if def {
+		text.Success(out, "Created profile '%s' (now the default profile)", c.name)
+		return nil
+	}
+	text.Success(out, "Created profile '%s'", c.name)
+	return nil
+}
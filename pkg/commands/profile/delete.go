@@ -0,0 +1,59 @@
+package profile
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/configure"
+	fstcredentials "github.com/fastly/cli/pkg/commands/credentials"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DeleteCommand removes a named profile.
+type DeleteCommand struct {
+	cmd.Base
+
+	configFilePath string
+	name           string
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("delete", "Delete a named profile")
+	c.CmdClause.Flag("name", "Name of the profile").Short('n').Required().StringVar(&c.name)
+	c.configFilePath = configFilePath
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	p, err := lookup(c.name, c.Globals)
+	if err != nil {
+		return err
+	}
+
+	// The token is removed from the credential store first, so that a failure
+	// to unlock it leaves the profile in place to try again.
+	if p.Token == config.CredentialStoreToken {
+		if err := fstcredentials.Remove(in, out, c.configFilePath, c.Globals, config.CredentialKey(c.name)); err != nil {
+			return err
+		}
+	}
+
+	delete(c.Globals.File.Profiles, c.name)
+
+	if err := configure.WriteConfig(&c.Globals.File, c.configFilePath, c.Globals.ErrLog); err != nil {
+		return err
+	}
+
+	text.Success(out, "Deleted profile '%s'", c.name)
+
+	if p.Default && len(c.Globals.File.Profiles) > 0 {
+		text.Break(out)
+		text.Warning(out, "The deleted profile was the default profile. Set a new default using `fastly profile switch`.")
+	}
+	return nil
+}
@@ -0,0 +1,3 @@
+// Package profile contains commands to inspect and manipulate the named
+// profiles stored in the CLI global configuration.
+package profile
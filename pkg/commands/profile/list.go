@@ -0,0 +1,76 @@
+package profile
+
+import (
+	"io"
+	"sort"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ListCommand lists the named profiles stored in the config file.
+type ListCommand struct {
+	cmd.Base
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List named profiles")
+	return &c
+}
+
+// Entry is the representation of a profile used for list output. It
+// deliberately omits the token.
+type Entry struct {
+	Name        string
+	Default     bool
+	Email       string
+	APIEndpoint string
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	names := make([]string, 0, len(c.Globals.File.Profiles))
+	for name := range c.Globals.File.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	entries := make([]Entry, 0, len(names))
+	for _, name := range names {
+		p := c.Globals.File.Profiles[name]
+		if p == nil {
+			continue
+		}
+		endpoint := p.APIEndpoint
+		if endpoint == "" {
+			endpoint = config.DefaultEndpoint
+		}
+		entries = append(entries, Entry{
+			Name:        name,
+			Default:     p.Default,
+			Email:       p.Email,
+			APIEndpoint: endpoint,
+		})
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, entries)
+	}
+
+	if len(entries) == 0 {
+		text.Info(out, "No profiles found. Create one using `fastly profile create --name <NAME>`.")
+		return nil
+	}
+
+	t := text.NewTable(out)
+	t.AddHeader("NAME", "DEFAULT", "EMAIL", "ENDPOINT")
+	for _, e := range entries {
+		t.AddLine(e.Name, e.Default, e.Email, e.APIEndpoint)
+	}
+	t.Print()
+	return nil
+}
@@ -0,0 +1,220 @@
+package profile_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestProfile(t *testing.T) {
+	var (
+		goodToken = func() (*fastly.Token, error) { return &fastly.Token{}, nil }
+		badToken  = func() (*fastly.Token, error) { return nil, errors.New("bad token") }
+		goodUser  = func(*fastly.GetUserInput) (*fastly.User, error) {
+			return &fastly.User{
+				Login: "test@example.com",
+			}, nil
+		}
+		args = testutil.Args
+	)
+
+	existing := func() config.File {
+		return config.File{
+			Profiles: map[string]*config.Profile{
+				"production": {Default: true, Email: "prod@example.com", Token: "prod-token"},
+				"staging":    {Email: "staging@example.com", Token: "staging-token", APIEndpoint: "http://staging.dev"},
+			},
+		}
+	}
+
+	for _, testcase := range []struct {
+		name       string
+		args       []string
+		env        config.Environment
+		file       config.File
+		api        mock.API
+		stdin      string
+		wantError  string
+		wantOutput []string
+		wantFile   []string
+	}{
+		{
+			name: "create first profile becomes default",
+			args: args("profile create --name production --token abcdef"),
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			wantOutput: []string{
+				"Fastly API token provided via --token",
+				"Validating token...",
+				"Persisting configuration...",
+				"Created profile 'production' (now the default profile)",
+			},
+			wantFile: []string{
+				"[profile.production]",
+				"default = true",
+				`email = "test@example.com"`,
+				`token = "abcdef"`,
+			},
+		},
+		{
+			name: "create profile with token from stdin and endpoint from flag",
+			args: args("profile create --name customer --endpoint http://local.dev"),
+			file: existing(),
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			stdin: "1234",
+			wantOutput: []string{
+				"Fastly API endpoint (via --endpoint): http://local.dev",
+				"An API token is used to authenticate requests to the Fastly API.",
+				"Fastly API token: ",
+				"Created profile 'customer'",
+			},
+			wantFile: []string{
+				"[profile.customer]",
+				`api_endpoint = "http://local.dev"`,
+				`token = "1234"`,
+			},
+		},
+		{
+			name:      "create existing profile",
+			args:      args("profile create --name staging --token abcdef"),
+			file:      existing(),
+			wantError: "profile 'staging' already exists",
+		},
+		{
+			name: "create profile with invalid token",
+			args: args("profile create --name customer --token abcdef"),
+			api: mock.API{
+				GetTokenSelfFn: badToken,
+			},
+			wantError: "error validating token: bad token",
+		},
+		{
+			name: "list profiles",
+			args: args("profile list"),
+			file: existing(),
+			wantOutput: []string{
+				"NAME        DEFAULT  EMAIL                ENDPOINT",
+				"production  true     prod@example.com     https://api.fastly.com",
+				"staging     false    staging@example.com  http://staging.dev",
+			},
+		},
+		{
+			name: "list profiles as JSON",
+			args: args("profile list --json"),
+			file: existing(),
+			wantOutput: []string{
+				`{"Name":"production","Default":true,"Email":"prod@example.com","APIEndpoint":"https://api.fastly.com"}`,
+				`{"Name":"staging","Default":false,"Email":"staging@example.com","APIEndpoint":"http://staging.dev"}`,
+			},
+		},
+		{
+			name:       "list no profiles",
+			args:       args("profile list"),
+			wantOutput: []string{"No profiles found."},
+		},
+		{
+			name:       "switch profile",
+			args:       args("profile switch --name staging"),
+			file:       existing(),
+			wantOutput: []string{"Profile 'staging' is now the default profile"},
+			wantFile: []string{
+				"[profile.staging]\n    api_endpoint = \"http://staging.dev\"\n    default = true",
+				"[profile.production]\n    default = false",
+			},
+		},
+		{
+			name:      "switch to unknown profile",
+			args:      args("profile switch --name unknown"),
+			file:      existing(),
+			wantError: "profile 'unknown' does not exist",
+		},
+		{
+			name: "update profile",
+			args: args("profile update --name staging --token new_token"),
+			file: existing(),
+			api: mock.API{
+				GetTokenSelfFn: goodToken,
+				GetUserFn:      goodUser,
+			},
+			wantOutput: []string{"Updated profile 'staging'"},
+			wantFile: []string{
+				"[profile.staging]\n    default = false\n    email = \"test@example.com\"\n    token = \"new_token\"",
+			},
+		},
+		{
+			name: "delete default profile",
+			args: args("profile delete --name production"),
+			file: existing(),
+			wantOutput: []string{
+				"Deleted profile 'production'",
+				"Set a new default using `fastly profile switch`.",
+			},
+			wantFile: []string{"[profile.staging]"},
+		},
+		{
+			name:      "unknown profile from flag",
+			args:      args("service list --profile unknown"),
+			file:      existing(),
+			wantError: "profile 'unknown' does not exist",
+		},
+		{
+			name:      "unknown profile from environment",
+			args:      args("service list"),
+			env:       config.Environment{Profile: "unknown"},
+			file:      existing(),
+			wantError: "profile 'unknown' does not exist",
+		},
+		{
+			name: "verbose output reports profile",
+			args: args("service list --profile staging --verbose"),
+			file: existing(),
+			api: mock.API{
+				ListServicesFn: func(*fastly.ListServicesInput) ([]*fastly.Service, error) { return nil, nil },
+			},
+			wantOutput: []string{
+				"Fastly CLI profile provided via --profile: staging",
+				"Fastly API token provided via config file",
+				"Fastly API endpoint (via config file): http://staging.dev",
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			configFilePath := testutil.MakeTempFile(t, "")
+			defer os.RemoveAll(configFilePath)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.ConfigFile = testcase.file
+			opts.ConfigPath = configFilePath
+			opts.Env = testcase.env
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantError == "" && len(testcase.wantFile) > 0 {
+				p, err := os.ReadFile(configFilePath)
+				testutil.AssertNoError(t, err)
+				for _, s := range testcase.wantFile {
+					testutil.AssertStringContains(t, string(p), s)
+				}
+			}
+		})
+	}
+}
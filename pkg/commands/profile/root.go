@@ -0,0 +1,83 @@
+package profile
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/configure"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/env"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// RootCommand is the parent command for all subcommands in this package.
+// It should be installed under the primary root command.
+type RootCommand struct {
+	cmd.Base
+	// no flags
+}
+
+// NewRootCommand returns a new command registered in the parent.
+func NewRootCommand(parent cmd.Registerer, globals *config.Data) *RootCommand {
+	var c RootCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("profile", "Manage named configuration profiles")
+	return &c
+}
+
+// Exec implements the command interface.
+func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
+	panic("unreachable")
+}
+
+// credentials returns the token and endpoint to be stored against a profile.
+//
+// NOTE: we deliberately don't use config.Data.Token() as that would resolve
+// the token from the profile currently in use, whereas we only want a token
+// that was explicitly provided, otherwise we'll prompt for one.
+func credentials(in io.Reader, out io.Writer, globals *config.Data) (token, endpoint string, err error) {
+	switch {
+	case globals.Flag.Endpoint != "":
+		endpoint = globals.Flag.Endpoint
+		text.Output(out, "Fastly API endpoint (via --endpoint): %s", endpoint)
+	case globals.Env.Endpoint != "":
+		endpoint = globals.Env.Endpoint
+		text.Output(out, "Fastly API endpoint (via %s): %s", env.Endpoint, endpoint)
+	default:
+		endpoint = config.DefaultEndpoint
+	}
+
+	switch {
+	case globals.Flag.Token != "":
+		token = globals.Flag.Token
+		text.Output(out, "Fastly API token provided via --token")
+	case globals.Env.Token != "":
+		token = globals.Env.Token
+		text.Output(out, "Fastly API token provided via %s", env.Token)
+	default:
+		token, err = configure.PromptToken(in, out)
+		if err != nil {
+			globals.ErrLog.Add(err)
+			return token, endpoint, err
+		}
+	}
+
+	text.Break(out)
+	return token, endpoint, nil
+}
+
+// lookup returns the named profile from the config file.
+func lookup(name string, globals *config.Data) (*config.Profile, error) {
+	p, ok := globals.File.Profiles[name]
+	if !ok || p == nil {
+		err := errors.RemediationError{
+			Inner:       fmt.Errorf("profile '%s' does not exist", name),
+			Remediation: errors.ProfileRemediation,
+		}
+		globals.ErrLog.Add(err)
+		return nil, err
+	}
+	return p, nil
+}
@@ -0,0 +1,44 @@
+package profile
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/configure"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// SwitchCommand sets the default profile.
+type SwitchCommand struct {
+	cmd.Base
+
+	configFilePath string
+	name           string
+}
+
+// NewSwitchCommand returns a usable command registered under the parent.
+func NewSwitchCommand(parent cmd.Registerer, configFilePath string, globals *config.Data) *SwitchCommand {
+	var c SwitchCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("switch", "Set the default profile")
+	c.CmdClause.Flag("name", "Name of the profile").Short('n').Required().StringVar(&c.name)
+	c.configFilePath = configFilePath
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *SwitchCommand) Exec(in io.Reader, out io.Writer) error {
+	if _, err := lookup(c.name, c.Globals); err != nil {
+		return err
+	}
+
+	c.Globals.File.SetDefaultProfile(c.name)
+
+	if err := configure.WriteConfig(&c.Globals.File, c.configFilePath, c.Globals.ErrLog); err != nil {
+		return err
+	}
+
+	text.Success(out, "Profile '%s' is now the default profile", c.name)
+	return nil
+}
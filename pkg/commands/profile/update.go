@@ -0,0 +1,82 @@
+package profile
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/configure"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UpdateCommand calls the Fastly API to validate a new token and then stores
+// it against an existing named profile.
+type UpdateCommand struct {
+	cmd.Base
+
+	clientFactory  configure.APIClientFactory
+	configFilePath string
+	def            bool
+	name           string
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, configFilePath string, cf configure.APIClientFactory, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Update the token and endpoint of a named profile")
+	c.CmdClause.Flag("name", "Name of the profile").Short('n').Required().StringVar(&c.name)
+	c.CmdClause.Flag("default", "Make this the default profile").BoolVar(&c.def)
+	c.configFilePath = configFilePath
+	c.clientFactory = cf
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	p, err := lookup(c.name, c.Globals)
+	if err != nil {
+		return err
+	}
+
+	token, endpoint, err := credentials(in, out, c.Globals)
+	if err != nil {
+		return err
+	}
+
+	progress := text.NewQuietProgress(out)
+	defer func() {
+		if err != nil {
+			progress.Fail() // progress.Done is handled inline
+		}
+	}()
+
+	progress.Step("Validating token...")
+
+	user, err := configure.ValidateToken(token, endpoint, c.clientFactory, c.Globals.ErrLog)
+	if err != nil {
+		return err
+	}
+
+	progress.Step("Persisting configuration...")
+
+	p.Email = user.Login
+	p.Token = token
+	p.APIEndpoint = ""
+	if endpoint != config.DefaultEndpoint {
+		p.APIEndpoint = endpoint
+	}
+
+	if c.def {
+		c.Globals.File.SetDefaultProfile(c.name)
+	}
+
+	if err := configure.WriteConfig(&c.Globals.File, c.configFilePath, c.Globals.ErrLog); err != nil {
+		return err
+	}
+
+	progress.Done()
+	text.Break(out)
+	text.Success(out, "Updated profile '%s'", c.name)
+	return nil
+}
@@ -0,0 +1,108 @@
+package requestsetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Actions is the list of supported request setting actions.
+var Actions = []string{"lookup", "pass"}
+
+// XForwardedFor is the list of supported X-Forwarded-For header behaviours.
+var XForwardedFor = []string{"clear", "leave", "append", "append_all", "overwrite"}
+
+// CreateCommand calls the Fastly API to create request settings.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateRequestSettingInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	// We must store the following flags separately to the input structure so
+	// they can be casted to go-fastly's custom types later.
+	Action         string
+	XForwardedFor  string
+	ForceMiss      bool
+	ForceSSL       bool
+	BypassBusyWait bool
+	TimerSupport   bool
+	GeoHeaders     bool
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a request setting on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Request setting name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("action", "Allows you to terminate request handling and immediately perform an action. Valid values are: lookup, pass").HintOptions(Actions...).EnumVar(&c.Action, Actions...)
+	c.CmdClause.Flag("xff", "Short for X-Forwarded-For. Valid values are: clear, leave, append, append_all, overwrite").HintOptions(XForwardedFor...).EnumVar(&c.XForwardedFor, XForwardedFor...)
+	c.CmdClause.Flag("force-miss", "Force a cache miss for the request").BoolVar(&c.ForceMiss)
+	c.CmdClause.Flag("force-ssl", "Force the request to use SSL, redirecting a non-SSL request to SSL").BoolVar(&c.ForceSSL)
+	c.CmdClause.Flag("bypass-busy-wait", "Disable collapsed forwarding, so you don't wait for other objects to origin").BoolVar(&c.BypassBusyWait)
+	c.CmdClause.Flag("max-stale-age", "How old an object is allowed to be to serve stale-if-error or stale-while-revalidate").UintVar(&c.Input.MaxStaleAge)
+	c.CmdClause.Flag("hash-keys", "Comma separated list of varnish request object fields that should be in the hash key").StringVar(&c.Input.HashKeys)
+	c.CmdClause.Flag("timer-support", "Injects the X-Timer info into the request for viewing origin fetch durations").BoolVar(&c.TimerSupport)
+	c.CmdClause.Flag("geo-headers", "Injects Fastly-Geo-Country, Fastly-Geo-City, and Fastly-Geo-Region into the request headers").BoolVar(&c.GeoHeaders)
+	c.CmdClause.Flag("default-host", "Sets the host header").StringVar(&c.Input.DefaultHost)
+	c.CmdClause.Flag("request-condition", "Condition which, if met, will apply the request setting").StringVar(&c.Input.RequestCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Action = fastly.RequestSettingAction(c.Action)
+	c.Input.XForwardedFor = fastly.RequestSettingXFF(c.XForwardedFor)
+	c.Input.ForceMiss = fastly.Compatibool(c.ForceMiss)
+	c.Input.ForceSSL = fastly.Compatibool(c.ForceSSL)
+	c.Input.BypassBusyWait = fastly.Compatibool(c.BypassBusyWait)
+	c.Input.TimerSupport = fastly.Compatibool(c.TimerSupport)
+	c.Input.GeoHeaders = fastly.Compatibool(c.GeoHeaders)
+
+	rs, err := c.Globals.Client.CreateRequestSetting(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created request setting %s (service %s version %d)", rs.Name, rs.ServiceID, rs.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,372 @@
+package requestsetting_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestRequestSettingCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("request-setting create --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args:      args("request-setting create --service-id 123 --version 1 --name force-ssl --xff replace"),
+			wantError: "error parsing arguments: enum value must be one of clear,leave,append,append_all,overwrite, got 'replace'",
+		},
+		{
+			args: args("request-setting create --service-id 123 --version 1 --name force-ssl --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateRequestSettingFn: createRequestSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("request-setting create --service-id 123 --version 1 --name force-ssl --force-ssl --xff append --default-host www.example.com --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateRequestSettingFn: createRequestSettingOK,
+			},
+			wantOutput: "Created request setting force-ssl (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestRequestSettingList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("request-setting list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			wantOutput: listRequestSettingsShortOutput,
+		},
+		{
+			args: args("request-setting list --service-id 123 --version 1 --verbose"),
+			api: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsOK,
+			},
+			wantOutput: listRequestSettingsVerboseOutput,
+		},
+		{
+			args: args("request-setting list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListRequestSettingsFn: listRequestSettingsError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestRequestSettingDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("request-setting describe --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("request-setting describe --service-id 123 --version 1 --name force-ssl"),
+			api: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("request-setting describe --service-id 123 --version 1 --name force-ssl"),
+			api: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetRequestSettingFn: getRequestSettingOK,
+			},
+			wantOutput: describeRequestSettingOutput,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestRequestSettingUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("request-setting update --service-id 123 --version 1 --new-name secure"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("request-setting update --service-id 123 --version 1 --name force-ssl --new-name secure"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "service version 1 is not editable",
+		},
+		{
+			args: args("request-setting update --service-id 123 --version 1 --name force-ssl --new-name secure --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("request-setting update --service-id 123 --version 1 --name force-ssl --new-name secure --action pass --force-miss --max-stale-age 30 --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateRequestSettingFn: updateRequestSettingOK,
+			},
+			wantOutput: "Updated request setting secure (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestRequestSettingDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("request-setting delete --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("request-setting delete --service-id 123 --version 1 --name force-ssl --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("request-setting delete --service-id 123 --version 1 --name force-ssl --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteRequestSettingFn: deleteRequestSettingOK,
+			},
+			wantOutput: "Deleted request setting force-ssl (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createRequestSettingOK(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	if !i.ForceSSL || i.ForceMiss || i.XForwardedFor != fastly.RequestSettingXFFAppend || i.DefaultHost != "www.example.com" {
+		return nil, errTest
+	}
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createRequestSettingError(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+func listRequestSettingsOK(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return []*fastly.RequestSetting{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "force-ssl",
+			ForceSSL:         true,
+			XForwardedFor:    fastly.RequestSettingXFFAppend,
+			DefaultHost:      "www.example.com",
+			RequestCondition: "is-http",
+		},
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "api-pass",
+			Action:           fastly.RequestSettingActionPass,
+			RequestCondition: "is-api",
+		},
+	}, nil
+}
+
+func listRequestSettingsError(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+var listRequestSettingsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME       ACTION  XFF     DEFAULT HOST     REQUEST CONDITION
+123      1        force-ssl          append  www.example.com  is-http
+123      1        api-pass   pass                             is-api
+`) + "\n"
+
+var listRequestSettingsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"\tRequest setting 1/2",
+	"\t\tName: force-ssl",
+	"\t\tAction: ",
+	"\t\tX-Forwarded-For: append",
+	"\t\tForce miss: false",
+	"\t\tForce SSL: true",
+	"\t\tBypass busy wait: false",
+	"\t\tMax stale age: 0",
+	"\t\tHash keys: ",
+	"\t\tTimer support: false",
+	"\t\tGeo headers: false",
+	"\t\tDefault host: www.example.com",
+	"\t\tRequest condition: is-http",
+	"\tRequest setting 2/2",
+	"\t\tName: api-pass",
+	"\t\tAction: pass",
+	"\t\tX-Forwarded-For: ",
+	"\t\tForce miss: false",
+	"\t\tForce SSL: false",
+	"\t\tBypass busy wait: false",
+	"\t\tMax stale age: 0",
+	"\t\tHash keys: ",
+	"\t\tTimer support: false",
+	"\t\tGeo headers: false",
+	"\t\tDefault host: ",
+	"\t\tRequest condition: is-api",
+}, "\n") + "\n\n"
+
+func getRequestSettingOK(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           "force-ssl",
+		ForceSSL:       true,
+		XForwardedFor:  fastly.RequestSettingXFFAppend,
+		HashKeys:       "req.url,req.http.host",
+		TimerSupport:   true,
+		DefaultHost:    "www.example.com",
+	}, nil
+}
+
+func getRequestSettingError(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+var describeRequestSettingOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: force-ssl",
+	"Action: ",
+	"X-Forwarded-For: append",
+	"Force miss: false",
+	"Force SSL: true",
+	"Bypass busy wait: false",
+	"Max stale age: 0",
+	"Hash keys: req.url,req.http.host",
+	"Timer support: true",
+	"Geo headers: false",
+	"Default host: www.example.com",
+	"Request condition: ",
+}, "\n") + "\n"
+
+func updateRequestSettingOK(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	if i.Action != fastly.RequestSettingActionPass || !*i.ForceMiss || *i.MaxStaleAge != 30 || i.ForceSSL != nil {
+		return nil, errTest
+	}
+	return &fastly.RequestSetting{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateRequestSettingError(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return nil, errTest
+}
+
+func deleteRequestSettingOK(i *fastly.DeleteRequestSettingInput) error {
+	return nil
+}
+
+func deleteRequestSettingError(i *fastly.DeleteRequestSettingInput) error {
+	return errTest
+}
@@ -0,0 +1,147 @@
+package requestsetting
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update request settings.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateRequestSettingInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName          cmd.OptionalString
+	Action           cmd.OptionalString
+	XForwardedFor    cmd.OptionalString
+	ForceMiss        cmd.OptionalBool
+	ForceSSL         cmd.OptionalBool
+	BypassBusyWait   cmd.OptionalBool
+	MaxStaleAge      cmd.OptionalUint
+	HashKeys         cmd.OptionalString
+	TimerSupport     cmd.OptionalBool
+	GeoHeaders       cmd.OptionalBool
+	DefaultHost      cmd.OptionalString
+	RequestCondition cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a request setting on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Request setting name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New request setting name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("action", "Allows you to terminate request handling and immediately perform an action. Valid values are: lookup, pass").Action(c.Action.Set).HintOptions(Actions...).EnumVar(&c.Action.Value, Actions...)
+	c.CmdClause.Flag("xff", "Short for X-Forwarded-For. Valid values are: clear, leave, append, append_all, overwrite").Action(c.XForwardedFor.Set).HintOptions(XForwardedFor...).EnumVar(&c.XForwardedFor.Value, XForwardedFor...)
+	c.CmdClause.Flag("force-miss", "Force a cache miss for the request").Action(c.ForceMiss.Set).BoolVar(&c.ForceMiss.Value)
+	c.CmdClause.Flag("force-ssl", "Force the request to use SSL, redirecting a non-SSL request to SSL").Action(c.ForceSSL.Set).BoolVar(&c.ForceSSL.Value)
+	c.CmdClause.Flag("bypass-busy-wait", "Disable collapsed forwarding, so you don't wait for other objects to origin").Action(c.BypassBusyWait.Set).BoolVar(&c.BypassBusyWait.Value)
+	c.CmdClause.Flag("max-stale-age", "How old an object is allowed to be to serve stale-if-error or stale-while-revalidate").Action(c.MaxStaleAge.Set).UintVar(&c.MaxStaleAge.Value)
+	c.CmdClause.Flag("hash-keys", "Comma separated list of varnish request object fields that should be in the hash key").Action(c.HashKeys.Set).StringVar(&c.HashKeys.Value)
+	c.CmdClause.Flag("timer-support", "Injects the X-Timer info into the request for viewing origin fetch durations").Action(c.TimerSupport.Set).BoolVar(&c.TimerSupport.Value)
+	c.CmdClause.Flag("geo-headers", "Injects Fastly-Geo-Country, Fastly-Geo-City, and Fastly-Geo-Region into the request headers").Action(c.GeoHeaders.Set).BoolVar(&c.GeoHeaders.Value)
+	c.CmdClause.Flag("default-host", "Sets the host header").Action(c.DefaultHost.Set).StringVar(&c.DefaultHost.Value)
+	c.CmdClause.Flag("request-condition", "Condition which, if met, will apply the request setting").Action(c.RequestCondition.Set).StringVar(&c.RequestCondition.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Action.WasSet {
+		c.input.Action = fastly.RequestSettingAction(c.Action.Value)
+	}
+
+	if c.XForwardedFor.WasSet {
+		c.input.XForwardedFor = fastly.RequestSettingXFF(c.XForwardedFor.Value)
+	}
+
+	if c.ForceMiss.WasSet {
+		c.input.ForceMiss = fastly.CBool(c.ForceMiss.Value)
+	}
+
+	if c.ForceSSL.WasSet {
+		c.input.ForceSSL = fastly.CBool(c.ForceSSL.Value)
+	}
+
+	if c.BypassBusyWait.WasSet {
+		c.input.BypassBusyWait = fastly.CBool(c.BypassBusyWait.Value)
+	}
+
+	if c.MaxStaleAge.WasSet {
+		c.input.MaxStaleAge = fastly.Uint(c.MaxStaleAge.Value)
+	}
+
+	if c.HashKeys.WasSet {
+		c.input.HashKeys = fastly.String(c.HashKeys.Value)
+	}
+
+	if c.TimerSupport.WasSet {
+		c.input.TimerSupport = fastly.CBool(c.TimerSupport.Value)
+	}
+
+	if c.GeoHeaders.WasSet {
+		c.input.GeoHeaders = fastly.CBool(c.GeoHeaders.Value)
+	}
+
+	if c.DefaultHost.WasSet {
+		c.input.DefaultHost = fastly.String(c.DefaultHost.Value)
+	}
+
+	if c.RequestCondition.WasSet {
+		c.input.RequestCondition = fastly.String(c.RequestCondition.Value)
+	}
+
+	rs, err := c.Globals.Client.UpdateRequestSetting(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated request setting %s (service %s version %d)", rs.Name, rs.ServiceID, rs.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,81 @@
+package responseobject
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CreateCommand calls the Fastly API to create response objects.
+type CreateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	Input          fastly.CreateResponseObjectInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Create a response object on a Fastly service version").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Response object name").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("status", "The HTTP status code").UintVar(&c.Input.Status)
+	c.CmdClause.Flag("response", "The HTTP response reason phrase (e.g. OK)").StringVar(&c.Input.Response)
+	c.CmdClause.Flag("content", "The content to deliver for the response object, passed as file path or content, e.g. $(< page.html)").StringVar(&c.Input.Content)
+	c.CmdClause.Flag("content-type", "The MIME type of the content").StringVar(&c.Input.ContentType)
+	c.CmdClause.Flag("request-condition", "Condition which, if met, will select this response object during a request").StringVar(&c.Input.RequestCondition)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will select this response object during a cache lookup").StringVar(&c.Input.CacheCondition)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.Input.ServiceID = serviceID
+	c.Input.ServiceVersion = serviceVersion.Number
+	c.Input.Content = cmd.Content(c.Input.Content)
+
+	ro, err := c.Globals.Client.CreateResponseObject(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Created response object %s (service %s version %d)", ro.Name, ro.ServiceID, ro.ServiceVersion)
+	return nil
+}
@@ -0,0 +1,360 @@
+package responseobject_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestResponseObjectCreate(t *testing.T) {
+	contentFile := testutil.MakeTempFile(t, "<h1>Down for maintenance</h1>")
+	defer os.Remove(contentFile)
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("response-object create --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("response-object create --service-id 123 --version 1 --name maintenance --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: createResponseObjectError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("response-object create --service-id 123 --version 1 --name maintenance --status 503 --response Unavailable --content-type text/html --content " + contentFile + " --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				CreateResponseObjectFn: createResponseObjectOK,
+			},
+			wantOutput: "Created response object maintenance (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestResponseObjectList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("response-object list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			wantOutput: listResponseObjectsShortOutput,
+		},
+		{
+			args: args("response-object list --service-id 123 --version 1 --verbose"),
+			api: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsOK,
+			},
+			wantOutput: listResponseObjectsVerboseOutput,
+		},
+		{
+			args: args("response-object list --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn:        testutil.ListVersions,
+				ListResponseObjectsFn: listResponseObjectsError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestResponseObjectDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("response-object describe --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("response-object describe --service-id 123 --version 1 --name maintenance"),
+			api: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("response-object describe --service-id 123 --version 1 --name maintenance"),
+			api: mock.API{
+				ListVersionsFn:      testutil.ListVersions,
+				GetResponseObjectFn: getResponseObjectOK,
+			},
+			wantOutput: describeResponseObjectOutput,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestResponseObjectUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("response-object update --service-id 123 --version 1 --new-name outage"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("response-object update --service-id 123 --version 1 --name maintenance --new-name outage"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "service version 1 is not editable",
+		},
+		{
+			args: args("response-object update --service-id 123 --version 1 --name maintenance --new-name outage --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: updateResponseObjectError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("response-object update --service-id 123 --version 1 --name maintenance --new-name outage --status 200 --content OK --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				UpdateResponseObjectFn: updateResponseObjectOK,
+			},
+			wantOutput: "Updated response object outage (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestResponseObjectDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("response-object delete --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --name not provided",
+		},
+		{
+			args: args("response-object delete --service-id 123 --version 1 --name maintenance --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("response-object delete --service-id 123 --version 1 --name maintenance --autoclone"),
+			api: mock.API{
+				ListVersionsFn:         testutil.ListVersions,
+				CloneVersionFn:         testutil.CloneVersionResult(4),
+				DeleteResponseObjectFn: deleteResponseObjectOK,
+			},
+			wantOutput: "Deleted response object maintenance (service 123 version 4)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createResponseObjectOK(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	if i.Status != 503 || i.Response != "Unavailable" || i.ContentType != "text/html" || i.Content != "<h1>Down for maintenance</h1>" {
+		return nil, errTest
+	}
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           i.Name,
+	}, nil
+}
+
+func createResponseObjectError(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+func listResponseObjectsOK(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return []*fastly.ResponseObject{
+		{
+			ServiceID:        i.ServiceID,
+			ServiceVersion:   i.ServiceVersion,
+			Name:             "maintenance",
+			Status:           503,
+			Response:         "Unavailable",
+			ContentType:      "text/html",
+			Content:          "<h1>Down for maintenance</h1>",
+			RequestCondition: "is-maintenance",
+		},
+		{
+			ServiceID:      i.ServiceID,
+			ServiceVersion: i.ServiceVersion,
+			Name:           "robots",
+			Status:         200,
+			Response:       "OK",
+			ContentType:    "text/plain",
+			Content:        "User-agent: *",
+		},
+	}, nil
+}
+
+func listResponseObjectsError(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+var listResponseObjectsShortOutput = strings.TrimSpace(`
+SERVICE  VERSION  NAME         STATUS  RESPONSE     CONTENT TYPE
+123      1        maintenance  503     Unavailable  text/html
+123      1        robots       200     OK           text/plain
+`) + "\n"
+
+var listResponseObjectsVerboseOutput = strings.Join([]string{
+	"Fastly API token not provided",
+	"Fastly API endpoint: https://api.fastly.com",
+	"Service ID: 123",
+	"Version: 1",
+	"\tResponse object 1/2",
+	"\t\tName: maintenance",
+	"\t\tStatus: 503",
+	"\t\tResponse: Unavailable",
+	"\t\tContent type: text/html",
+	"\t\tRequest condition: is-maintenance",
+	"\t\tCache condition: ",
+	"\t\tContent: <h1>Down for maintenance</h1>",
+	"\tResponse object 2/2",
+	"\t\tName: robots",
+	"\t\tStatus: 200",
+	"\t\tResponse: OK",
+	"\t\tContent type: text/plain",
+	"\t\tRequest condition: ",
+	"\t\tCache condition: ",
+	"\t\tContent: User-agent: *",
+}, "\n") + "\n\n"
+
+func getResponseObjectOK(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return &fastly.ResponseObject{
+		ServiceID:        i.ServiceID,
+		ServiceVersion:   i.ServiceVersion,
+		Name:             "maintenance",
+		Status:           503,
+		Response:         "Unavailable",
+		ContentType:      "text/html",
+		Content:          "<h1>Down for maintenance</h1>",
+		RequestCondition: "is-maintenance",
+	}, nil
+}
+
+func getResponseObjectError(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+var describeResponseObjectOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Name: maintenance",
+	"Status: 503",
+	"Response: Unavailable",
+	"Content type: text/html",
+	"Request condition: is-maintenance",
+	"Cache condition: ",
+	"Content: <h1>Down for maintenance</h1>",
+}, "\n") + "\n"
+
+func updateResponseObjectOK(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	if *i.Status != 200 || *i.Content != "OK" || i.Response != nil {
+		return nil, errTest
+	}
+	return &fastly.ResponseObject{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+		Name:           *i.NewName,
+	}, nil
+}
+
+func updateResponseObjectError(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return nil, errTest
+}
+
+func deleteResponseObjectOK(i *fastly.DeleteResponseObjectInput) error {
+	return nil
+}
+
+func deleteResponseObjectError(i *fastly.DeleteResponseObjectInput) error {
+	return errTest
+}
@@ -0,0 +1,117 @@
+package responseobject
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update response objects.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateResponseObjectInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	NewName          cmd.OptionalString
+	Status           cmd.OptionalUint
+	Response         cmd.OptionalString
+	Content          cmd.OptionalString
+	ContentType      cmd.OptionalString
+	RequestCondition cmd.OptionalString
+	CacheCondition   cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update a response object on a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("name", "Response object name").Short('n').Required().StringVar(&c.input.Name)
+	c.CmdClause.Flag("new-name", "New response object name").Action(c.NewName.Set).StringVar(&c.NewName.Value)
+	c.CmdClause.Flag("status", "The HTTP status code").Action(c.Status.Set).UintVar(&c.Status.Value)
+	c.CmdClause.Flag("response", "The HTTP response reason phrase (e.g. OK)").Action(c.Response.Set).StringVar(&c.Response.Value)
+	c.CmdClause.Flag("content", "The content to deliver for the response object, passed as file path or content, e.g. $(< page.html)").Action(c.Content.Set).StringVar(&c.Content.Value)
+	c.CmdClause.Flag("content-type", "The MIME type of the content").Action(c.ContentType.Set).StringVar(&c.ContentType.Value)
+	c.CmdClause.Flag("request-condition", "Condition which, if met, will select this response object during a request").Action(c.RequestCondition.Set).StringVar(&c.RequestCondition.Value)
+	c.CmdClause.Flag("cache-condition", "Condition which, if met, will select this response object during a cache lookup").Action(c.CacheCondition.Set).StringVar(&c.CacheCondition.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	if c.NewName.WasSet {
+		c.input.NewName = fastly.String(c.NewName.Value)
+	}
+
+	if c.Status.WasSet {
+		c.input.Status = fastly.Uint(c.Status.Value)
+	}
+
+	if c.Response.WasSet {
+		c.input.Response = fastly.String(c.Response.Value)
+	}
+
+	if c.Content.WasSet {
+		c.input.Content = fastly.String(cmd.Content(c.Content.Value))
+	}
+
+	if c.ContentType.WasSet {
+		c.input.ContentType = fastly.String(c.ContentType.Value)
+	}
+
+	if c.RequestCondition.WasSet {
+		c.input.RequestCondition = fastly.String(c.RequestCondition.Value)
+	}
+
+	if c.CacheCondition.WasSet {
+		c.input.CacheCondition = fastly.String(c.CacheCondition.Value)
+	}
+
+	ro, err := c.Globals.Client.UpdateResponseObject(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated response object %s (service %s version %d)", ro.Name, ro.ServiceID, ro.ServiceVersion)
+	return nil
+}
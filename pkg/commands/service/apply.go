@@ -0,0 +1,142 @@
+package service
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/commands/service/snapshot"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/undo"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ApplyCommand calls the Fastly API to make a service version match a
+// declarative service definition file.
+type ApplyCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+	file           string
+	activate       bool
+}
+
+// NewApplyCommand returns a usable command registered under the parent.
+func NewApplyCommand(parent cmd.Registerer, globals *config.Data) *ApplyCommand {
+	var c ApplyCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("apply", "Update a Fastly service version to match a service definition file")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("file", "Path to a TOML or JSON service definition (e.g. as created by the service export command)").Required().StringVar(&c.file)
+	c.CmdClause.Flag("activate", "Activate the service version once the changes have been applied").BoolVar(&c.activate)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ApplyCommand) Exec(in io.Reader, out io.Writer) (err error) {
+	desired, err := readDefinition(c.file)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return err
+	}
+
+	// The service ID within the definition file is used unless the --service-id
+	// flag is provided.
+	if c.manifest.Flag.ServiceID == "" {
+		c.manifest.Flag.ServiceID = desired.ServiceID
+	}
+
+	// NOTE: we don't use --autoclone until we know there are changes to apply,
+	// as otherwise we'd create a redundant service version.
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	current, err := snapshot.Take(c.Globals.Client, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	changes := snapshot.Plan(current, desired)
+	printPlan(out, serviceID, serviceVersion.Number, changes)
+	if len(changes) == 0 {
+		return nil
+	}
+
+	serviceVersion, err = c.autoClone.Parse(serviceVersion, serviceID, c.Globals.Verbose(), out, c.Globals.Client)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+		})
+		return err
+	}
+
+	text.Break(out)
+	progress := text.NewQuietProgress(out)
+	undoStack := undo.NewStack()
+
+	defer func(errLog errors.LogInterface) {
+		if err != nil {
+			errLog.Add(err)
+			progress.Fail() // progress.Done is handled inline
+		}
+		undoStack.RunIfError(out, err)
+	}(c.Globals.ErrLog)
+
+	for _, change := range changes {
+		progress.Step(fmt.Sprintf("Applying: %s %s '%s'...", change.Op, change.Type, change.Name))
+		if err = change.Apply(c.Globals.Client, serviceID, serviceVersion.Number, undoStack); err != nil {
+			return err
+		}
+	}
+
+	if c.activate {
+		progress.Step(fmt.Sprintf("Activating version %d...", serviceVersion.Number))
+		_, err = c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+		})
+		if err != nil {
+			return fmt.Errorf("error activating version: %w", err)
+		}
+	}
+
+	progress.Done()
+	text.Break(out)
+	text.Success(out, "Applied %d changes to service %s version %d", len(changes), serviceID, serviceVersion.Number)
+	if c.activate {
+		text.Success(out, "Activated service %s version %d", serviceID, serviceVersion.Number)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/commands/service/snapshot"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// ExportCommand calls the Fastly API to export the configuration of a service
+// version as a declarative TOML or JSON document.
+type ExportCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+	file           string
+	format         string
+}
+
+// NewExportCommand returns a usable command registered under the parent.
+func NewExportCommand(parent cmd.Registerer, globals *config.Data) *ExportCommand {
+	var c ExportCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("export", "Export the configuration of a Fastly service version to a TOML or JSON file")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.CmdClause.Flag("file", "Path of the file to write the configuration to (defaults to stdout)").StringVar(&c.file)
+	c.CmdClause.Flag("format", "Format of the exported configuration (toml, json), defaults to the --file extension or toml").EnumVar(&c.format, snapshot.FormatTOML, snapshot.FormatJSON)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ExportCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	s, err := snapshot.Take(c.Globals.Client, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	var buf bytes.Buffer
+	if err := s.Encode(&buf, c.outputFormat()); err != nil {
+		c.Globals.ErrLog.Add(err)
+		return fmt.Errorf("error encoding service configuration: %w", err)
+	}
+
+	if c.file == "" {
+		_, err := buf.WriteTo(out)
+		return err
+	}
+
+	// The configuration includes the credentials of logging endpoints, so the
+	// file is only readable by the user.
+	if err := os.WriteFile(c.file, buf.Bytes(), 0600); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return fmt.Errorf("error writing service configuration: %w", err)
+	}
+
+	text.Success(out, "Exported service %s version %d to %s", serviceID, serviceVersion.Number, c.file)
+	return nil
+}
+
+// outputFormat determines the format to encode the configuration with.
+//
+// An explicit --format takes precedence, followed by the global --json flag,
+// and then the extension of the --file path.
+func (c *ExportCommand) outputFormat() string {
+	switch {
+	case c.format != "":
+		return c.format
+	case c.JSONOutput():
+		return snapshot.FormatJSON
+	case strings.EqualFold(filepath.Ext(c.file), ".json"):
+		return snapshot.FormatJSON
+	}
+	return snapshot.FormatTOML
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/commands/service/snapshot"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// PlanCommand calls the Fastly API to compare a service version with a
+// declarative service definition file.
+type PlanCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+	file           string
+}
+
+// NewPlanCommand returns a usable command registered under the parent.
+func NewPlanCommand(parent cmd.Registerer, globals *config.Data) *PlanCommand {
+	var c PlanCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("plan", "Show the changes required for a Fastly service version to match a service definition file")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.CmdClause.Flag("file", "Path to a TOML or JSON service definition (e.g. as created by the service export command)").Required().StringVar(&c.file)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *PlanCommand) Exec(in io.Reader, out io.Writer) error {
+	desired, err := readDefinition(c.file)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"File": c.file,
+		})
+		return err
+	}
+
+	// The service ID within the definition file is used unless the --service-id
+	// flag is provided.
+	if c.manifest.Flag.ServiceID == "" {
+		c.manifest.Flag.ServiceID = desired.ServiceID
+	}
+
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	current, err := snapshot.Take(c.Globals.Client, serviceID, serviceVersion.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	changes := snapshot.Plan(current, desired)
+
+	if c.JSONOutput() {
+		return c.WriteJSON(out, newPlanOutput(serviceID, serviceVersion.Number, changes))
+	}
+
+	printPlan(out, serviceID, serviceVersion.Number, changes)
+	return nil
+}
+
+// planOutput is the JSON representation of a plan.
+type planOutput struct {
+	ServiceID string
+	Version   int
+	Changes   []snapshot.Change
+}
+
+func newPlanOutput(serviceID string, version int, changes []snapshot.Change) planOutput {
+	if changes == nil {
+		changes = []snapshot.Change{}
+	}
+	return planOutput{
+		ServiceID: serviceID,
+		Version:   version,
+		Changes:   changes,
+	}
+}
+
+// readDefinition reads a service definition file, using the file extension to
+// determine whether it's JSON or TOML.
+func readDefinition(path string) (*snapshot.Snapshot, error) {
+	// gosec flagged this:
+	// G304 (CWE-22): Potential file inclusion via variable
+	// Disabling as we trust the source of the filepath variable.
+	/* #nosec */
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading service definition: %w", err)
+	}
+	defer f.Close() // #nosec G307
+
+	format := snapshot.FormatTOML
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		format = snapshot.FormatJSON
+	}
+
+	s, err := snapshot.Decode(f, format)
+	if err != nil {
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("error parsing service definition: %w", err),
+			Remediation: "Use `fastly service export` to see the expected structure of a service definition.",
+		}
+	}
+	return s, nil
+}
+
+// printPlan displays the changes in a summarised format.
+func printPlan(out io.Writer, serviceID string, version int, changes []snapshot.Change) {
+	fmt.Fprintf(out, "\nService %s, version %d\n\n", serviceID, version)
+
+	if len(changes) == 0 {
+		text.Info(out, "No changes. The service version matches the service definition.")
+		return
+	}
+
+	var create, update, del int
+	for _, c := range changes {
+		switch c.Op {
+		case snapshot.OpCreate:
+			fmt.Fprintf(out, "+ create %s '%s'\n", c.Type, c.Name)
+			create++
+		case snapshot.OpUpdate:
+			fmt.Fprintf(out, "~ update %s '%s' (%s)\n", c.Type, c.Name, strings.Join(c.Fields, ", "))
+			update++
+		case snapshot.OpDelete:
+			fmt.Fprintf(out, "- delete %s '%s'\n", c.Type, c.Name)
+			del++
+		}
+	}
+
+	text.Break(out)
+	text.Info(out, "Plan: %d to create, %d to update, %d to delete.", create, update, del)
+
+	for _, c := range changes {
+		if c.Versionless() {
+			text.Warning(out, "Dictionary items and ACL entries aren't versioned, so changes to them take effect as soon as they're applied, whether or not the service version is activated.")
+			break
+		}
+	}
+}
@@ -3,9 +3,12 @@ package service_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
+	"runtime"
 	"strings"
 	"testing"
 
@@ -379,6 +382,422 @@ func TestServiceDelete(t *testing.T) {
 	}
 }
 
+func TestServiceExport(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput []string
+		wantFile   []string
+	}{
+		{
+			args:      args("service export --service-id 123"),
+			wantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			args:      args("service export --version 1"),
+			wantError: "error reading service: no service ID found",
+		},
+		{
+			args: args("service export --service-id 123 --version 1"),
+			api:  exportAPI(),
+			wantOutput: []string{
+				"service_id = \"123\"\nversion = 1\n",
+				"[[acl]]\n  name = \"blocklist\"\n\n  [[acl.entries]]\n    comment = \"\"\n    ip = \"192.0.2.0\"\n    negated = false\n    subnet = 24\n",
+				"[[backend]]\n  address = \"example.com\"\n",
+				// A backend's hostname is derived from its address.
+				"  healthcheck = \"\"\n  max_conn = 0\n",
+				"  port = 443\n",
+				"[[dictionary]]\n  name = \"redirects\"\n  write_only = false\n\n  [dictionary.items]\n    \"/old\" = \"/new\"\n",
+				"[[domain]]\n  comment = \"\"\n  name = \"www.example.com\"\n",
+				"[logging]\n\n  [[logging.s3]]\n",
+				"    bucket_name = \"logs\"\n",
+				"[[vcl]]\n  content = \"\"\"\nsub vcl_recv {\n  #FASTLY recv\n}\n\"\"\"\n  main = true\n  name = \"main\"\n",
+			},
+		},
+		{
+			args: args("service export --service-id 123 --version 1 --format json"),
+			api:  exportAPI(),
+			wantOutput: []string{
+				"\"service_id\": \"123\",",
+				"\"version\": 1",
+				"\"domain\": [\n    {\n      \"comment\": \"\",\n      \"name\": \"www.example.com\"\n    }\n  ],",
+				"\"items\": {\n        \"/old\": \"/new\"\n      },",
+			},
+		},
+		{
+			args:       args("service export --service-id 123 --version 1 --file service.json"),
+			api:        exportAPI(),
+			wantOutput: []string{"Exported service 123 version 1 to service.json"},
+			wantFile:   []string{"\"service_id\": \"123\","},
+		},
+		{
+			args: args("service export --service-id 123 --version 1"),
+			api: testutil.EmptyResourceLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListBackendsFn: func(*fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+					return nil, errTest
+				},
+			}),
+			wantError: "error listing backend resources: fixture error",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			// We're going to chdir to an temp environment,
+			// so save the PWD to return to, afterwards.
+			pwd, err := os.Getwd()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rootdir := testutil.NewEnv(testutil.EnvOpts{T: t})
+			defer os.RemoveAll(rootdir)
+
+			if err := os.Chdir(rootdir); err != nil {
+				t.Fatal(err)
+			}
+			defer os.Chdir(pwd)
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err = app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if len(testcase.wantFile) > 0 {
+				b, err := os.ReadFile(filepath.Join(rootdir, "service.json"))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if runtime.GOOS != "windows" {
+					fi, err := os.Stat(filepath.Join(rootdir, "service.json"))
+					if err != nil {
+						t.Fatal(err)
+					}
+					testutil.AssertEqual(t, os.FileMode(0600), fi.Mode().Perm())
+				}
+				for _, s := range testcase.wantFile {
+					testutil.AssertStringContains(t, string(b), s)
+				}
+			}
+		})
+	}
+}
+
+func TestServicePlan(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		definition string
+		wantError  string
+		wantOutput []string
+	}{
+		{
+			args:      args("service plan --version 1"),
+			wantError: "error parsing arguments: required flag --file not provided",
+		},
+		{
+			args:      args("service plan --version 1 --file missing.toml"),
+			wantError: "error reading service definition",
+		},
+		{
+			args:       args("service plan --version 1 --file service.toml"),
+			definition: "service_id = \"123\"\n\n[[backends]]\n  name = \"origin\"\n",
+			wantError:  "error parsing service definition: unrecognised resource type: backends",
+		},
+		{
+			args:       args("service plan --version 1 --file service.toml"),
+			definition: "service_id = \"123\"\n\n[[backend]]\n  name = \"origin\"\n  overide_host = \"example.com\"\n  hostname = \"example.com\"\n",
+			wantError:  "error parsing service definition: unrecognised fields in backend 'origin': hostname, overide_host",
+		},
+		{
+			args:       args("service plan --version 1 --file service.toml"),
+			definition: "service_id = \"123\"\n\n[[domain]]\n  name = \"www.example.com\"\n  items = {}\n",
+			wantError:  "error parsing service definition: unrecognised fields in domain 'www.example.com': items",
+		},
+		{
+			args:       args("service plan --version 1 --file service.toml"),
+			api:        planAPI(nil),
+			definition: planDefinition,
+			wantOutput: []string{
+				"Service 123, version 1",
+				"+ create backend 'new'\n~ update backend 'origin' (port)\n- delete domain 'old.example.com'\n",
+				"Plan: 1 to create, 1 to update, 1 to delete.",
+			},
+		},
+		{
+			args:       args("service plan --service-id 456 --version 1 --file service.toml"),
+			api:        planAPI(nil),
+			definition: planDefinition,
+			wantOutput: []string{"Service 456, version 1"},
+		},
+		{
+			args:       args("service plan --version 1 --file service.toml --json"),
+			api:        planAPI(nil),
+			definition: planDefinition,
+			wantOutput: []string{
+				`{"ServiceID":"123","Version":1,"Changes":[{"Op":"create","Type":"backend","Name":"new"},{"Op":"update","Type":"backend","Name":"origin","Fields":["port"]},{"Op":"delete","Type":"domain","Name":"old.example.com"}]}`,
+			},
+		},
+		{
+			args:       args("service plan --version 1 --file service.json"),
+			api:        planAPI(nil),
+			definition: `{"service_id": "123", "domain": [{"name": "www.example.com"}, {"name": "old.example.com"}], "backend": [{"name": "origin", "address": "example.com", "port": 443}]}`,
+			wantOutput: []string{"No changes. The service version matches the service definition."},
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			stdout, err := runWithDefinition(t, testcase.args, testcase.api, testcase.definition)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout, s)
+			}
+		})
+	}
+}
+
+func TestServiceApply(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		definition string
+		wantError  string
+		wantOutput []string
+		wantCalls  []string
+	}{
+		{
+			args:       args("service apply --version 1 --file service.toml"),
+			definition: planDefinition,
+			wantError:  "service version 1 is not editable",
+		},
+		{
+			args:       args("service apply --version 1 --file service.toml --autoclone --activate"),
+			definition: planDefinition,
+			wantOutput: []string{
+				"Plan: 1 to create, 1 to update, 1 to delete.",
+				"Applied 3 changes to service 123 version 4",
+				"Activated service 123 version 4",
+			},
+			wantCalls: []string{
+				"CreateBackend 4 new new.example.com 443",
+				"UpdateBackend 4 origin 8443",
+				"DeleteDomain 4 old.example.com",
+				"ActivateVersion 4",
+			},
+		},
+		{
+			args:       args("service apply --version 3 --file service.toml"),
+			definition: planDefinition,
+			wantOutput: []string{"Applied 3 changes to service 123 version 3"},
+			wantCalls: []string{
+				"CreateBackend 3 new new.example.com 443",
+				"UpdateBackend 3 origin 8443",
+				"DeleteDomain 3 old.example.com",
+			},
+		},
+		{
+			args:       args("service apply --version 1 --file service.toml --autoclone"),
+			definition: "service_id = \"123\"\n\n[[backend]]\n  name = \"origin\"\n  port = 443\n\n[[domain]]\n  name = \"www.example.com\"\n\n[[domain]]\n  name = \"old.example.com\"\n",
+			wantOutput: []string{"No changes."},
+		},
+		{
+			args:       args("service apply --version 1 --file service.toml --autoclone"),
+			definition: planDefinition,
+			api: mock.API{
+				UpdateBackendFn: func(*fastly.UpdateBackendInput) (*fastly.Backend, error) {
+					return nil, errTest
+				},
+			},
+			wantError: "error updating backend 'origin': fixture error",
+			wantCalls: []string{
+				"CreateBackend 4 new new.example.com 443",
+				"DeleteBackend 4 new",
+			},
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var calls []string
+			api := planAPI(&calls)
+			if testcase.api.UpdateBackendFn != nil {
+				api.UpdateBackendFn = testcase.api.UpdateBackendFn
+			}
+
+			stdout, err := runWithDefinition(t, testcase.args, api, testcase.definition)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout, s)
+			}
+			testutil.AssertString(t, strings.Join(testcase.wantCalls, "\n"), strings.Join(calls, "\n"))
+		})
+	}
+}
+
+func TestServiceApplyVersionless(t *testing.T) {
+	definition := `service_id = "123"
+
+[[acl]]
+  name = "blocklist"
+
+  [[acl.entries]]
+    ip = "192.0.2.0"
+    subnet = 24
+
+[[dictionary]]
+  name = "redirects"
+
+  [dictionary.items]
+    "/a" = "/1"
+    "/b" = "/3"
+`
+	for _, testcase := range []struct {
+		name       string
+		batchErr   error
+		wantError  string
+		wantOutput []string
+		wantCalls  []string
+	}{
+		{
+			name: "applied",
+			wantOutput: []string{
+				"~ update dictionary 'redirects' (items)\n+ create acl 'blocklist'\n",
+				"Dictionary items and ACL entries aren't versioned",
+				"Applied 2 changes to service 123 version 3",
+			},
+			wantCalls: []string{
+				"BatchModifyDictionaryItems 456 [update /b=/3 delete /old=]",
+				"CreateACL 3 blocklist",
+				"BatchModifyACLEntries 789 [create 192.0.2.0/24]",
+			},
+		},
+		{
+			name:      "reverted",
+			batchErr:  errTest,
+			wantError: "error creating acl 'blocklist': error modifying entries: fixture error",
+			wantCalls: []string{
+				"BatchModifyDictionaryItems 456 [update /b=/3 delete /old=]",
+				"CreateACL 3 blocklist",
+				"BatchModifyACLEntries 789 [create 192.0.2.0/24]",
+				"DeleteACL 3 blocklist",
+				"BatchModifyDictionaryItems 456 [update /b=/2 create /old=/new]",
+			},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			var calls []string
+			api := testutil.EmptyResourceLists(mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDictionariesFn: func(i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) {
+					return []*fastly.Dictionary{
+						{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, ID: "456", Name: "redirects"},
+					}, nil
+				},
+				GetDictionaryFn: func(i *fastly.GetDictionaryInput) (*fastly.Dictionary, error) {
+					return &fastly.Dictionary{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, ID: "456", Name: i.Name}, nil
+				},
+				GetFn: func(path string, ro *fastly.RequestOptions) (*http.Response, error) {
+					if path == "/service/123/dictionary/456/items" {
+						return testutil.PaginatedGet([]*fastly.DictionaryItem{
+							{ItemKey: "/a", ItemValue: "/1"},
+							{ItemKey: "/b", ItemValue: "/2"},
+							{ItemKey: "/old", ItemValue: "/new"},
+						})(path, ro)
+					}
+					return testutil.PaginatedGet([]*fastly.ACLEntry{})(path, ro)
+				},
+				BatchModifyDictionaryItemsFn: func(i *fastly.BatchModifyDictionaryItemsInput) error {
+					var ops []string
+					for _, item := range i.Items {
+						ops = append(ops, fmt.Sprintf("%s %s=%s", item.Operation, item.ItemKey, item.ItemValue))
+					}
+					calls = append(calls, fmt.Sprintf("BatchModifyDictionaryItems %s %s", i.DictionaryID, ops))
+					return nil
+				},
+				CreateACLFn: func(i *fastly.CreateACLInput) (*fastly.ACL, error) {
+					calls = append(calls, fmt.Sprintf("CreateACL %d %s", i.ServiceVersion, i.Name))
+					return &fastly.ACL{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, ID: "789", Name: i.Name}, nil
+				},
+				DeleteACLFn: func(i *fastly.DeleteACLInput) error {
+					calls = append(calls, fmt.Sprintf("DeleteACL %d %s", i.ServiceVersion, i.Name))
+					return nil
+				},
+				BatchModifyACLEntriesFn: func(i *fastly.BatchModifyACLEntriesInput) error {
+					var ops []string
+					for _, e := range i.Entries {
+						ops = append(ops, fmt.Sprintf("%s %s/%d", e.Operation, *e.IP, *e.Subnet))
+					}
+					calls = append(calls, fmt.Sprintf("BatchModifyACLEntries %s %s", i.ACLID, ops))
+					return testcase.batchErr
+				},
+			})
+
+			stdout, err := runWithDefinition(t, testutil.Args("service apply --version 3 --file service.toml"), api, definition)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout, s)
+			}
+			testutil.AssertString(t, strings.Join(testcase.wantCalls, "\n"), strings.Join(calls, "\n"))
+		})
+	}
+}
+
+// runWithDefinition runs the CLI within a temporary directory, containing
+// the given service definition, as service.toml or service.json depending on
+// the --file flag.
+func runWithDefinition(t *testing.T, args []string, api mock.API, definition string) (string, error) {
+	// We're going to chdir to an temp environment,
+	// so save the PWD to return to, afterwards.
+	pwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	opts := testutil.EnvOpts{T: t}
+	if definition != "" {
+		name := "service.toml"
+		if strings.Contains(strings.Join(args, " "), "service.json") {
+			name = "service.json"
+		}
+		opts.Write = []testutil.FileIO{{Src: definition, Dst: name}}
+	}
+	rootdir := testutil.NewEnv(opts)
+	defer os.RemoveAll(rootdir)
+
+	if err := os.Chdir(rootdir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(pwd)
+
+	var stdout bytes.Buffer
+	runOpts := testutil.NewRunOpts(args, &stdout)
+	runOpts.APIClient = mock.APIClient(api)
+	err = app.Run(runOpts)
+	return stdout.String(), err
+}
+
+// planDefinition is a service definition which, compared to planAPI, creates
+// a backend, updates a backend's port and deletes a domain.
+var planDefinition = `service_id = "123"
+version = 1
+
+[[backend]]
+  address = "example.com"
+  name = "origin"
+  port = 8443
+
+[[backend]]
+  address = "new.example.com"
+  name = "new"
+  port = 443
+
+[[domain]]
+  name = "www.example.com"
+`
+
 var errTest = errors.New("fixture error")
 
 func createServiceOK(i *fastly.CreateServiceInput) (*fastly.Service, error) {
@@ -758,3 +1177,104 @@ func deleteServiceOK(*fastly.DeleteServiceInput) error {
 func deleteServiceError(*fastly.DeleteServiceInput) error {
 	return errTest
 }
+
+// exportAPI returns a mock API for a service version with a resource of most
+// types, where every other resource type is empty.
+func exportAPI() mock.API {
+	return testutil.EmptyResourceLists(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+			return []*fastly.Domain{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "www.example.com"},
+			}, nil
+		},
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			return []*fastly.Backend{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "example.com", Hostname: "example.com", Port: 443},
+			}, nil
+		},
+		ListDictionariesFn: func(i *fastly.ListDictionariesInput) ([]*fastly.Dictionary, error) {
+			return []*fastly.Dictionary{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, ID: "456", Name: "redirects"},
+			}, nil
+		},
+		ListACLsFn: func(i *fastly.ListACLsInput) ([]*fastly.ACL, error) {
+			return []*fastly.ACL{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, ID: "789", Name: "blocklist"},
+			}, nil
+		},
+		GetFn: func(path string, ro *fastly.RequestOptions) (*http.Response, error) {
+			switch path {
+			case "/service/123/dictionary/456/items":
+				return testutil.PaginatedGet([]*fastly.DictionaryItem{
+					{ServiceID: "123", DictionaryID: "456", ItemKey: "/old", ItemValue: "/new"},
+				})(path, ro)
+			case "/service/123/acl/789/entries":
+				return testutil.PaginatedGet([]*fastly.ACLEntry{
+					{ServiceID: "123", ACLID: "789", ID: "1", IP: "192.0.2.0", Subnet: 24},
+				})(path, ro)
+			}
+			return nil, fmt.Errorf("unexpected path: %s", path)
+		},
+		ListVCLsFn: func(i *fastly.ListVCLsInput) ([]*fastly.VCL, error) {
+			return []*fastly.VCL{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "main", Main: true, Content: "sub vcl_recv {\n  #FASTLY recv\n}\n"},
+			}, nil
+		},
+		ListS3sFn: func(i *fastly.ListS3sInput) ([]*fastly.S3, error) {
+			return []*fastly.S3{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "logs", BucketName: "logs"},
+			}, nil
+		},
+	})
+}
+
+// planAPI returns a mock API for a service version with two domains and a
+// backend, where every other resource type is empty. The calls that modify
+// resources are recorded into calls (if not nil).
+func planAPI(calls *[]string) mock.API {
+	record := func(format string, args ...interface{}) {
+		if calls != nil {
+			*calls = append(*calls, fmt.Sprintf(format, args...))
+		}
+	}
+	return testutil.EmptyResourceLists(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		CloneVersionFn: testutil.CloneVersionResult(4),
+		ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+			return []*fastly.Domain{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "www.example.com"},
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "old.example.com"},
+			}, nil
+		},
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			return []*fastly.Backend{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "example.com", Hostname: "example.com", Port: 443},
+			}, nil
+		},
+		CreateBackendFn: func(i *fastly.CreateBackendInput) (*fastly.Backend, error) {
+			record("CreateBackend %d %s %s %d", i.ServiceVersion, i.Name, i.Address, i.Port)
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name}, nil
+		},
+		UpdateBackendFn: func(i *fastly.UpdateBackendInput) (*fastly.Backend, error) {
+			record("UpdateBackend %d %s %d", i.ServiceVersion, i.Name, *i.Port)
+			return &fastly.Backend{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name}, nil
+		},
+		DeleteBackendFn: func(i *fastly.DeleteBackendInput) error {
+			record("DeleteBackend %d %s", i.ServiceVersion, i.Name)
+			return nil
+		},
+		CreateDomainFn: func(i *fastly.CreateDomainInput) (*fastly.Domain, error) {
+			record("CreateDomain %d %s", i.ServiceVersion, i.Name)
+			return &fastly.Domain{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: i.Name}, nil
+		},
+		DeleteDomainFn: func(i *fastly.DeleteDomainInput) error {
+			record("DeleteDomain %d %s", i.ServiceVersion, i.Name)
+			return nil
+		},
+		ActivateVersionFn: func(i *fastly.ActivateVersionInput) (*fastly.Version, error) {
+			record("ActivateVersion %d", i.ServiceVersion)
+			return &fastly.Version{ServiceID: i.ServiceID, Number: i.ServiceVersion}, nil
+		},
+	})
+}
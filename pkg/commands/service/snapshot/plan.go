@@ -0,0 +1,368 @@
+package snapshot
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/undo"
+)
+
+// Operations performed by a Change.
+const (
+	OpCreate = "create"
+	OpUpdate = "update"
+	OpDelete = "delete"
+)
+
+// dependencies are the resource types that other resources refer to by name
+// (e.g. a backend's healthcheck and request_condition), so they're created
+// and updated before, and deleted after, every other resource type.
+var dependencies = []string{"condition", "healthcheck"}
+
+// Change describes an operation required to reconcile a service version with
+// the desired snapshot.
+type Change struct {
+	Op   string
+	Type string
+	Name string
+
+	// Fields are the names of the fields that differ (updates only).
+	Fields []string `json:",omitempty"`
+
+	// Desired is the desired state of the resource (creates and updates).
+	Desired Resource `json:"-"`
+
+	// Current is the current state of the resource (updates and deletes).
+	Current Resource `json:"-"`
+}
+
+// Plan computes the changes required for the current snapshot to match the
+// desired snapshot.
+//
+// Fields omitted from a desired resource are left unchanged, which allows a
+// declarative file to only define the fields it cares about. When a desired
+// dictionary defines its items, or an ACL its entries, they replace the
+// current items or entries.
+//
+// Creates and updates are ordered so that referenced resources exist before
+// the resources that refer to them, and deletes follow in the reverse order.
+func Plan(current, desired *Snapshot) []Change {
+	var changes []Change
+	var deletes [][]Change
+
+	for _, rt := range applyOrder() {
+		cur := byName(current.Resources[rt.Name])
+		des := byName(desired.Resources[rt.Name])
+
+		names := make([]string, 0, len(cur)+len(des))
+		for name := range cur {
+			names = append(names, name)
+		}
+		for name := range des {
+			if _, ok := cur[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		var del []Change
+		for _, name := range names {
+			c, okC := cur[name]
+			d, okD := des[name]
+
+			switch {
+			case !okC:
+				changes = append(changes, Change{Op: OpCreate, Type: rt.Name, Name: name, Desired: d})
+			case !okD:
+				del = append(del, Change{Op: OpDelete, Type: rt.Name, Name: name, Current: c})
+			default:
+				if fields := changedFields(c, d); len(fields) > 0 {
+					changes = append(changes, Change{Op: OpUpdate, Type: rt.Name, Name: name, Fields: fields, Current: c, Desired: d})
+				}
+			}
+		}
+		deletes = append(deletes, del)
+	}
+
+	for i := len(deletes) - 1; i >= 0; i-- {
+		changes = append(changes, deletes[i]...)
+	}
+
+	return changes
+}
+
+// applyOrder returns the resource types in the order their creates and
+// updates are applied.
+func applyOrder() []ResourceType {
+	order := make([]ResourceType, 0, len(ResourceTypes))
+	for _, name := range dependencies {
+		rt, _ := LookupResourceType(name)
+		order = append(order, rt)
+	}
+	for _, rt := range ResourceTypes {
+		if !isDependency(rt.Name) {
+			order = append(order, rt)
+		}
+	}
+	return order
+}
+
+func isDependency(name string) bool {
+	for _, d := range dependencies {
+		if d == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Versionless reports whether the change modifies dictionary items or ACL
+// entries, which take effect immediately rather than when the service version
+// is activated.
+func (c Change) Versionless() bool {
+	switch c.Op {
+	case OpCreate:
+		return hasContents(c.Desired)
+	case OpUpdate:
+		for _, f := range c.Fields {
+			if versionlessFields[f] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// byName indexes resources by their name.
+func byName(rs []Resource) map[string]Resource {
+	m := make(map[string]Resource, len(rs))
+	for _, r := range rs {
+		m[r.Name()] = r
+	}
+	return m
+}
+
+// changedFields returns the sorted names of the fields defined in the desired
+// resource whose value differs from the current resource.
+//
+// The items of a write-only dictionary can't be read, and so they're only
+// compared when the current resource has them.
+func changedFields(current, desired Resource) []string {
+	var fields []string
+	for k, v := range desired {
+		if versionlessFields[k] {
+			cv, ok := current[k]
+			if ok && !contentsEqual(k, cv, v) {
+				fields = append(fields, k)
+			}
+			continue
+		}
+		if !reflect.DeepEqual(current[k], v) {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+	return fields
+}
+
+// Apply performs the change against the given service version, pushing the
+// inverse operation onto the undo stack so that the change can be reverted if
+// a later change fails.
+func (c Change) Apply(client api.Interface, serviceID string, version int, undoStack undo.Stacker) error {
+	rt, ok := LookupResourceType(c.Type)
+	if !ok {
+		return fmt.Errorf("unrecognised resource type: %s", c.Type)
+	}
+
+	switch c.Op {
+	case OpCreate:
+		if err := create(client, rt, serviceID, version, c.Name, c.Desired, undoStack); err != nil {
+			return fmt.Errorf("error creating %s '%s': %w", c.Type, c.Name, err)
+		}
+	case OpUpdate:
+		desired, previous := make(Resource), make(Resource)
+		var contents []string
+		for _, f := range c.Fields {
+			if versionlessFields[f] {
+				contents = append(contents, f)
+				continue
+			}
+			desired[f] = c.Desired[f]
+			previous[f] = c.Current[f]
+		}
+		if len(desired) > 0 {
+			if _, err := call(client, "Update"+rt.Kind, serviceID, version, c.Name, desired, true); err != nil {
+				return fmt.Errorf("error updating %s '%s': %w", c.Type, c.Name, err)
+			}
+			undoStack.Push(func() error {
+				_, err := call(client, "Update"+rt.Kind, serviceID, version, c.Name, previous, true)
+				return err
+			})
+		}
+		if len(contents) > 0 {
+			id, err := lookupID(client, rt, serviceID, version, c.Name)
+			if err == nil {
+				for _, f := range contents {
+					if err = applyContents(client, serviceID, id, f, c.Current[f], c.Desired[f], undoStack); err != nil {
+						break
+					}
+				}
+			}
+			if err != nil {
+				return fmt.Errorf("error updating %s '%s': %w", c.Type, c.Name, err)
+			}
+		}
+	case OpDelete:
+		if _, err := call(client, "Delete"+rt.Kind, serviceID, version, c.Name, nil, false); err != nil {
+			return fmt.Errorf("error deleting %s '%s': %w", c.Type, c.Name, err)
+		}
+		undoStack.Push(func() error {
+			// An undo isn't itself undone, so its undo operations are discarded.
+			return create(client, rt, serviceID, version, c.Name, c.Current, undo.NewStack())
+		})
+	}
+
+	return nil
+}
+
+// create creates a resource, along with its dictionary items or ACL entries.
+func create(client api.Interface, rt ResourceType, serviceID string, version int, name string, r Resource, undoStack undo.Stacker) error {
+	created, err := call(client, "Create"+rt.Kind, serviceID, version, name, r, false)
+	if err != nil {
+		return err
+	}
+	undoStack.Push(func() error {
+		_, err := call(client, "Delete"+rt.Kind, serviceID, version, name, nil, false)
+		return err
+	})
+
+	for f := range versionlessFields {
+		if v, ok := r[f]; ok {
+			if err := applyContents(client, serviceID, resourceID(created), f, nil, v, undoStack); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// lookupID returns the ID of the named resource (e.g. a dictionary's ID).
+func lookupID(client api.Interface, rt ResourceType, serviceID string, version int, name string) (string, error) {
+	r, err := call(client, "Get"+rt.Kind, serviceID, version, name, nil, false)
+	if err != nil {
+		return "", err
+	}
+	return resourceID(r), nil
+}
+
+// resourceID returns the ID field of a go-fastly API struct.
+func resourceID(v interface{}) string {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return ""
+	}
+	if f := rv.FieldByName("ID"); f.IsValid() && f.Kind() == reflect.String {
+		return f.String()
+	}
+	return ""
+}
+
+// call invokes the named api.Interface method (e.g. CreateBackend) with an
+// input struct populated from the given resource, and returns its result.
+//
+// The input struct fields are matched to the resource fields using their form
+// tags, which mirror the field names used by the Fastly API. When updating, a
+// resource's name field is never set as that would rename the resource.
+func call(client api.Interface, method, serviceID string, version int, name string, r Resource, update bool) (interface{}, error) {
+	m := reflect.ValueOf(client).MethodByName(method)
+	if !m.IsValid() || m.Type().NumIn() != 1 || m.Type().In(0).Kind() != reflect.Ptr {
+		return nil, fmt.Errorf("unsupported API operation: %s", method)
+	}
+
+	input := reflect.New(m.Type().In(0).Elem())
+	v := input.Elem()
+
+	for field, value := range map[string]interface{}{
+		"ServiceID":      serviceID,
+		"ServiceVersion": version,
+		"Name":           name,
+	} {
+		if f := v.FieldByName(field); f.IsValid() && f.CanSet() && f.Kind() == reflect.TypeOf(value).Kind() {
+			f.Set(reflect.ValueOf(value))
+		}
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key := strings.Split(t.Field(i).Tag.Get("form"), ",")[0]
+		if key == "" || (update && key == "name") {
+			continue
+		}
+		value, ok := r[key]
+		if !ok {
+			continue
+		}
+		if err := setValue(v.Field(i), value); err != nil {
+			return nil, fmt.Errorf("invalid value for '%s': %w", key, err)
+		}
+	}
+
+	out := m.Call([]reflect.Value{input})
+	if err, ok := out[len(out)-1].Interface().(error); ok && err != nil {
+		return nil, err
+	}
+	if len(out) > 1 {
+		return out[0].Interface(), nil
+	}
+	return nil, nil
+}
+
+// setValue assigns a resource value to an input struct field, converting
+// between the (limited) set of types used by resources and the field's type.
+func setValue(f reflect.Value, value interface{}) error {
+	if f.Kind() == reflect.Ptr {
+		p := reflect.New(f.Type().Elem())
+		if err := setValue(p.Elem(), value); err != nil {
+			return err
+		}
+		f.Set(p)
+		return nil
+	}
+
+	switch f.Kind() {
+	case reflect.String:
+		if s, ok := value.(string); ok {
+			f.SetString(s)
+			return nil
+		}
+	case reflect.Bool:
+		if b, ok := value.(bool); ok {
+			f.SetBool(b)
+			return nil
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if n, ok := value.(int64); ok {
+			f.SetInt(n)
+			return nil
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if n, ok := value.(int64); ok && n >= 0 {
+			f.SetUint(uint64(n))
+			return nil
+		}
+	case reflect.Slice:
+		if ss, ok := value.([]string); ok && f.Type().Elem().Kind() == reflect.String {
+			v := reflect.MakeSlice(f.Type(), len(ss), len(ss))
+			for i, s := range ss {
+				v.Index(i).SetString(s)
+			}
+			f.Set(v)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unexpected %T", value)
+}
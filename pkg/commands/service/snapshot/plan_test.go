@@ -0,0 +1,98 @@
+package snapshot_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/commands/service/snapshot"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestPlanOrder(t *testing.T) {
+	current := &snapshot.Snapshot{
+		Resources: map[string][]snapshot.Resource{
+			"backend": {
+				{"name": "origin", "healthcheck": "old-hc", "request_condition": "old-cond"},
+				{"name": "unused", "request_condition": "old-cond"},
+			},
+			"condition":   {{"name": "old-cond", "statement": "req.url ~ \"^/old\""}},
+			"healthcheck": {{"name": "old-hc", "path": "/old"}},
+		},
+	}
+	desired := &snapshot.Snapshot{
+		Resources: map[string][]snapshot.Resource{
+			"backend": {
+				{"name": "origin", "healthcheck": "hc", "request_condition": "cond"},
+			},
+			"condition":   {{"name": "cond", "statement": "req.url ~ \"^/new\""}},
+			"header":      {{"name": "h", "request_condition": "cond"}},
+			"healthcheck": {{"name": "hc", "path": "/new"}},
+		},
+	}
+
+	var have []string
+	for _, c := range snapshot.Plan(current, desired) {
+		have = append(have, fmt.Sprintf("%s %s %s", c.Op, c.Type, c.Name))
+	}
+	testutil.AssertString(t, strings.Join([]string{
+		"create condition cond",
+		"create healthcheck hc",
+		"update backend origin",
+		"create header h",
+		"delete backend unused",
+		"delete healthcheck old-hc",
+		"delete condition old-cond",
+	}, "\n"), strings.Join(have, "\n"))
+}
+
+func TestPlanContents(t *testing.T) {
+	current := &snapshot.Snapshot{
+		Resources: map[string][]snapshot.Resource{
+			"dictionary": {
+				{"name": "redirects", "items": map[string]interface{}{"/a": "/1", "/b": "/2"}},
+				{"name": "secrets", "write_only": true},
+				{"name": "unchanged", "items": map[string]interface{}{"port": "8080"}},
+			},
+			"acl": {
+				{"name": "blocklist", "entries": []map[string]interface{}{
+					{"ip": "192.0.2.0", "subnet": int64(24), "negated": false, "comment": ""},
+				}},
+				{"name": "allowlist", "entries": []map[string]interface{}{
+					{"ip": "192.0.2.1", "subnet": int64(0), "negated": false, "comment": ""},
+				}},
+			},
+		},
+	}
+	desired := &snapshot.Snapshot{
+		Resources: map[string][]snapshot.Resource{
+			"dictionary": {
+				{"name": "redirects", "items": map[string]interface{}{"/a": "/1", "/b": "/3", "/c": "/4"}},
+				{"name": "secrets", "write_only": true, "items": map[string]interface{}{"key": "value"}},
+				{"name": "unchanged", "items": map[string]interface{}{"port": int64(8080)}},
+				{"name": "new"},
+			},
+			"acl": {
+				// Decoded JSON numbers are float64 and optional fields may be omitted.
+				{"name": "blocklist", "entries": []interface{}{
+					map[string]interface{}{"ip": "192.0.2.0", "subnet": float64(24)},
+				}},
+				{"name": "allowlist", "entries": []interface{}{
+					map[string]interface{}{"ip": "192.0.2.1", "negated": true},
+				}},
+				{"name": "empty", "entries": []interface{}{}},
+			},
+		},
+	}
+
+	var have []string
+	for _, c := range snapshot.Plan(current, desired) {
+		have = append(have, fmt.Sprintf("%s %s %s %v %t", c.Op, c.Type, c.Name, c.Fields, c.Versionless()))
+	}
+	testutil.AssertString(t, strings.Join([]string{
+		"create dictionary new [] false",
+		"update dictionary redirects [items] true",
+		"update acl allowlist [entries] true",
+		"create acl empty [] false",
+	}, "\n"), strings.Join(have, "\n"))
+}
@@ -0,0 +1,527 @@
+// Package snapshot captures the configuration of a service version as a
+// declarative document that can be encoded as TOML or JSON.
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/go-fastly/v3/fastly"
+	toml "github.com/pelletier/go-toml"
+)
+
+// Supported encoding formats.
+const (
+	FormatTOML = "toml"
+	FormatJSON = "json"
+)
+
+// Lister lists every resource of a given type for a service version. The
+// returned value is a slice of go-fastly API structs.
+type Lister func(c api.Interface, serviceID string, version int) (interface{}, error)
+
+// ResourceType describes a type of versioned service resource.
+//
+// Logging endpoint names are prefixed with "logging/" which is reflected in
+// the encoded document as a nested [logging] table.
+//
+// Kind is the suffix of the api.Interface methods used to manage the
+// resource (e.g. CreateBackend, UpdateBackend and DeleteBackend).
+type ResourceType struct {
+	Name string
+	Kind string
+	List Lister
+}
+
+// ResourceTypes are all the versioned resource types that make up a service
+// version's configuration, in the order they should be displayed.
+var ResourceTypes = []ResourceType{
+	{Name: "domain", Kind: "Domain", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListDomains(&fastly.ListDomainsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "backend", Kind: "Backend", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListBackends(&fastly.ListBackendsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "healthcheck", Kind: "HealthCheck", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListHealthChecks(&fastly.ListHealthChecksInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "condition", Kind: "Condition", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListConditions(&fastly.ListConditionsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "header", Kind: "Header", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListHeaders(&fastly.ListHeadersInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "cache_setting", Kind: "CacheSetting", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListCacheSettings(&fastly.ListCacheSettingsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "request_setting", Kind: "RequestSetting", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListRequestSettings(&fastly.ListRequestSettingsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "response_object", Kind: "ResponseObject", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListResponseObjects(&fastly.ListResponseObjectsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "gzip", Kind: "Gzip", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListGzips(&fastly.ListGzipsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "dictionary", Kind: "Dictionary", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListDictionaries(&fastly.ListDictionariesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "acl", Kind: "ACL", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListACLs(&fastly.ListACLsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "vcl", Kind: "VCL", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListVCLs(&fastly.ListVCLsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "snippet", Kind: "Snippet", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListSnippets(&fastly.ListSnippetsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/azureblob", Kind: "BlobStorage", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListBlobStorages(&fastly.ListBlobStoragesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/bigquery", Kind: "BigQuery", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListBigQueries(&fastly.ListBigQueriesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/cloudfiles", Kind: "Cloudfiles", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListCloudfiles(&fastly.ListCloudfilesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/datadog", Kind: "Datadog", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListDatadog(&fastly.ListDatadogInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/digitalocean", Kind: "DigitalOcean", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListDigitalOceans(&fastly.ListDigitalOceansInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/elasticsearch", Kind: "Elasticsearch", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListElasticsearch(&fastly.ListElasticsearchInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/ftp", Kind: "FTP", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListFTPs(&fastly.ListFTPsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/gcs", Kind: "GCS", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListGCSs(&fastly.ListGCSsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/googlepubsub", Kind: "Pubsub", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListPubsubs(&fastly.ListPubsubsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/heroku", Kind: "Heroku", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListHerokus(&fastly.ListHerokusInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/honeycomb", Kind: "Honeycomb", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListHoneycombs(&fastly.ListHoneycombsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/https", Kind: "HTTPS", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListHTTPS(&fastly.ListHTTPSInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/kafka", Kind: "Kafka", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListKafkas(&fastly.ListKafkasInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/kinesis", Kind: "Kinesis", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListKinesis(&fastly.ListKinesisInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/logentries", Kind: "Logentries", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListLogentries(&fastly.ListLogentriesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/loggly", Kind: "Loggly", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListLoggly(&fastly.ListLogglyInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/logshuttle", Kind: "Logshuttle", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListLogshuttles(&fastly.ListLogshuttlesInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/newrelic", Kind: "NewRelic", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListNewRelic(&fastly.ListNewRelicInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/openstack", Kind: "Openstack", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListOpenstack(&fastly.ListOpenstackInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/papertrail", Kind: "Papertrail", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListPapertrails(&fastly.ListPapertrailsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/s3", Kind: "S3", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListS3s(&fastly.ListS3sInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/scalyr", Kind: "Scalyr", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListScalyrs(&fastly.ListScalyrsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/sftp", Kind: "SFTP", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListSFTPs(&fastly.ListSFTPsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/splunk", Kind: "Splunk", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListSplunks(&fastly.ListSplunksInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/sumologic", Kind: "Sumologic", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListSumologics(&fastly.ListSumologicsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+	{Name: "logging/syslog", Kind: "Syslog", List: func(c api.Interface, sid string, v int) (interface{}, error) {
+		return c.ListSyslogs(&fastly.ListSyslogsInput{ServiceID: sid, ServiceVersion: v})
+	}},
+}
+
+// Resource is the declarative representation of a single service resource,
+// keyed by the field names used by the Fastly API (e.g. "override_host").
+type Resource map[string]interface{}
+
+// Name returns the name of the resource.
+func (r Resource) Name() string {
+	name, _ := r["name"].(string)
+	return name
+}
+
+// Snapshot is the declarative representation of a service version.
+type Snapshot struct {
+	ServiceID string
+	Version   int
+
+	// Resources are keyed by ResourceType.Name.
+	Resources map[string][]Resource
+}
+
+// ignoredFields are fields of the go-fastly API structs that identify a
+// resource, describe its lifecycle, or are derived by the API (e.g. a backend's
+// hostname is derived from its address), rather than configure it.
+var ignoredFields = map[string]bool{
+	"ACLID":          true,
+	"CreatedAt":      true,
+	"DeletedAt":      true,
+	"DictionaryID":   true,
+	"Hostname":       true,
+	"ID":             true,
+	"ServiceID":      true,
+	"ServiceVersion": true,
+	"UpdatedAt":      true,
+}
+
+// Take fetches every versioned resource of the given service version,
+// including the (versionless) dictionary items and ACL entries.
+func Take(client api.Interface, serviceID string, version int) (*Snapshot, error) {
+	s := &Snapshot{
+		ServiceID: serviceID,
+		Version:   version,
+		Resources: make(map[string][]Resource),
+	}
+
+	for _, rt := range ResourceTypes {
+		list, err := rt.List(client, serviceID, version)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s resources: %w", rt.Name, err)
+		}
+
+		rv := reflect.ValueOf(list)
+		for i := 0; i < rv.Len(); i++ {
+			item := rv.Index(i).Interface()
+			r := ToResource(item)
+
+			switch v := item.(type) {
+			case *fastly.Dictionary:
+				// The items of a write-only dictionary can't be read.
+				if v.WriteOnly {
+					break
+				}
+				items, err := api.ListAllDictionaryItems(client, &fastly.ListDictionaryItemsInput{
+					ServiceID:    serviceID,
+					DictionaryID: v.ID,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("error listing items for dictionary '%s': %w", v.Name, err)
+				}
+				m := make(map[string]interface{})
+				for _, item := range items {
+					if item.DeletedAt == nil {
+						m[item.ItemKey] = item.ItemValue
+					}
+				}
+				r["items"] = m
+			case *fastly.ACL:
+				entries, err := api.ListAllACLEntries(client, &fastly.ListACLEntriesInput{
+					ServiceID: serviceID,
+					ACLID:     v.ID,
+				})
+				if err != nil {
+					return nil, fmt.Errorf("error listing entries for ACL '%s': %w", v.Name, err)
+				}
+				es := make([]map[string]interface{}, 0, len(entries))
+				for _, e := range entries {
+					if e.DeletedAt == nil {
+						es = append(es, ToResource(e))
+					}
+				}
+				r["entries"] = es
+			}
+
+			s.Resources[rt.Name] = append(s.Resources[rt.Name], r)
+		}
+	}
+
+	return s, nil
+}
+
+// ToResource converts a go-fastly API struct into a Resource, omitting the
+// fields that don't configure the resource.
+func ToResource(v interface{}) Resource {
+	r := make(Resource)
+
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return r
+	}
+
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if ignoredFields[f.Name] || f.PkgPath != "" {
+			continue
+		}
+
+		key := strings.Split(f.Tag.Get("mapstructure"), ",")[0]
+		if key == "" {
+			key = strings.ToLower(f.Name)
+		}
+
+		fv := rv.Field(i)
+		if fv.Kind() == reflect.Ptr {
+			if fv.IsNil() {
+				continue
+			}
+			fv = fv.Elem()
+		}
+
+		switch fv.Kind() {
+		case reflect.String:
+			r[key] = fv.String()
+		case reflect.Bool:
+			r[key] = fv.Bool()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			r[key] = fv.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			r[key] = int64(fv.Uint())
+		case reflect.Slice:
+			if fv.Type().Elem().Kind() == reflect.String {
+				ss := make([]string, fv.Len())
+				for j := range ss {
+					ss[j] = fv.Index(j).String()
+				}
+				r[key] = ss
+			}
+		}
+	}
+
+	return r
+}
+
+// document converts the snapshot into the generic structure that is encoded.
+func (s *Snapshot) document() map[string]interface{} {
+	doc := map[string]interface{}{
+		"service_id": s.ServiceID,
+		"version":    int64(s.Version),
+	}
+	logging := make(map[string]interface{})
+
+	for _, rt := range ResourceTypes {
+		rs := s.Resources[rt.Name]
+		if len(rs) == 0 {
+			continue
+		}
+		ms := make([]map[string]interface{}, 0, len(rs))
+		for _, r := range rs {
+			ms = append(ms, r)
+		}
+		if name := strings.TrimPrefix(rt.Name, "logging/"); name != rt.Name {
+			logging[name] = ms
+			continue
+		}
+		doc[rt.Name] = ms
+	}
+
+	if len(logging) > 0 {
+		doc["logging"] = logging
+	}
+	return doc
+}
+
+// Encode writes the snapshot to w in the given format.
+func (s *Snapshot) Encode(w io.Writer, format string) error {
+	doc := s.document()
+
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(doc)
+	case FormatTOML:
+		tree, err := toml.TreeFromMap(doc)
+		if err != nil {
+			return err
+		}
+		multiline(tree)
+		_, err = tree.WriteTo(w)
+		return err
+	}
+
+	return fmt.Errorf("unsupported format: %s", format)
+}
+
+// Decode reads a snapshot in the given format from r.
+func Decode(r io.Reader, format string) (*Snapshot, error) {
+	var doc map[string]interface{}
+
+	switch format {
+	case FormatJSON:
+		if err := json.NewDecoder(r).Decode(&doc); err != nil {
+			return nil, err
+		}
+	case FormatTOML:
+		tree, err := toml.LoadReader(r)
+		if err != nil {
+			return nil, err
+		}
+		doc = tree.ToMap()
+	default:
+		return nil, fmt.Errorf("unsupported format: %s", format)
+	}
+
+	s := &Snapshot{
+		Resources: make(map[string][]Resource),
+	}
+
+	if logging, ok := doc["logging"].(map[string]interface{}); ok {
+		delete(doc, "logging")
+		for k, v := range logging {
+			doc["logging/"+k] = v
+		}
+	}
+
+	for key, value := range doc {
+		switch key {
+		case "service_id":
+			s.ServiceID, _ = value.(string)
+			continue
+		case "version":
+			n, _ := normalise(value).(int64)
+			s.Version = int(n)
+			continue
+		}
+
+		rt, ok := LookupResourceType(key)
+		if !ok {
+			return nil, fmt.Errorf("unrecognised resource type: %s", strings.Replace(key, "/", ".", 1))
+		}
+		fields := rt.fields()
+
+		items, ok := value.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected a list of %s resources", key)
+		}
+		for _, item := range items {
+			m, ok := item.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("expected a list of %s resources", key)
+			}
+			r := make(Resource)
+			for k, v := range m {
+				r[k] = normalise(v)
+			}
+			if r.Name() == "" {
+				return nil, fmt.Errorf("a %s resource is missing a name", key)
+			}
+			var unknown []string
+			for k := range r {
+				if !fields[k] {
+					unknown = append(unknown, k)
+				}
+			}
+			if len(unknown) > 0 {
+				sort.Strings(unknown)
+				return nil, fmt.Errorf("unrecognised fields in %s '%s': %s", strings.Replace(key, "/", ".", 1), r.Name(), strings.Join(unknown, ", "))
+			}
+			s.Resources[key] = append(s.Resources[key], r)
+		}
+	}
+
+	return s, nil
+}
+
+// fields returns the names of the fields that configure a resource of this
+// type. They're the form tags of the inputs to its create and update API
+// operations, as used by call, plus a dictionary's items or an ACL's entries.
+//
+// A field that isn't one of these could never be applied, and so it would be
+// planned as a change forever.
+func (rt ResourceType) fields() map[string]bool {
+	fields := make(map[string]bool)
+
+	client := reflect.TypeOf((*api.Interface)(nil)).Elem()
+	for _, op := range []string{"Create", "Update"} {
+		m, ok := client.MethodByName(op + rt.Kind)
+		if !ok || m.Type.NumIn() != 1 || m.Type.In(0).Kind() != reflect.Ptr {
+			continue
+		}
+		t := m.Type.In(0).Elem()
+		for i := 0; i < t.NumField(); i++ {
+			if key := strings.Split(t.Field(i).Tag.Get("form"), ",")[0]; key != "" && key != "-" {
+				fields[key] = true
+			}
+		}
+	}
+
+	switch rt.Name {
+	case "dictionary":
+		fields["items"] = true
+	case "acl":
+		fields["entries"] = true
+	}
+	return fields
+}
+
+// LookupResourceType returns the resource type with the given name.
+func LookupResourceType(name string) (ResourceType, bool) {
+	for _, rt := range ResourceTypes {
+		if rt.Name == name {
+			return rt, true
+		}
+	}
+	return ResourceType{}, false
+}
+
+// normalise converts decoded values into the types produced by ToResource,
+// so that values decoded from different formats can be compared.
+func normalise(v interface{}) interface{} {
+	switch v := v.(type) {
+	case float64:
+		if v == float64(int64(v)) {
+			return int64(v)
+		}
+	case []interface{}:
+		ss := make([]string, 0, len(v))
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return v
+			}
+			ss = append(ss, s)
+		}
+		return ss
+	}
+	return v
+}
+
+// multiline ensures multi-line strings (e.g. VCL content) are written as TOML
+// multi-line strings so they remain readable and diff well.
+func multiline(tree *toml.Tree) {
+	for _, key := range tree.Keys() {
+		switch v := tree.GetPath([]string{key}).(type) {
+		case string:
+			if strings.Contains(v, "\n") {
+				tree.SetPathWithOptions([]string{key}, toml.SetOptions{Multiline: true}, v)
+			}
+		case *toml.Tree:
+			multiline(v)
+		case []*toml.Tree:
+			for _, t := range v {
+				multiline(t)
+			}
+		}
+	}
+}
@@ -0,0 +1,316 @@
+package snapshot
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/undo"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// versionlessFields are resource fields that aren't part of a service version
+// (i.e. dictionary items and ACL entries). They're modified with the batch
+// APIs, and changes to them take effect immediately.
+var versionlessFields = map[string]bool{
+	"entries": true,
+	"items":   true,
+}
+
+// hasContents reports whether a resource defines any dictionary items or ACL
+// entries.
+func hasContents(r Resource) bool {
+	for f := range versionlessFields {
+		if v, ok := r[f]; ok && !contentsEqual(f, nil, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// contentsEqual reports whether the current and desired values of a
+// versionless field are equivalent.
+func contentsEqual(field string, current, desired interface{}) bool {
+	switch field {
+	case "items":
+		ops, _ := itemOps(toItems(current), toItems(desired))
+		return len(ops) == 0
+	case "entries":
+		ops, _, _ := entryOps(toEntries(current), toEntries(desired))
+		return len(ops) == 0
+	}
+	return true
+}
+
+// applyContents replaces the items of a dictionary, or the entries of an ACL,
+// with the desired value of the versionless field.
+func applyContents(client api.Interface, serviceID, id, field string, current, desired interface{}, undoStack undo.Stacker) error {
+	switch field {
+	case "items":
+		return applyItems(client, serviceID, id, toItems(current), toItems(desired), undoStack)
+	case "entries":
+		return applyEntries(client, serviceID, id, toEntries(desired), undoStack)
+	}
+	return nil
+}
+
+// toItems converts a resource's items field into a map of keys to values.
+func toItems(v interface{}) map[string]string {
+	items := make(map[string]string)
+	if m, ok := v.(map[string]interface{}); ok {
+		for k, v := range m {
+			items[k] = fmt.Sprint(v)
+		}
+	}
+	return items
+}
+
+// itemOps returns the batch operations that make the current items match the
+// desired items, and the operations that revert them.
+func itemOps(current, desired map[string]string) (ops, inverse []*fastly.BatchDictionaryItem) {
+	keys := make([]string, 0, len(current)+len(desired))
+	for k := range current {
+		keys = append(keys, k)
+	}
+	for k := range desired {
+		if _, ok := current[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		cv, okC := current[k]
+		dv, okD := desired[k]
+		switch {
+		case !okC:
+			ops = append(ops, &fastly.BatchDictionaryItem{Operation: fastly.CreateBatchOperation, ItemKey: k, ItemValue: dv})
+			inverse = append(inverse, &fastly.BatchDictionaryItem{Operation: fastly.DeleteBatchOperation, ItemKey: k})
+		case !okD:
+			ops = append(ops, &fastly.BatchDictionaryItem{Operation: fastly.DeleteBatchOperation, ItemKey: k})
+			inverse = append(inverse, &fastly.BatchDictionaryItem{Operation: fastly.CreateBatchOperation, ItemKey: k, ItemValue: cv})
+		case cv != dv:
+			ops = append(ops, &fastly.BatchDictionaryItem{Operation: fastly.UpdateBatchOperation, ItemKey: k, ItemValue: dv})
+			inverse = append(inverse, &fastly.BatchDictionaryItem{Operation: fastly.UpdateBatchOperation, ItemKey: k, ItemValue: cv})
+		}
+	}
+	return ops, inverse
+}
+
+func applyItems(client api.Interface, serviceID, dictionaryID string, current, desired map[string]string, undoStack undo.Stacker) error {
+	ops, inverse := itemOps(current, desired)
+	if err := batchItems(client, serviceID, dictionaryID, ops); err != nil {
+		return fmt.Errorf("error modifying items: %w", err)
+	}
+	if len(ops) > 0 {
+		undoStack.Push(func() error {
+			return batchItems(client, serviceID, dictionaryID, inverse)
+		})
+	}
+	return nil
+}
+
+func batchItems(client api.Interface, serviceID, dictionaryID string, ops []*fastly.BatchDictionaryItem) error {
+	for start := 0; start < len(ops); start += fastly.BatchModifyMaximumOperations {
+		end := start + fastly.BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+		err := client.BatchModifyDictionaryItems(&fastly.BatchModifyDictionaryItemsInput{
+			ServiceID:    serviceID,
+			DictionaryID: dictionaryID,
+			Items:        ops[start:end],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// aclEntry is the comparable representation of an ACL entry. Entries are
+// identified by their IP address and subnet, as the API rejects duplicates.
+type aclEntry struct {
+	id      string
+	ip      string
+	subnet  int
+	negated bool
+	comment string
+}
+
+func (e aclEntry) key() string {
+	return fmt.Sprintf("%s/%d", e.ip, e.subnet)
+}
+
+// toEntries converts a resource's entries field into ACL entries.
+func toEntries(v interface{}) []aclEntry {
+	var ms []map[string]interface{}
+	switch v := v.(type) {
+	case []map[string]interface{}:
+		ms = v
+	case []interface{}:
+		for _, e := range v {
+			if m, ok := e.(map[string]interface{}); ok {
+				ms = append(ms, m)
+			}
+		}
+	}
+
+	entries := make([]aclEntry, 0, len(ms))
+	for _, m := range ms {
+		var e aclEntry
+		e.ip, _ = m["ip"].(string)
+		e.negated, _ = m["negated"].(bool)
+		e.comment, _ = m["comment"].(string)
+		switch n := m["subnet"].(type) {
+		case int64:
+			e.subnet = int(n)
+		case float64:
+			e.subnet = int(n)
+		case int:
+			e.subnet = n
+		}
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// entryOps returns the batch operations that make the current entries match
+// the desired entries, the operations that revert them, and the keys of the
+// entries that will be created (whose IDs are needed to revert them).
+func entryOps(current, desired []aclEntry) (ops, inverse []*fastly.BatchACLEntry, created []string) {
+	have := make(map[string]aclEntry, len(current))
+	for _, e := range current {
+		have[e.key()] = e
+	}
+	want := make(map[string]aclEntry, len(desired))
+	for _, e := range desired {
+		want[e.key()] = e
+	}
+
+	keys := make([]string, 0, len(have)+len(want))
+	for k := range have {
+		keys = append(keys, k)
+	}
+	for k := range want {
+		if _, ok := have[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		c, okC := have[k]
+		d, okD := want[k]
+		switch {
+		case !okC:
+			ops = append(ops, createEntryOp(d))
+			created = append(created, k)
+		case !okD:
+			ops = append(ops, &fastly.BatchACLEntry{Operation: fastly.DeleteBatchOperation, ID: fastly.String(c.id)})
+			inverse = append(inverse, createEntryOp(c))
+		case c.negated != d.negated || c.comment != d.comment:
+			ops = append(ops, &fastly.BatchACLEntry{
+				Operation: fastly.UpdateBatchOperation,
+				ID:        fastly.String(c.id),
+				Negated:   fastly.Bool(d.negated),
+				Comment:   fastly.String(d.comment),
+			})
+			inverse = append(inverse, &fastly.BatchACLEntry{
+				Operation: fastly.UpdateBatchOperation,
+				ID:        fastly.String(c.id),
+				Negated:   fastly.Bool(c.negated),
+				Comment:   fastly.String(c.comment),
+			})
+		}
+	}
+	return ops, inverse, created
+}
+
+func createEntryOp(e aclEntry) *fastly.BatchACLEntry {
+	op := &fastly.BatchACLEntry{
+		Operation: fastly.CreateBatchOperation,
+		IP:        fastly.String(e.ip),
+		Negated:   fastly.Bool(e.negated),
+		Comment:   fastly.String(e.comment),
+	}
+	if e.subnet > 0 {
+		op.Subnet = fastly.Int(e.subnet)
+	}
+	return op
+}
+
+// applyEntries makes the entries of an ACL match the desired entries. The
+// current entries are listed as their IDs are needed to update and delete
+// them.
+func applyEntries(client api.Interface, serviceID, aclID string, desired []aclEntry, undoStack undo.Stacker) error {
+	current, err := liveEntries(client, serviceID, aclID)
+	if err != nil {
+		return err
+	}
+
+	ops, inverse, created := entryOps(current, desired)
+	if err := batchEntries(client, serviceID, aclID, ops); err != nil {
+		return fmt.Errorf("error modifying entries: %w", err)
+	}
+	if len(ops) == 0 {
+		return nil
+	}
+
+	undoStack.Push(func() error {
+		if len(created) > 0 {
+			entries, err := liveEntries(client, serviceID, aclID)
+			if err != nil {
+				return err
+			}
+			keys := make(map[string]bool, len(created))
+			for _, k := range created {
+				keys[k] = true
+			}
+			for _, e := range entries {
+				if keys[e.key()] {
+					inverse = append(inverse, &fastly.BatchACLEntry{Operation: fastly.DeleteBatchOperation, ID: fastly.String(e.id)})
+				}
+			}
+		}
+		return batchEntries(client, serviceID, aclID, inverse)
+	})
+	return nil
+}
+
+// liveEntries returns every (non-deleted) entry of an ACL.
+func liveEntries(client api.Interface, serviceID, aclID string) ([]aclEntry, error) {
+	entries, err := api.ListAllACLEntries(client, &fastly.ListACLEntriesInput{
+		ServiceID: serviceID,
+		ACLID:     aclID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing entries: %w", err)
+	}
+
+	live := make([]aclEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.DeletedAt == nil {
+			live = append(live, aclEntry{id: e.ID, ip: e.IP, subnet: e.Subnet, negated: e.Negated, comment: e.Comment})
+		}
+	}
+	return live, nil
+}
+
+func batchEntries(client api.Interface, serviceID, aclID string, ops []*fastly.BatchACLEntry) error {
+	for start := 0; start < len(ops); start += fastly.BatchModifyMaximumOperations {
+		end := start + fastly.BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+		err := client.BatchModifyACLEntries(&fastly.BatchModifyACLEntriesInput{
+			ServiceID: serviceID,
+			ACLID:     aclID,
+			Entries:   ops[start:end],
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
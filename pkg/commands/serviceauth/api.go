@@ -0,0 +1,156 @@
+package serviceauth
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	fsttime "github.com/fastly/cli/pkg/time"
+	"github.com/fastly/cli/pkg/useragent"
+	"github.com/segmentio/textio"
+)
+
+// Permissions is the list of supported service authorization permissions.
+var Permissions = []string{"full", "read_only", "purge_select", "purge_all"}
+
+// ServiceAuthorization is a user's permission on a single service.
+//
+// NOTE: the go-fastly client doesn't yet support the service authorizations
+// API, so the commands in this package make the JSON:API requests directly.
+type ServiceAuthorization struct {
+	ID         string     `json:"id"`
+	Permission string     `json:"permission"`
+	ServiceID  string     `json:"service_id"`
+	UserID     string     `json:"user_id"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// resource models a service_authorization JSON:API resource object.
+type resource struct {
+	ID            string        `json:"id,omitempty"`
+	Type          string        `json:"type"`
+	Attributes    attributes    `json:"attributes"`
+	Relationships relationships `json:"relationships,omitempty"`
+}
+
+type attributes struct {
+	Permission string     `json:"permission,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+type relationships struct {
+	Service *relationship `json:"service,omitempty"`
+	User    *relationship `json:"user,omitempty"`
+}
+
+type relationship struct {
+	Data identifier `json:"data"`
+}
+
+type identifier struct {
+	ID   string `json:"id"`
+	Type string `json:"type"`
+}
+
+// toServiceAuthorization flattens a JSON:API resource object.
+func (r resource) toServiceAuthorization() *ServiceAuthorization {
+	sa := &ServiceAuthorization{
+		ID:         r.ID,
+		Permission: r.Attributes.Permission,
+		CreatedAt:  r.Attributes.CreatedAt,
+		UpdatedAt:  r.Attributes.UpdatedAt,
+	}
+	if r.Relationships.Service != nil {
+		sa.ServiceID = r.Relationships.Service.Data.ID
+	}
+	if r.Relationships.User != nil {
+		sa.UserID = r.Relationships.User.Data.ID
+	}
+	return sa
+}
+
+// printServiceAuthorization pretty prints a ServiceAuthorization in verbose
+// format to a given io.Writer, using prefix for indentation.
+func printServiceAuthorization(out io.Writer, prefix string, sa *ServiceAuthorization) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", sa.ID)
+	fmt.Fprintf(out, "Service ID: %s\n", sa.ServiceID)
+	fmt.Fprintf(out, "User ID: %s\n", sa.UserID)
+	fmt.Fprintf(out, "Permission: %s\n", sa.Permission)
+	if sa.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", sa.CreatedAt.UTC().Format(fsttime.Format))
+	}
+	if sa.UpdatedAt != nil {
+		fmt.Fprintf(out, "Last edited (UTC): %s\n", sa.UpdatedAt.UTC().Format(fsttime.Format))
+	}
+}
+
+// request makes a JSON:API request against the service authorizations
+// endpoint. If body is non-nil it's sent as the request's primary data, and if
+// v is non-nil the response's primary data is decoded into it.
+func request(client api.HTTPClient, globals *config.Data, method, path string, body *resource, v interface{}) error {
+	token, source := globals.Token()
+	if source == config.SourceUndefined {
+		return errors.ErrNoToken
+	}
+
+	var payload io.Reader
+	if body != nil {
+		data, err := json.Marshal(struct {
+			Data *resource `json:"data"`
+		}{body})
+		if err != nil {
+			return fmt.Errorf("error encoding API request: %w", err)
+		}
+		payload = bytes.NewReader(data)
+	}
+
+	endpoint, _ := globals.Endpoint()
+	fullurl := fmt.Sprintf("%s%s", strings.TrimSuffix(endpoint, "/"), path)
+	req, err := http.NewRequest(method, fullurl, payload)
+	if err != nil {
+		globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			method: fullurl,
+		})
+		return fmt.Errorf("error constructing API request: %w", err)
+	}
+
+	req.Header.Set("Fastly-Key", token)
+	req.Header.Set("Accept", "application/vnd.api+json")
+	req.Header.Set("User-Agent", useragent.Name)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/vnd.api+json")
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		globals.ErrLog.Add(err)
+		return fmt.Errorf("error executing API request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("error from API: %s", resp.Status)
+	}
+
+	if v == nil {
+		return nil
+	}
+	document := struct {
+		Data interface{} `json:"data"`
+	}{v}
+	if err := json.NewDecoder(resp.Body).Decode(&document); err != nil {
+		globals.ErrLog.Add(err)
+		return fmt.Errorf("error decoding API response: %w", err)
+	}
+	return nil
+}
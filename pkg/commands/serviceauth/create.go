@@ -0,0 +1,64 @@
+package serviceauth
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// CreateCommand calls the Fastly API to create a service authorization.
+type CreateCommand struct {
+	cmd.Base
+	client     api.HTTPClient
+	manifest   manifest.Data
+	permission string
+	userID     string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, client api.HTTPClient, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.client = client
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("create", "Grant a user access to a service").Alias("add")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.CmdClause.Flag("user-id", "Alphanumeric string identifying the user").Required().StringVar(&c.userID)
+	c.CmdClause.Flag("permission", "The permission the user has in relation to the service. Valid values are: full, read_only, purge_select, purge_all").Default("read_only").HintOptions(Permissions...).EnumVar(&c.permission, Permissions...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	var r resource
+	err := request(c.client, c.Globals, http.MethodPost, "/service-authorizations", &resource{
+		Type:       "service_authorization",
+		Attributes: attributes{Permission: c.permission},
+		Relationships: relationships{
+			Service: &relationship{Data: identifier{ID: serviceID, Type: "service"}},
+			User:    &relationship{Data: identifier{ID: c.userID, Type: "user"}},
+		},
+	}, &r)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"User ID":    c.userID,
+		})
+		return err
+	}
+
+	text.Success(out, "Created service authorization %s (service %s, user %s, permission %s)", r.ID, serviceID, c.userID, c.permission)
+	return nil
+}
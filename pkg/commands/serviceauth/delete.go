@@ -0,0 +1,43 @@
+package serviceauth
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DeleteCommand calls the Fastly API to delete a service authorization.
+type DeleteCommand struct {
+	cmd.Base
+	client api.HTTPClient
+	id     string
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, client api.HTTPClient, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.client = client
+	c.CmdClause = parent.Command("delete", "Revoke a user's access to a service").Alias("remove")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the service authorization").Required().StringVar(&c.id)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	err := request(c.client, c.Globals, http.MethodDelete, fmt.Sprintf("/service-authorizations/%s", c.id), nil, nil)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.id,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted service authorization %s", c.id)
+	return nil
+}
@@ -0,0 +1,48 @@
+package serviceauth
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+)
+
+// DescribeCommand calls the Fastly API to describe a service authorization.
+type DescribeCommand struct {
+	cmd.Base
+	client api.HTTPClient
+	id     string
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, client api.HTTPClient, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.client = client
+	c.CmdClause = parent.Command("describe", "Show detailed information about a service authorization").Alias("get")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the service authorization").Required().StringVar(&c.id)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	var r resource
+	err := request(c.client, c.Globals, http.MethodGet, fmt.Sprintf("/service-authorizations/%s", c.id), nil, &r)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.id,
+		})
+		return err
+	}
+
+	sa := r.toServiceAuthorization()
+	if c.JSONOutput() {
+		return c.WriteJSON(out, sa)
+	}
+
+	printServiceAuthorization(out, "", sa)
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package serviceauth contains commands to manage service authorizations.
+package serviceauth
@@ -0,0 +1,84 @@
+package serviceauth
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// pageSize is the number of service authorizations requested per page.
+const pageSize = 100
+
+// ListCommand calls the Fastly API to list service authorizations.
+type ListCommand struct {
+	cmd.Base
+	client    api.HTTPClient
+	serviceID string
+	userID    string
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, client api.HTTPClient, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.client = client
+	c.CmdClause = parent.Command("list", "List service authorizations")
+	c.CmdClause.Flag("filter-service-id", "Only list authorizations for the given service").StringVar(&c.serviceID)
+	c.CmdClause.Flag("filter-user-id", "Only list authorizations for the given user").StringVar(&c.userID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	var auths []*ServiceAuthorization
+	for page := 1; ; page++ {
+		var resources []resource
+		path := fmt.Sprintf("/service-authorizations?page[number]=%d&page[size]=%d", page, pageSize)
+		if err := request(c.client, c.Globals, http.MethodGet, path, nil, &resources); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Page": page,
+			})
+			return err
+		}
+		for _, r := range resources {
+			sa := r.toServiceAuthorization()
+			if c.serviceID != "" && sa.ServiceID != c.serviceID {
+				continue
+			}
+			if c.userID != "" && sa.UserID != c.userID {
+				continue
+			}
+			auths = append(auths, sa)
+		}
+		if len(resources) < pageSize {
+			break
+		}
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, auths)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "SERVICE ID", "USER ID", "PERMISSION")
+		for _, sa := range auths {
+			tw.AddLine(sa.ID, sa.ServiceID, sa.UserID, sa.Permission)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, sa := range auths {
+		fmt.Fprintf(out, "Service Authorization %d/%d\n", i+1, len(auths))
+		printServiceAuthorization(out, "\t", sa)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package serviceauth_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestServiceAuth(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args        []string
+		client      *fakeClient
+		wantError   string
+		wantOutput  string
+		wantRequest string
+		wantBody    string
+	}{
+		{
+			args:      args("service-auth create --service-id 123 --user-id 456"),
+			client:    &fakeClient{},
+			wantError: "no token provided",
+		},
+		{
+			args:      args("--token x service-auth create --user-id 456"),
+			client:    &fakeClient{},
+			wantError: "error reading service: no service ID found",
+		},
+		{
+			args:      args("--token x service-auth create --service-id 123 --user-id 456 --permission admin"),
+			client:    &fakeClient{},
+			wantError: "error parsing arguments: enum value must be one of full,read_only,purge_select,purge_all, got 'admin'",
+		},
+		{
+			args:        args("--token x service-auth create --service-id 123 --user-id 456 --permission purge_all"),
+			client:      &fakeClient{code: http.StatusCreated, response: `{"data":` + authJSON + `}`},
+			wantOutput:  "Created service authorization sa-1 (service 123, user 456, permission purge_all)",
+			wantRequest: "POST https://api.fastly.com/service-authorizations",
+			wantBody:    `{"data":{"type":"service_authorization","attributes":{"permission":"purge_all"},"relationships":{"service":{"data":{"id":"123","type":"service"}},"user":{"data":{"id":"456","type":"user"}}}}}`,
+		},
+		{
+			args:      args("--token x service-auth describe --id sa-1"),
+			client:    &fakeClient{code: http.StatusNotFound},
+			wantError: "error from API: 404 Not Found",
+		},
+		{
+			args:      args("--token x service-auth describe --id sa-1"),
+			client:    &fakeClient{err: errors.New("some network failure")},
+			wantError: "error executing API request: some network failure",
+		},
+		{
+			args:   args("--token x service-auth describe --id sa-1"),
+			client: &fakeClient{code: http.StatusOK, response: `{"data":` + authJSON + `}`},
+			wantOutput: strings.Join([]string{
+				"ID: sa-1",
+				"Service ID: 123",
+				"User ID: 456",
+				"Permission: purge_all",
+				"Created (UTC): 2021-06-15 23:00",
+			}, "\n") + "\n",
+			wantRequest: "GET https://api.fastly.com/service-authorizations/sa-1",
+		},
+		{
+			args:   args("--token x service-auth list --filter-user-id 456"),
+			client: &fakeClient{code: http.StatusOK, response: `{"data":[` + authJSON + `,` + strings.ReplaceAll(authJSON, `"456"`, `"789"`) + `]}`},
+			wantOutput: strings.TrimSpace(`
+ID    SERVICE ID  USER ID  PERMISSION
+sa-1  123         456      purge_all
+`) + "\n",
+			wantRequest: "GET https://api.fastly.com/service-authorizations?page[number]=1&page[size]=100",
+		},
+		{
+			args:        args("--token x service-auth update --id sa-1 --permission full"),
+			client:      &fakeClient{code: http.StatusOK, response: `{"data":` + strings.ReplaceAll(authJSON, "purge_all", "full") + `}`},
+			wantOutput:  "Updated service authorization sa-1 (permission full)",
+			wantRequest: "PATCH https://api.fastly.com/service-authorizations/sa-1",
+			wantBody:    `{"data":{"id":"sa-1","type":"service_authorization","attributes":{"permission":"full"},"relationships":{}}}`,
+		},
+		{
+			args:        args("--token x service-auth delete --id sa-1"),
+			client:      &fakeClient{code: http.StatusNoContent},
+			wantOutput:  "Deleted service authorization sa-1",
+			wantRequest: "DELETE https://api.fastly.com/service-authorizations/sa-1",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.HTTPClient = testcase.client
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+			if testcase.wantRequest != "" {
+				testutil.AssertString(t, testcase.wantRequest, testcase.client.request)
+			}
+			if testcase.wantBody != "" {
+				testutil.AssertString(t, testcase.wantBody, testcase.client.body)
+			}
+		})
+	}
+}
+
+const authJSON = `{"id":"sa-1","type":"service_authorization","attributes":{"permission":"purge_all","created_at":"2021-06-15T23:00:00Z"},"relationships":{"service":{"data":{"id":"123","type":"service"}},"user":{"data":{"id":"456","type":"user"}}}}`
+
+// fakeClient records the request it receives and replies with a canned
+// response.
+type fakeClient struct {
+	code     int
+	response string
+	err      error
+
+	request string
+	body    string
+}
+
+var _ api.HTTPClient = (*fakeClient)(nil)
+
+func (c *fakeClient) Do(req *http.Request) (*http.Response, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	c.request = req.Method + " " + req.URL.String()
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		c.body = string(data)
+	}
+	rec := httptest.NewRecorder()
+	rec.WriteHeader(c.code)
+	rec.WriteString(c.response)
+	return rec.Result(), nil
+}
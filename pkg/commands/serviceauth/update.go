@@ -0,0 +1,50 @@
+package serviceauth
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// UpdateCommand calls the Fastly API to update a service authorization.
+type UpdateCommand struct {
+	cmd.Base
+	client     api.HTTPClient
+	id         string
+	permission string
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, client api.HTTPClient, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.client = client
+	c.CmdClause = parent.Command("update", "Update the permission of a service authorization")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the service authorization").Required().StringVar(&c.id)
+	c.CmdClause.Flag("permission", "The permission the user has in relation to the service. Valid values are: full, read_only, purge_select, purge_all").Required().HintOptions(Permissions...).EnumVar(&c.permission, Permissions...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	var r resource
+	err := request(c.client, c.Globals, http.MethodPatch, fmt.Sprintf("/service-authorizations/%s", c.id), &resource{
+		ID:         c.id,
+		Type:       "service_authorization",
+		Attributes: attributes{Permission: c.permission},
+	}, &r)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.id,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated service authorization %s (permission %s)", c.id, r.Attributes.Permission)
+	return nil
+}
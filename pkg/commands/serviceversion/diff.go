@@ -0,0 +1,375 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/commands/service/snapshot"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+)
+
+// DiffCommand calls the Fastly API to compare two service versions.
+type DiffCommand struct {
+	cmd.Base
+	manifest manifest.Data
+	versionA cmd.OptionalServiceVersion
+	versionB cmd.OptionalServiceVersion
+}
+
+// NewDiffCommand returns a usable command registered under the parent.
+func NewDiffCommand(parent cmd.Registerer, globals *config.Data) *DiffCommand {
+	var c DiffCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("diff", "Compare the configuration of two Fastly service versions")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.CmdClause.Flag("version-a", "'latest', 'active', or the number of the version to compare from").Required().StringVar(&c.versionA.Value)
+	c.CmdClause.Flag("version-b", "'latest', 'active', or the number of the version to compare to").Required().StringVar(&c.versionB.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DiffCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	versionA, err := c.versionA.Parse(serviceID, c.Globals.Client)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"Version A":  c.versionA.Value,
+		})
+		return err
+	}
+	versionB, err := c.versionB.Parse(serviceID, c.Globals.Client)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"Version B":  c.versionB.Value,
+		})
+		return err
+	}
+
+	diff, err := Diff(c.Globals.Client, serviceID, versionA.Number, versionB.Number)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+			"Version A":  versionA.Number,
+			"Version B":  versionB.Number,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSON(out, diff)
+	}
+
+	diff.Print(out)
+	return nil
+}
+
+// Resource statuses used by ResourceDiff.
+const (
+	StatusAdded   = "added"
+	StatusRemoved = "removed"
+	StatusChanged = "changed"
+)
+
+// VersionDiff describes the differences between two service versions.
+type VersionDiff struct {
+	ServiceID string
+	VersionA  int
+	VersionB  int
+	Changes   []ResourceDiff
+}
+
+// ResourceDiff describes the difference in a single resource (e.g. a backend)
+// between two service versions. Diff holds the unified diff lines.
+type ResourceDiff struct {
+	Type   string
+	Name   string
+	Status string
+	Diff   []string
+}
+
+// Print displays the differences in a unified diff format.
+func (d *VersionDiff) Print(out io.Writer) {
+	fmt.Fprintf(out, "\nComparing service %s version %d with version %d\n", d.ServiceID, d.VersionA, d.VersionB)
+
+	if len(d.Changes) == 0 {
+		text.Break(out)
+		text.Info(out, "No differences found")
+		return
+	}
+
+	var added, removed, changed int
+	for _, r := range d.Changes {
+		from := fmt.Sprintf("a/%s/%s (version %d)", r.Type, r.Name, d.VersionA)
+		to := fmt.Sprintf("b/%s/%s (version %d)", r.Type, r.Name, d.VersionB)
+		switch r.Status {
+		case StatusAdded:
+			from = "/dev/null"
+			added++
+		case StatusRemoved:
+			to = "/dev/null"
+			removed++
+		default:
+			changed++
+		}
+		fmt.Fprintf(out, "\n--- %s\n+++ %s\n", from, to)
+		for _, l := range r.Diff {
+			fmt.Fprintln(out, l)
+		}
+	}
+
+	text.Break(out)
+	text.Info(out, "%d added, %d removed, %d changed", added, removed, changed)
+}
+
+// ignoredFields are resource fields that differ between versions without
+// representing a change in configuration.
+var ignoredFields = map[string]bool{
+	"ServiceID":      true,
+	"ServiceVersion": true,
+	"CreatedAt":      true,
+	"UpdatedAt":      true,
+	"DeletedAt":      true,
+}
+
+// diffContext is the number of unchanged lines shown around each change.
+const diffContext = 3
+
+// Diff compares every versioned resource of versions a and b of the given
+// service.
+func Diff(client api.Interface, serviceID string, a, b int) (*VersionDiff, error) {
+	d := &VersionDiff{
+		ServiceID: serviceID,
+		VersionA:  a,
+		VersionB:  b,
+		Changes:   []ResourceDiff{},
+	}
+
+	for _, rt := range snapshot.ResourceTypes {
+		ra, err := rt.List(client, serviceID, a)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s resources for version %d: %w", rt.Name, a, err)
+		}
+		rb, err := rt.List(client, serviceID, b)
+		if err != nil {
+			return nil, fmt.Errorf("error listing %s resources for version %d: %w", rt.Name, b, err)
+		}
+
+		linesA, linesB := resourceLines(ra), resourceLines(rb)
+
+		names := make([]string, 0, len(linesA)+len(linesB))
+		for name := range linesA {
+			names = append(names, name)
+		}
+		for name := range linesB {
+			if _, ok := linesA[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			la, okA := linesA[name]
+			lb, okB := linesB[name]
+
+			status := StatusChanged
+			switch {
+			case !okA:
+				status = StatusAdded
+			case !okB:
+				status = StatusRemoved
+			}
+
+			hunks := unifiedDiff(la, lb, diffContext)
+			if len(hunks) == 0 {
+				continue
+			}
+			d.Changes = append(d.Changes, ResourceDiff{
+				Type:   rt.Name,
+				Name:   name,
+				Status: status,
+				Diff:   hunks,
+			})
+		}
+	}
+
+	return d, nil
+}
+
+// resourceLines converts a slice of API resources into a set of text lines per
+// resource, keyed by the resource name, which can then be diffed.
+func resourceLines(resources interface{}) map[string][]string {
+	m := make(map[string][]string)
+
+	rv := reflect.ValueOf(resources)
+	if rv.Kind() != reflect.Slice {
+		return m
+	}
+
+	for i := 0; i < rv.Len(); i++ {
+		v := reflect.Indirect(rv.Index(i))
+		if v.Kind() != reflect.Struct {
+			continue
+		}
+
+		var lines []string
+		t := v.Type()
+		for j := 0; j < t.NumField(); j++ {
+			f := t.Field(j)
+			if ignoredFields[f.Name] || f.PkgPath != "" {
+				continue
+			}
+
+			fv := v.Field(j)
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					lines = append(lines, fmt.Sprintf("%s: ", f.Name))
+					continue
+				}
+				fv = fv.Elem()
+			}
+
+			// Multi-line values, such as VCL content, are shown line by line so
+			// that the diff only highlights the lines that changed.
+			if fv.Kind() == reflect.String && strings.Contains(fv.String(), "\n") {
+				lines = append(lines, fmt.Sprintf("%s:", f.Name))
+				for _, l := range strings.Split(strings.TrimRight(fv.String(), "\n"), "\n") {
+					lines = append(lines, "  "+l)
+				}
+				continue
+			}
+
+			lines = append(lines, fmt.Sprintf("%s: %v", f.Name, fv.Interface()))
+		}
+
+		m[v.FieldByName("Name").String()] = lines
+	}
+
+	return m
+}
+
+// edit is a single line of a line-based diff, where op is one of ' ' (line
+// is unchanged), '-' (line removed) or '+' (line added).
+type edit struct {
+	op   byte
+	text string
+}
+
+// lineDiff computes the edits required to turn a into b using the longest
+// common subsequence of lines.
+func lineDiff(a, b []string) []edit {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			case lcs[i+1][j] >= lcs[i][j+1]:
+				lcs[i][j] = lcs[i+1][j]
+			default:
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	var edits []edit
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			edits = append(edits, edit{' ', a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			edits = append(edits, edit{'-', a[i]})
+			i++
+		default:
+			edits = append(edits, edit{'+', b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		edits = append(edits, edit{'-', a[i]})
+	}
+	for ; j < len(b); j++ {
+		edits = append(edits, edit{'+', b[j]})
+	}
+	return edits
+}
+
+// unifiedDiff returns the hunks of a unified diff between a and b, showing
+// the given number of unchanged lines of context around each change. No
+// hunks are returned if a and b are identical.
+func unifiedDiff(a, b []string, context int) []string {
+	edits := lineDiff(a, b)
+
+	include := make([]bool, len(edits))
+	for i, e := range edits {
+		if e.op == ' ' {
+			continue
+		}
+		for j := i - context; j <= i+context; j++ {
+			if j >= 0 && j < len(edits) {
+				include[j] = true
+			}
+		}
+	}
+
+	var out []string
+	lineA, lineB := 1, 1
+	for i := 0; i < len(edits); {
+		if !include[i] {
+			lineA++
+			lineB++
+			i++
+			continue
+		}
+
+		startA, startB := lineA, lineB
+		var lenA, lenB int
+		var body []string
+		for ; i < len(edits) && include[i]; i++ {
+			e := edits[i]
+			body = append(body, string(e.op)+e.text)
+			if e.op != '+' {
+				lenA++
+				lineA++
+			}
+			if e.op != '-' {
+				lenB++
+				lineB++
+			}
+		}
+
+		out = append(out, fmt.Sprintf("@@ -%s +%s @@", hunkRange(startA, lenA), hunkRange(startB, lenB)))
+		out = append(out, body...)
+	}
+	return out
+}
+
+// hunkRange formats the line range of a hunk, where an empty range refers to
+// the line before it (as per the unified diff format).
+func hunkRange(start, length int) string {
+	if length == 0 {
+		start--
+	}
+	return fmt.Sprintf("%d,%d", start, length)
+}
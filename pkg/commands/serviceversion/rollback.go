@@ -0,0 +1,151 @@
+package serviceversion
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// RollbackCommand calls the Fastly API to activate the service version that
+// was deployed prior to the currently active version.
+type RollbackCommand struct {
+	cmd.Base
+	manifest manifest.Data
+	comment  cmd.OptionalString
+	force    bool
+}
+
+// NewRollbackCommand returns a usable command registered under the parent.
+func NewRollbackCommand(parent cmd.Registerer, globals *config.Data) *RollbackCommand {
+	var c RollbackCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("rollback", "Activate the service version that was deployed before the active version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.CmdClause.Flag("comment", "Reason for the rollback, recorded as the comment of the reactivated version").Action(c.comment.Set).StringVar(&c.comment.Value)
+	c.CmdClause.Flag("force", "Skip the confirmation prompt").BoolVar(&c.force)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *RollbackCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, source := c.manifest.ServiceID()
+	if source == manifest.SourceUndefined {
+		return errors.ErrNoServiceID
+	}
+
+	versions, err := c.Globals.Client.ListVersions(&fastly.ListVersionsInput{
+		ServiceID: serviceID,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+		})
+		return fmt.Errorf("error listing service versions: %w", err)
+	}
+
+	active, previous, err := rollbackVersions(versions)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID": serviceID,
+		})
+		return err
+	}
+
+	if !c.force {
+		label := fmt.Sprintf("Roll back service %s from version %d to version %d? [y/N] ", serviceID, active.Number, previous.Number)
+		cont, err := text.Input(out, label, in)
+		if err != nil {
+			return fmt.Errorf("error reading input %w", err)
+		}
+		contl := strings.ToLower(cont)
+		if contl != "y" && contl != "yes" {
+			text.Info(out, "Rollback cancelled.")
+			return nil
+		}
+	}
+
+	ver, err := c.Globals.Client.ActivateVersion(&fastly.ActivateVersionInput{
+		ServiceID:      serviceID,
+		ServiceVersion: previous.Number,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": previous.Number,
+		})
+		return err
+	}
+
+	// The comment is only updated once the version has been reactivated, so
+	// that a failed rollback doesn't leave a misleading comment behind. The
+	// rollback itself has succeeded, so a failure to update the comment isn't
+	// treated as an error.
+	if c.comment.WasSet {
+		_, err := c.Globals.Client.UpdateVersion(&fastly.UpdateVersionInput{
+			ServiceID:      serviceID,
+			ServiceVersion: previous.Number,
+			Comment:        fastly.String(c.comment.Value),
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": previous.Number,
+				"Comment":         c.comment.Value,
+			})
+			text.Warning(out, "Error updating comment of version %d: %s", previous.Number, err)
+		}
+	}
+
+	text.Success(out, "Rolled back service %s from version %d to version %d", ver.ServiceID, active.Number, previous.Number)
+	return nil
+}
+
+// rollbackVersions returns the active service version along with the most
+// recently deployed version that was updated before it.
+//
+// NOTE: a version is locked once it has been activated, so we treat locked
+// versions as having been deployed. The Deployed field isn't reliably
+// populated by the API.
+func rollbackVersions(versions []*fastly.Version) (active, previous *fastly.Version, err error) {
+	for _, v := range versions {
+		if v.Active {
+			active = v
+			break
+		}
+	}
+	if active == nil {
+		return nil, nil, errors.RemediationError{
+			Inner:       fmt.Errorf("no active service version found"),
+			Remediation: "Use `fastly service-version activate` to activate a service version.",
+		}
+	}
+
+	for _, v := range versions {
+		if v.Active || !(v.Locked || v.Deployed) || v.UpdatedAt == nil {
+			continue
+		}
+		if active.UpdatedAt != nil && !v.UpdatedAt.Before(*active.UpdatedAt) {
+			continue
+		}
+		if previous == nil || v.UpdatedAt.After(*previous.UpdatedAt) {
+			previous = v
+		}
+	}
+	if previous == nil {
+		return active, nil, errors.RemediationError{
+			Inner:       fmt.Errorf("no version was deployed before the active version %d", active.Number),
+			Remediation: "Use `fastly service-version list` to review the service versions.",
+		}
+	}
+
+	return active, previous, nil
+}
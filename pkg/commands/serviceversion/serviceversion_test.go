@@ -2,6 +2,7 @@ package serviceversion_test
 
 import (
 	"bytes"
+	"errors"
 	"strings"
 	"testing"
 
@@ -201,6 +202,105 @@ func TestVersionActivate(t *testing.T) {
 	}
 }
 
+func TestVersionRollback(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		stdin      string
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("service-version rollback"),
+			wantError: "error reading service: no service ID found",
+		},
+		{
+			args: args("service-version rollback --service-id 123"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersionsError,
+			},
+			wantError: "error listing service versions: test error",
+		},
+		{
+			args: args("service-version rollback --service-id 123 --force"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "no version was deployed before the active version 1",
+		},
+		{
+			args: args("service-version rollback --service-id 123"),
+			api: mock.API{
+				ListVersionsFn: listRollbackVersions,
+			},
+			stdin:      "n",
+			wantOutput: "Rollback cancelled.",
+		},
+		{
+			args: args("service-version rollback --service-id 123"),
+			api: mock.API{
+				ListVersionsFn:    listRollbackVersions,
+				ActivateVersionFn: activateVersionOK,
+			},
+			stdin:      "y",
+			wantOutput: "Rolled back service 123 from version 3 to version 2",
+		},
+		{
+			args: args("service-version rollback --service-id 123 --force"),
+			api: mock.API{
+				ListVersionsFn:    listRollbackVersions,
+				ActivateVersionFn: activateVersionError,
+			},
+			wantError: testutil.Err.Error(),
+		},
+		{
+			args: args("service-version rollback --service-id 123 --force --comment broken"),
+			api: mock.API{
+				ListVersionsFn: listRollbackVersions,
+				UpdateVersionFn: func(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+					if i.ServiceVersion != 2 || *i.Comment != "broken" {
+						return nil, testutil.Err
+					}
+					return updateVersionOK(i)
+				},
+				ActivateVersionFn: activateVersionOK,
+			},
+			wantOutput: "Rolled back service 123 from version 3 to version 2",
+		},
+		{
+			args: args("service-version rollback --service-id 123 --force --comment broken"),
+			api: mock.API{
+				ListVersionsFn:    listRollbackVersions,
+				ActivateVersionFn: activateVersionError,
+				UpdateVersionFn: func(i *fastly.UpdateVersionInput) (*fastly.Version, error) {
+					return nil, errors.New("comment updated before the version was activated")
+				},
+			},
+			wantError: testutil.Err.Error(),
+		},
+		{
+			args: args("service-version rollback --service-id 123 --force --comment broken"),
+			api: mock.API{
+				ListVersionsFn:    listRollbackVersions,
+				ActivateVersionFn: activateVersionOK,
+				UpdateVersionFn:   updateVersionError,
+			},
+			wantOutput: "Error updating comment of version 2: test error",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
 func TestVersionDeactivate(t *testing.T) {
 	args := testutil.Args
 	for _, testcase := range []struct {
@@ -359,6 +459,19 @@ func activateVersionError(i *fastly.ActivateVersionInput) (*fastly.Version, erro
 	return nil, testutil.Err
 }
 
+// listRollbackVersions returns versions where version 3 is active, having
+// replaced version 2, which itself replaced version 1. Version 4 was activated
+// and subsequently rolled back, while version 5 is a draft.
+func listRollbackVersions(i *fastly.ListVersionsInput) ([]*fastly.Version, error) {
+	return []*fastly.Version{
+		{ServiceID: i.ServiceID, Number: 1, Locked: true, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-01T01:00:00Z")},
+		{ServiceID: i.ServiceID, Number: 2, Locked: true, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-02T01:00:00Z")},
+		{ServiceID: i.ServiceID, Number: 3, Active: true, Locked: true, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-04T01:00:00Z")},
+		{ServiceID: i.ServiceID, Number: 4, Locked: true, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-05T01:00:00Z")},
+		{ServiceID: i.ServiceID, Number: 5, UpdatedAt: testutil.MustParseTimeRFC3339("2000-01-06T01:00:00Z")},
+	}, nil
+}
+
 func deactivateVersionOK(i *fastly.DeactivateVersionInput) (*fastly.Version, error) {
 	return &fastly.Version{
 		Number:    i.ServiceVersion,
@@ -389,3 +502,125 @@ func lockVersionOK(i *fastly.LockVersionInput) (*fastly.Version, error) {
 func lockVersionError(i *fastly.LockVersionInput) (*fastly.Version, error) {
 	return nil, testutil.Err
 }
+
+func TestVersionDiff(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args          []string
+		api           mock.API
+		wantError     string
+		wantOutput    []string
+		dontWantOuput string
+	}{
+		{
+			args:      args("service-version diff --version-a 1 --version-b 2"),
+			wantError: "error reading service: no service ID found",
+		},
+		{
+			args:      args("service-version diff --service-id 123 --version-a 1"),
+			wantError: "error parsing arguments: required flag --version-b not provided",
+		},
+		{
+			args: args("service-version diff --service-id 123 --version-a 1 --version-b 4"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "specified service version not found: 4",
+		},
+		{
+			args: args("service-version diff --service-id 123 --version-a active --version-b latest"),
+			api:  diffAPI(),
+			wantOutput: []string{
+				"Comparing service 123 version 1 with version 3",
+				"--- /dev/null\n+++ b/domain/www.example.com (version 3)\n@@ -0,0 +1,2 @@\n+Name: www.example.com",
+				"--- a/backend/origin (version 1)\n+++ b/backend/origin (version 3)",
+				"-Port: 80\n+Port: 443",
+				"--- a/vcl/main (version 1)\n+++ b/vcl/main (version 3)",
+				"   sub vcl_recv {\n-    set req.backend = F_origin;\n+    set req.backend = F_secure;\n   }",
+				"1 added, 0 removed, 2 changed",
+			},
+		},
+		{
+			args: args("service-version diff --service-id 123 --version-a 1 --version-b 1"),
+			api:  diffAPI(),
+			wantOutput: []string{
+				"Comparing service 123 version 1 with version 1",
+				"No differences found",
+			},
+			dontWantOuput: "---",
+		},
+		{
+			args: args("service-version diff --service-id 123 --version-a 1 --version-b 3 --json"),
+			api:  diffAPI(),
+			wantOutput: []string{
+				`{"ServiceID":"123","VersionA":1,"VersionB":3,"Changes":[`,
+				`{"Type":"domain","Name":"www.example.com","Status":"added","Diff":["@@ -0,0 +1,2 @@","+Name: www.example.com"`,
+				`{"Type":"backend","Name":"origin","Status":"changed","Diff":[`,
+			},
+		},
+		{
+			args: args("service-version diff --service-id 123 --version-a 1 --version-b 3"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				ListDomainsFn: func(*fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+					return nil, testutil.Err
+				},
+			},
+			wantError: "error listing domain resources for version 1: test error",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.dontWantOuput != "" && strings.Contains(stdout.String(), testcase.dontWantOuput) {
+				t.Errorf("unexpected output %q in:\n%s", testcase.dontWantOuput, stdout.String())
+			}
+		})
+	}
+}
+
+// diffAPI returns a mock API where version 3 adds a domain, changes the port
+// of the origin backend and changes the main VCL compared to version 1.
+func diffAPI() mock.API {
+	return testutil.EmptyResourceLists(mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		ListDomainsFn: func(i *fastly.ListDomainsInput) ([]*fastly.Domain, error) {
+			if i.ServiceVersion == 1 {
+				return nil, nil
+			}
+			return []*fastly.Domain{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "www.example.com"},
+			}, nil
+		},
+		ListBackendsFn: func(i *fastly.ListBackendsInput) ([]*fastly.Backend, error) {
+			port := uint(80)
+			if i.ServiceVersion == 3 {
+				port = 443
+			}
+			return []*fastly.Backend{
+				{ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion, Name: "origin", Address: "example.com", Port: port},
+			}, nil
+		},
+		ListVCLsFn: func(i *fastly.ListVCLsInput) ([]*fastly.VCL, error) {
+			backend := "F_origin"
+			if i.ServiceVersion == 3 {
+				backend = "F_secure"
+			}
+			return []*fastly.VCL{
+				{
+					ServiceID:      i.ServiceID,
+					ServiceVersion: i.ServiceVersion,
+					Name:           "main",
+					Main:           true,
+					Content:        "sub vcl_recv {\n  set req.backend = " + backend + ";\n}\n",
+				},
+			}, nil
+		},
+	})
+}
@@ -0,0 +1,164 @@
+package settings_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestSettingsDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("service-version settings describe --service-id 123"),
+			wantError: "error parsing arguments: required flag --version not provided",
+		},
+		{
+			args: args("service-version settings describe --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetSettingsFn:  getSettingsError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("service-version settings describe --service-id 123 --version 1"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetSettingsFn:  getSettingsOK,
+			},
+			wantOutput: describeSettingsOutput,
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestSettingsUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("service-version settings update --service-id 123 --version 1 --default-ttl 60"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+			},
+			wantError: "service version 1 is not editable",
+		},
+		{
+			args: args("service-version settings update --service-id 123 --version 1 --default-ttl 60 --autoclone"),
+			api: mock.API{
+				ListVersionsFn:   testutil.ListVersions,
+				CloneVersionFn:   testutil.CloneVersionResult(4),
+				UpdateSettingsFn: updateSettingsError,
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("service-version settings update --service-id 123 --version 1 --default-ttl 60 --stale-if-error --autoclone"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				CloneVersionFn: testutil.CloneVersionResult(4),
+				UpdateSettingsFn: func(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+					if i.DefaultTTL != 60 || !*i.StaleIfError || i.DefaultHost != nil {
+						return nil, errTest
+					}
+					return updateSettingsOK(i)
+				},
+			},
+			wantOutput: "Updated settings (service 123 version 4)",
+		},
+		// The default TTL is always sent to the API, so we expect the current
+		// value to be used when --default-ttl isn't provided.
+		{
+			args: args("service-version settings update --service-id 123 --version 3 --default-host www.example.com"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetSettingsFn:  getSettingsOK,
+				UpdateSettingsFn: func(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+					if i.DefaultTTL != 3600 || *i.DefaultHost != "www.example.com" {
+						return nil, errTest
+					}
+					return updateSettingsOK(i)
+				},
+			},
+			wantOutput: "Updated settings (service 123 version 3)",
+		},
+		{
+			args: args("service-version settings update --service-id 123 --version 3 --default-host www.example.com"),
+			api: mock.API{
+				ListVersionsFn: testutil.ListVersions,
+				GetSettingsFn:  getSettingsError,
+			},
+			wantError: errTest.Error(),
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func getSettingsOK(i *fastly.GetSettingsInput) (*fastly.Settings, error) {
+	return &fastly.Settings{
+		ServiceID:       i.ServiceID,
+		ServiceVersion:  i.ServiceVersion,
+		DefaultTTL:      3600,
+		DefaultHost:     "origin.example.com",
+		StaleIfError:    true,
+		StaleIfErrorTTL: 43200,
+	}, nil
+}
+
+func getSettingsError(i *fastly.GetSettingsInput) (*fastly.Settings, error) {
+	return nil, errTest
+}
+
+var describeSettingsOutput = strings.Join([]string{
+	"Service ID: 123",
+	"Version: 1",
+	"Default TTL: 3600",
+	"Default host: origin.example.com",
+	"Stale if error: true",
+	"Stale if error TTL: 43200",
+}, "\n") + "\n"
+
+func updateSettingsOK(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+	return &fastly.Settings{
+		ServiceID:      i.ServiceID,
+		ServiceVersion: i.ServiceVersion,
+	}, nil
+}
+
+func updateSettingsError(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+	return nil, errTest
+}
@@ -0,0 +1,115 @@
+package settings
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update the settings of a service
+// version.
+type UpdateCommand struct {
+	cmd.Base
+	manifest       manifest.Data
+	input          fastly.UpdateSettingsInput
+	serviceVersion cmd.OptionalServiceVersion
+	autoClone      cmd.OptionalAutoClone
+
+	DefaultTTL      cmd.OptionalUint
+	DefaultHost     cmd.OptionalString
+	StaleIfError    cmd.OptionalBool
+	StaleIfErrorTTL cmd.OptionalUint
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+	c.CmdClause = parent.Command("update", "Update the settings of a Fastly service version")
+	c.RegisterServiceIDFlag(&c.manifest)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("default-ttl", "The default time-to-live (TTL) for requests, in seconds").Action(c.DefaultTTL.Set).UintVar(&c.DefaultTTL.Value)
+	c.CmdClause.Flag("default-host", "The default host name for the version").Action(c.DefaultHost.Set).StringVar(&c.DefaultHost.Value)
+	c.CmdClause.Flag("stale-if-error", "Enables serving a stale object if there is an error").Action(c.StaleIfError.Set).BoolVar(&c.StaleIfError.Value)
+	c.CmdClause.Flag("stale-if-error-ttl", "The default time-to-live (TTL) for serving the stale object for the version, in seconds").Action(c.StaleIfErrorTTL.Set).UintVar(&c.StaleIfErrorTTL.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AutoCloneFlag:      c.autoClone,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	c.input.ServiceID = serviceID
+	c.input.ServiceVersion = serviceVersion.Number
+
+	// NOTE: go-fastly always sends the default TTL (it isn't a pointer type) so
+	// if the user hasn't provided one we must send the current value to avoid
+	// it being reset.
+	if c.DefaultTTL.WasSet {
+		c.input.DefaultTTL = c.DefaultTTL.Value
+	} else {
+		current, err := c.Globals.Client.GetSettings(&fastly.GetSettingsInput{
+			ServiceID:      serviceID,
+			ServiceVersion: serviceVersion.Number,
+		})
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+		c.input.DefaultTTL = current.DefaultTTL
+	}
+
+	if c.DefaultHost.WasSet {
+		c.input.DefaultHost = fastly.String(c.DefaultHost.Value)
+	}
+
+	if c.StaleIfError.WasSet {
+		c.input.StaleIfError = fastly.Bool(c.StaleIfError.Value)
+	}
+
+	if c.StaleIfErrorTTL.WasSet {
+		c.input.StaleIfErrorTTL = fastly.Uint(c.StaleIfErrorTTL.Value)
+	}
+
+	s, err := c.Globals.Client.UpdateSettings(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated settings (service %s version %d)", s.ServiceID, s.ServiceVersion)
+	return nil
+}
@@ -29,7 +29,7 @@ func NewHistoricalCommand(parent cmd.Registerer, globals *config.Data) *Historic
 	c.Globals = globals
 
 	c.CmdClause = parent.Command("historical", "View historical stats for a Fastly service")
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 
 	c.CmdClause.Flag("from", "From time, accepted formats at https://fastly.dev/reference/api/metrics-stats/historical-stats").StringVar(&c.Input.From)
 	c.CmdClause.Flag("to", "To time").StringVar(&c.Input.To)
@@ -62,6 +62,10 @@ func (c *HistoricalCommand) Exec(in io.Reader, out io.Writer) error {
 		return fmt.Errorf("non-success response: %s", envelope.Msg)
 	}
 
+	if c.JSONOutput() {
+		c.formatFlag = "json"
+	}
+
 	switch c.formatFlag {
 	case "json":
 		err := writeBlocksJSON(out, serviceID, envelope.Data)
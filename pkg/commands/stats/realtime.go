@@ -27,7 +27,7 @@ func NewRealtimeCommand(parent cmd.Registerer, globals *config.Data) *RealtimeCo
 	c.Globals = globals
 
 	c.CmdClause = parent.Command("realtime", "View realtime stats for a Fastly service")
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 
 	c.CmdClause.Flag("format", "Output format (json)").EnumVar(&c.formatFlag, "json")
 
@@ -41,6 +41,10 @@ func (c *RealtimeCommand) Exec(in io.Reader, out io.Writer) error {
 		return errors.ErrNoServiceID
 	}
 
+	if c.JSONOutput() {
+		c.formatFlag = "json"
+	}
+
 	switch c.formatFlag {
 	case "json":
 		if err := loopJSON(c.Globals.RTSClient, serviceID, out); err != nil {
@@ -0,0 +1,273 @@
+package activation_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestActivationCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls activation create --domain www.example.com"),
+			wantError: "error parsing arguments: required flag --cert-id not provided",
+		},
+		{
+			args: args("tls activation create --cert-id cert-1 --domain www.example.com"),
+			api: mock.API{
+				CreateTLSActivationFn: func(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls activation create --cert-id cert-1 --domain www.example.com --config-id conf-1"),
+			api: mock.API{
+				CreateTLSActivationFn: func(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+					if i.Certificate.ID != "cert-1" || i.Domain.ID != "www.example.com" || i.Configuration.ID != "conf-1" {
+						return nil, errTest
+					}
+					return &fastly.TLSActivation{ID: "act-1"}, nil
+				},
+			},
+			wantOutput: "Enabled TLS for domain www.example.com using certificate cert-1 (id: act-1)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestActivationList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls activation list"),
+			api: mock.API{
+				ListTLSActivationsFn: func(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls activation list --filter-cert-id cert-1"),
+			api: mock.API{
+				ListTLSActivationsFn: listTLSActivationsOK,
+			},
+			wantOutput: strings.TrimSpace(`
+ID     DOMAIN           CERTIFICATE ID  CREATED (UTC)
+act-1  www.example.com  cert-1          2021-06-15 23:00
+`) + "\n",
+		},
+		{
+			args: args("tls activation list --verbose"),
+			api: mock.API{
+				ListTLSActivationsFn: listTLSActivationsOK,
+			},
+			wantOutput: strings.Join([]string{
+				"Fastly API token not provided",
+				"Fastly API endpoint: https://api.fastly.com",
+				"Activation 1/1",
+				"	ID: act-1",
+				"	Domain: www.example.com",
+				"	Certificate ID: cert-1",
+				"	Configuration ID: conf-1",
+				"	Created (UTC): 2021-06-15 23:00",
+			}, "\n") + "\n\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestActivationListPagination(t *testing.T) {
+	var pages []int
+	api := mock.API{
+		ListTLSActivationsFn: func(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+			pages = append(pages, i.PageNumber)
+			n := i.PageSize
+			if i.PageNumber == 2 {
+				n = 1
+			}
+			activations := make([]*fastly.TLSActivation, n)
+			for j := range activations {
+				activations[j] = &fastly.TLSActivation{ID: fmt.Sprintf("activation-%d-%d", i.PageNumber, j)}
+			}
+			return activations, nil
+		},
+	}
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("tls activation list --verbose"), &stdout)
+	opts.APIClient = mock.APIClient(api)
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, []int{1, 2}, pages)
+	testutil.AssertStringContains(t, stdout.String(), "Activation 101/101")
+}
+
+func TestActivationDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls activation describe --id act-1"),
+			api: mock.API{
+				GetTLSActivationFn: func(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls activation describe --id act-1"),
+			api: mock.API{
+				GetTLSActivationFn: func(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+					activations, _ := listTLSActivationsOK(nil)
+					return activations[0], nil
+				},
+			},
+			wantOutput: "Domain: www.example.com",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestActivationUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls activation update --id act-1"),
+			wantError: "error parsing arguments: required flag --cert-id not provided",
+		},
+		{
+			args: args("tls activation update --id act-1 --cert-id cert-2"),
+			api: mock.API{
+				UpdateTLSActivationFn: func(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls activation update --id act-1 --cert-id cert-2"),
+			api: mock.API{
+				UpdateTLSActivationFn: func(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+					return &fastly.TLSActivation{ID: i.ID, Certificate: i.Certificate}, nil
+				},
+			},
+			wantOutput: "Updated TLS activation act-1 to use certificate cert-2",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestActivationDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls activation delete --id act-1"),
+			api: mock.API{
+				DeleteTLSActivationFn: func(i *fastly.DeleteTLSActivationInput) error {
+					return errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls activation delete --id act-1"),
+			api: mock.API{
+				DeleteTLSActivationFn: func(i *fastly.DeleteTLSActivationInput) error {
+					return nil
+				},
+			},
+			wantOutput: "Deleted TLS activation act-1",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func listTLSActivationsOK(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+	return []*fastly.TLSActivation{
+		{
+			ID:            "act-1",
+			Domain:        &fastly.TLSDomain{ID: "www.example.com"},
+			Certificate:   &fastly.CustomTLSCertificate{ID: "cert-1"},
+			Configuration: &fastly.TLSConfiguration{ID: "conf-1"},
+			CreatedAt:     testutil.MustParseTimeRFC3339("2021-06-15T23:00:00Z"),
+		},
+	}, nil
+}
@@ -0,0 +1,52 @@
+package activation
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CreateCommand calls the Fastly API to enable TLS for a domain.
+type CreateCommand struct {
+	cmd.Base
+	certID   string
+	configID string
+	domain   string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Enable TLS for a domain using a custom certificate").Alias("add")
+	c.CmdClause.Flag("cert-id", "Alphanumeric string identifying a TLS certificate").Required().StringVar(&c.certID)
+	c.CmdClause.Flag("domain", "The domain name to enable TLS for").Short('d').Required().StringVar(&c.domain)
+	c.CmdClause.Flag("config-id", "Alphanumeric string identifying a TLS configuration (defaults to the account's default configuration)").StringVar(&c.configID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	input := fastly.CreateTLSActivationInput{
+		Certificate: &fastly.CustomTLSCertificate{ID: c.certID},
+		Domain:      &fastly.TLSDomain{ID: c.domain},
+	}
+	if c.configID != "" {
+		input.Configuration = &fastly.TLSConfiguration{ID: c.configID}
+	}
+
+	activation, err := c.Globals.Client.CreateTLSActivation(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Certificate ID": c.certID,
+			"Domain":         c.domain,
+		})
+		return err
+	}
+
+	text.Success(out, "Enabled TLS for domain %s using certificate %s (id: %s)", c.domain, c.certID, activation.ID)
+	return nil
+}
@@ -0,0 +1,38 @@
+package activation
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DeleteCommand calls the Fastly API to disable TLS for a domain.
+type DeleteCommand struct {
+	cmd.Base
+	Input fastly.DeleteTLSActivationInput
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("delete", "Disable TLS on the domain associated with a TLS activation").Alias("remove")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying a TLS activation").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	if err := c.Globals.Client.DeleteTLSActivation(&c.Input); err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.Input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Deleted TLS activation %s", c.Input.ID)
+	return nil
+}
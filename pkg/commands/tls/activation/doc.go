@@ -0,0 +1,2 @@
+// Package activation contains commands to manage TLS activations.
+package activation
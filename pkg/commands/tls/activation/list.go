@@ -0,0 +1,83 @@
+package activation
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// pageSize is the number of activations requested per page.
+const pageSize = 100
+
+// ListCommand calls the Fastly API to list TLS activations.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListTLSActivationsInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List TLS activations")
+	c.CmdClause.Flag("filter-cert-id", "Limit the returned activations to a specific certificate").StringVar(&c.Input.FilterTLSCertificateID)
+	c.CmdClause.Flag("filter-config-id", "Limit the returned activations to a specific TLS configuration").StringVar(&c.Input.FilterTLSConfigurationID)
+	c.CmdClause.Flag("filter-domain", "Limit the returned activations to a specific domain").StringVar(&c.Input.FilterTLSDomainID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	var activations []*fastly.TLSActivation
+	c.Input.PageSize = pageSize
+	for c.Input.PageNumber = 1; ; c.Input.PageNumber++ {
+		page, err := c.Globals.Client.ListTLSActivations(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Page": c.Input.PageNumber,
+			})
+			return err
+		}
+		activations = append(activations, page...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, activations)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "DOMAIN", "CERTIFICATE ID", "CREATED (UTC)")
+		for _, a := range activations {
+			var domain, certID, created string
+			if a.Domain != nil {
+				domain = a.Domain.ID
+			}
+			if a.Certificate != nil {
+				certID = a.Certificate.ID
+			}
+			if a.CreatedAt != nil {
+				created = a.CreatedAt.UTC().Format(time.Format)
+			}
+			tw.AddLine(a.ID, domain, certID, created)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, a := range activations {
+		fmt.Fprintf(out, "Activation %d/%d\n", i+1, len(activations))
+		text.PrintTLSActivation(out, "\t", a)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package activation
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to change the certificate of a TLS
+// activation.
+type UpdateCommand struct {
+	cmd.Base
+	certID string
+	id     string
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Update the certificate used to terminate TLS traffic for an activated domain")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying a TLS activation").Required().StringVar(&c.id)
+	c.CmdClause.Flag("cert-id", "Alphanumeric string identifying a TLS certificate").Required().StringVar(&c.certID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	activation, err := c.Globals.Client.UpdateTLSActivation(&fastly.UpdateTLSActivationInput{
+		ID:          c.id,
+		Certificate: &fastly.CustomTLSCertificate{ID: c.certID},
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID":             c.id,
+			"Certificate ID": c.certID,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated TLS activation %s to use certificate %s", activation.ID, c.certID)
+	return nil
+}
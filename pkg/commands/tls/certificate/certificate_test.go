@@ -0,0 +1,338 @@
+package certificate_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestCertificateCreate(t *testing.T) {
+	certFile := testutil.MakeTempFile(t, certPEM)
+	defer os.Remove(certFile)
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls certificate create --name example"),
+			wantError: "error parsing arguments: required flag --cert-blob not provided",
+		},
+		{
+			args: args("tls certificate create --name example --cert-blob " + certFile),
+			api: mock.API{
+				CreateCustomTLSCertificateFn: func(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls certificate create --name example --cert-blob " + certFile),
+			api: mock.API{
+				CreateCustomTLSCertificateFn: func(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+					if i.CertBlob != certPEM {
+						return nil, errTest
+					}
+					return &fastly.CustomTLSCertificate{ID: "cert-1", Name: i.Name}, nil
+				},
+			},
+			wantOutput: "Created TLS certificate example (id: cert-1)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestCertificateList(t *testing.T) {
+	soon := time.Now().AddDate(0, 0, 10).UTC().Truncate(time.Minute)
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls certificate list"),
+			api: mock.API{
+				ListCustomTLSCertificatesFn: func(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls certificate list"),
+			api: mock.API{
+				ListCustomTLSCertificatesFn: listCertificates(soon),
+			},
+			wantOutput: strings.TrimSpace(fmt.Sprintf(`
+ID         NAME  ISSUED TO    ISSUER         NOT AFTER (UTC)   DOMAINS
+cert-far   far   example.com  Let's Encrypt  2999-01-01 00:00  example.com, www.example.com
+cert-old   old   old.com      GlobalSign     2001-01-01 00:00  old.com
+cert-soon  soon  soon.com     GlobalSign     %s  soon.com
+`, soon.Format("2006-01-02 15:04"))) + "\n",
+		},
+		{
+			args: args("tls certificate list --expiring-within 30"),
+			api: mock.API{
+				ListCustomTLSCertificatesFn: listCertificates(soon),
+			},
+			wantOutput: strings.TrimSpace(fmt.Sprintf(`
+ID         NAME  ISSUED TO  ISSUER      NOT AFTER (UTC)   DOMAINS
+cert-old   old   old.com    GlobalSign  2001-01-01 00:00  old.com
+cert-soon  soon  soon.com   GlobalSign  %s  soon.com
+`, soon.Format("2006-01-02 15:04"))) + "\n",
+		},
+		{
+			args: args("tls certificate list --expiring-within 0"),
+			api: mock.API{
+				ListCustomTLSCertificatesFn: listCertificates(soon),
+			},
+			wantOutput: strings.TrimSpace(`
+ID        NAME  ISSUED TO  ISSUER      NOT AFTER (UTC)   DOMAINS
+cert-old  old   old.com    GlobalSign  2001-01-01 00:00  old.com
+`) + "\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCertificateListPagination(t *testing.T) {
+	var pages []int
+	api := mock.API{
+		ListCustomTLSCertificatesFn: func(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+			pages = append(pages, i.PageNumber)
+			n := i.PageSize
+			if i.PageNumber == 2 {
+				n = 1
+			}
+			certs := make([]*fastly.CustomTLSCertificate, n)
+			for j := range certs {
+				certs[j] = &fastly.CustomTLSCertificate{ID: fmt.Sprintf("cert-%d-%d", i.PageNumber, j)}
+			}
+			return certs, nil
+		},
+	}
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("tls certificate list --verbose"), &stdout)
+	opts.APIClient = mock.APIClient(api)
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, []int{1, 2}, pages)
+	testutil.AssertStringContains(t, stdout.String(), "Certificate 101/101")
+}
+
+func TestCertificateDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls certificate describe"),
+			wantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			args: args("tls certificate describe --id cert-old"),
+			api: mock.API{
+				GetCustomTLSCertificateFn: func(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls certificate describe --id cert-old"),
+			api: mock.API{
+				GetCustomTLSCertificateFn: func(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+					certs, _ := listCertificates(time.Now())(nil)
+					return certs[1], nil
+				},
+			},
+			wantOutput: strings.Join([]string{
+				"ID: cert-old",
+				"Name: old",
+				"Issued To: old.com",
+				"Issuer: GlobalSign",
+				"Serial Number: 1234",
+				"Signature Algorithm: SHA256-RSA",
+				"Replace: false",
+				"Domains: old.com",
+				"Not Before (UTC): 2000-01-01 00:00",
+				"Not After (UTC): 2001-01-01 00:00",
+			}, "\n") + "\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestCertificateUpdate(t *testing.T) {
+	certFile := testutil.MakeTempFile(t, certPEM)
+	defer os.Remove(certFile)
+
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls certificate update --cert-blob " + certFile),
+			wantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			args: args("tls certificate update --id cert-1 --cert-blob " + certFile),
+			api: mock.API{
+				UpdateCustomTLSCertificateFn: func(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls certificate update --id cert-1 --cert-blob " + certFile),
+			api: mock.API{
+				UpdateCustomTLSCertificateFn: func(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+					if i.CertBlob != certPEM {
+						return nil, errTest
+					}
+					return &fastly.CustomTLSCertificate{ID: i.ID, Name: "example"}, nil
+				},
+			},
+			wantOutput: "Updated TLS certificate example (id: cert-1)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestCertificateDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls certificate delete --id cert-1"),
+			api: mock.API{
+				DeleteCustomTLSCertificateFn: func(i *fastly.DeleteCustomTLSCertificateInput) error {
+					return errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls certificate delete --id cert-1"),
+			api: mock.API{
+				DeleteCustomTLSCertificateFn: func(i *fastly.DeleteCustomTLSCertificateInput) error {
+					return nil
+				},
+			},
+			wantOutput: "Deleted TLS certificate cert-1",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+const certPEM = `-----BEGIN CERTIFICATE-----
+MIIBszCCAVmgAwIBAgIUFixture
+-----END CERTIFICATE-----`
+
+func listCertificates(soon time.Time) func(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	date := func(year int) *time.Time {
+		t := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	return func(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+		return []*fastly.CustomTLSCertificate{
+			{
+				ID:       "cert-far",
+				Name:     "far",
+				IssuedTo: "example.com",
+				Issuer:   "Let's Encrypt",
+				NotAfter: date(2999),
+				Domains:  []*fastly.TLSDomain{{ID: "example.com"}, {ID: "www.example.com"}},
+			},
+			{
+				ID:                 "cert-old",
+				Name:               "old",
+				IssuedTo:           "old.com",
+				Issuer:             "GlobalSign",
+				SerialNumber:       "1234",
+				SignatureAlgorithm: "SHA256-RSA",
+				NotBefore:          date(2000),
+				NotAfter:           date(2001),
+				Domains:            []*fastly.TLSDomain{{ID: "old.com"}},
+			},
+			{
+				ID:       "cert-soon",
+				Name:     "soon",
+				IssuedTo: "soon.com",
+				Issuer:   "GlobalSign",
+				NotAfter: &soon,
+				Domains:  []*fastly.TLSDomain{{ID: "soon.com"}},
+			},
+		}, nil
+	}
+}
@@ -0,0 +1,42 @@
+package certificate
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CreateCommand calls the Fastly API to upload a custom TLS certificate.
+type CreateCommand struct {
+	cmd.Base
+	Input fastly.CreateCustomTLSCertificateInput
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Upload a custom TLS certificate. The matching private key must have been uploaded first").Alias("add")
+	c.CmdClause.Flag("cert-blob", "PEM-formatted certificate passed as file path or content, e.g. $(< cert.pem)").Required().StringVar(&c.Input.CertBlob)
+	c.CmdClause.Flag("name", "A customizable name for the certificate (defaults to the certificate's Common Name or first Subject Alternative Name)").Short('n').StringVar(&c.Input.Name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	c.Input.CertBlob = cmd.Content(c.Input.CertBlob)
+
+	cert, err := c.Globals.Client.CreateCustomTLSCertificate(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Name": c.Input.Name,
+		})
+		return err
+	}
+
+	text.Success(out, "Created TLS certificate %s (id: %s)", cert.Name, cert.ID)
+	return nil
+}
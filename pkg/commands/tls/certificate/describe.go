@@ -0,0 +1,43 @@
+package certificate
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a custom TLS certificate.
+type DescribeCommand struct {
+	cmd.Base
+	Input fastly.GetCustomTLSCertificateInput
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("describe", "Show detailed information about a custom TLS certificate").Alias("get")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying a certificate").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	cert, err := c.Globals.Client.GetCustomTLSCertificate(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.Input.ID,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSON(out, cert)
+	}
+
+	text.PrintTLSCertificate(out, "", cert)
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package certificate contains commands to manage custom TLS certificates.
+package certificate
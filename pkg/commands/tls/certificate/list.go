@@ -0,0 +1,99 @@
+package certificate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	fsttime "github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// pageSize is the number of certificates requested per page. The list
+// command walks every page so that the expiry filter sees all certificates.
+const pageSize = 100
+
+// ListCommand calls the Fastly API to list custom TLS certificates.
+type ListCommand struct {
+	cmd.Base
+	Input          fastly.ListCustomTLSCertificatesInput
+	expiringWithin cmd.OptionalUint
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List custom TLS certificates")
+	c.CmdClause.Flag("filter-domain", "Limit the returned certificates to those that include the given domain").StringVar(&c.Input.FilterTLSDomainsID)
+	c.CmdClause.Flag("expiring-within", "Only list certificates that expire within the given number of days, soonest first (0 lists already expired certificates)").Action(c.expiringWithin.Set).UintVar(&c.expiringWithin.Value)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	var certs []*fastly.CustomTLSCertificate
+	c.Input.PageSize = pageSize
+	for c.Input.PageNumber = 1; ; c.Input.PageNumber++ {
+		page, err := c.Globals.Client.ListCustomTLSCertificates(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Page": c.Input.PageNumber,
+			})
+			return err
+		}
+		certs = append(certs, page...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	if c.expiringWithin.WasSet {
+		certs = expiringBefore(certs, time.Now().AddDate(0, 0, int(c.expiringWithin.Value)))
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, certs)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME", "ISSUED TO", "ISSUER", "NOT AFTER (UTC)", "DOMAINS")
+		for _, cert := range certs {
+			var notAfter string
+			if cert.NotAfter != nil {
+				notAfter = cert.NotAfter.UTC().Format(fsttime.Format)
+			}
+			tw.AddLine(cert.ID, cert.Name, cert.IssuedTo, cert.Issuer, notAfter, text.TLSDomainIDs(cert.Domains))
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, cert := range certs {
+		fmt.Fprintf(out, "Certificate %d/%d\n", i+1, len(certs))
+		text.PrintTLSCertificate(out, "\t", cert)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
+
+// expiringBefore returns the certificates whose expiry date is before the
+// given deadline, ordered by expiry date.
+func expiringBefore(certs []*fastly.CustomTLSCertificate, deadline time.Time) []*fastly.CustomTLSCertificate {
+	var expiring []*fastly.CustomTLSCertificate
+	for _, cert := range certs {
+		if cert.NotAfter != nil && cert.NotAfter.Before(deadline) {
+			expiring = append(expiring, cert)
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].NotAfter.Before(*expiring[j].NotAfter)
+	})
+	return expiring
+}
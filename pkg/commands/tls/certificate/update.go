@@ -0,0 +1,43 @@
+package certificate
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to replace a custom TLS certificate.
+type UpdateCommand struct {
+	cmd.Base
+	Input fastly.UpdateCustomTLSCertificateInput
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Replace a custom TLS certificate with a newly reissued one. Any domains missing from the new certificate will have TLS disabled")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying a certificate").Required().StringVar(&c.Input.ID)
+	c.CmdClause.Flag("cert-blob", "PEM-formatted certificate passed as file path or content, e.g. $(< cert.pem)").Required().StringVar(&c.Input.CertBlob)
+	c.CmdClause.Flag("name", "A customizable name for the certificate").Short('n').StringVar(&c.Input.Name)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	c.Input.CertBlob = cmd.Content(c.Input.CertBlob)
+
+	cert, err := c.Globals.Client.UpdateCustomTLSCertificate(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.Input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated TLS certificate %s (id: %s)", cert.Name, cert.ID)
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package tls contains commands to manage TLS for domains served by Fastly.
+package tls
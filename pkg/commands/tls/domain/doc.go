@@ -0,0 +1,2 @@
+// Package domain contains commands to inspect TLS domains.
+package domain
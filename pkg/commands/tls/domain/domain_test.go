@@ -0,0 +1,111 @@
+package domain_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestDomainList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls domain list"),
+			api: mock.API{
+				ListTLSDomainsFn: func(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls domain list --filter-in-use"),
+			api: mock.API{
+				ListTLSDomainsFn: listTLSDomainsOK,
+			},
+			wantOutput: strings.TrimSpace(`
+ID               TYPE  ACTIVATIONS  CERTIFICATES  SUBSCRIPTIONS
+www.example.com  tls   1            2             0
+`) + "\n",
+		},
+		{
+			args: args("tls domain list --verbose"),
+			api: mock.API{
+				ListTLSDomainsFn: listTLSDomainsOK,
+			},
+			wantOutput: strings.Join([]string{
+				"Fastly API token not provided",
+				"Fastly API endpoint: https://api.fastly.com",
+				"Domain 1/1",
+				"	ID: www.example.com",
+				"	Type: tls",
+				"	Activation ID: act-1",
+				"	Certificate ID: cert-1",
+				"	Certificate ID: cert-2",
+			}, "\n") + "\n\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestDomainListPagination(t *testing.T) {
+	var pages []int
+	api := mock.API{
+		ListTLSDomainsFn: func(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+			pages = append(pages, i.PageNumber)
+			n := i.PageSize
+			if i.PageNumber == 2 {
+				n = 1
+			}
+			domains := make([]*fastly.TLSDomain, n)
+			for j := range domains {
+				domains[j] = &fastly.TLSDomain{ID: fmt.Sprintf("domain-%d-%d", i.PageNumber, j)}
+			}
+			return domains, nil
+		},
+	}
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("tls domain list --verbose"), &stdout)
+	opts.APIClient = mock.APIClient(api)
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, []int{1, 2}, pages)
+	testutil.AssertStringContains(t, stdout.String(), "Domain 101/101")
+}
+
+var errTest = errors.New("fixture error")
+
+func listTLSDomainsOK(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	if i.FilterInUse != nil && !*i.FilterInUse {
+		return nil, errTest
+	}
+	return []*fastly.TLSDomain{
+		{
+			ID:           "www.example.com",
+			Type:         "tls",
+			Activations:  []*fastly.TLSActivation{{ID: "act-1"}},
+			Certificates: []*fastly.CustomTLSCertificate{{ID: "cert-1"}, {ID: "cert-2"}},
+		},
+	}, nil
+}
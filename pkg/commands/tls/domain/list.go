@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// pageSize is the number of domains requested per page.
+const pageSize = 100
+
+// ListCommand calls the Fastly API to list TLS domains.
+type ListCommand struct {
+	cmd.Base
+	Input       fastly.ListTLSDomainsInput
+	filterInUse cmd.OptionalBool
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List domains listed on TLS certificates and subscriptions")
+	c.CmdClause.Flag("filter-in-use", "Limit the returned domains to those currently using Fastly to terminate TLS").Action(c.filterInUse.Set).BoolVar(&c.filterInUse.Value)
+	c.CmdClause.Flag("filter-cert-id", "Limit the returned domains to those listed on the given certificate").StringVar(&c.Input.FilterTLSCertificateID)
+	c.CmdClause.Flag("filter-subscription-id", "Limit the returned domains to those for the given subscription").StringVar(&c.Input.FilterTLSSubscriptionID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.filterInUse.WasSet {
+		c.Input.FilterInUse = fastly.Bool(c.filterInUse.Value)
+	}
+	c.Input.Include = "tls_activations,tls_certificates,tls_subscriptions"
+
+	var domains []*fastly.TLSDomain
+	c.Input.PageSize = pageSize
+	for c.Input.PageNumber = 1; ; c.Input.PageNumber++ {
+		page, err := c.Globals.Client.ListTLSDomains(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Page": c.Input.PageNumber,
+			})
+			return err
+		}
+		domains = append(domains, page...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, domains)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "TYPE", "ACTIVATIONS", "CERTIFICATES", "SUBSCRIPTIONS")
+		for _, d := range domains {
+			tw.AddLine(d.ID, d.Type, len(d.Activations), len(d.Certificates), len(d.Subscriptions))
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, d := range domains {
+		fmt.Fprintf(out, "Domain %d/%d\n", i+1, len(domains))
+		text.PrintTLSDomain(out, "\t", d)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
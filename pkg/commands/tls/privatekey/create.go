@@ -0,0 +1,42 @@
+package privatekey
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CreateCommand calls the Fastly API to upload a TLS private key.
+type CreateCommand struct {
+	cmd.Base
+	Input fastly.CreatePrivateKeyInput
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Upload a TLS private key").Alias("add")
+	c.CmdClause.Flag("name", "A customizable name for the private key").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("key", "PEM-formatted private key passed as file path or content, e.g. $(< key.pem)").Required().StringVar(&c.Input.Key)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	c.Input.Key = cmd.Content(c.Input.Key)
+
+	key, err := c.Globals.Client.CreatePrivateKey(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Name": c.Input.Name,
+		})
+		return err
+	}
+
+	text.Success(out, "Created TLS private key %s (id: %s)", key.Name, key.ID)
+	return nil
+}
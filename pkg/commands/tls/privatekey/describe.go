@@ -0,0 +1,43 @@
+package privatekey
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a TLS private key.
+type DescribeCommand struct {
+	cmd.Base
+	Input fastly.GetPrivateKeyInput
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("describe", "Show detailed information about a TLS private key").Alias("get")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying a private key").Required().StringVar(&c.Input.ID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	key, err := c.Globals.Client.GetPrivateKey(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.Input.ID,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSON(out, key)
+	}
+
+	text.PrintTLSPrivateKey(out, "", key)
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package privatekey contains commands to manage TLS private keys.
+package privatekey
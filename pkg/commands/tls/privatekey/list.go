@@ -0,0 +1,75 @@
+package privatekey
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// pageSize is the number of private keys requested per page.
+const pageSize = 100
+
+// ListCommand calls the Fastly API to list TLS private keys.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListPrivateKeysInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List TLS private keys")
+	c.CmdClause.Flag("filter-in-use", "Limit the returned keys to those without any matching TLS certificates (false) or with one (true)").HintOptions("true", "false").EnumVar(&c.Input.FilterInUse, "true", "false")
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	var keys []*fastly.PrivateKey
+	c.Input.PageSize = pageSize
+	for c.Input.PageNumber = 1; ; c.Input.PageNumber++ {
+		page, err := c.Globals.Client.ListPrivateKeys(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Page": c.Input.PageNumber,
+			})
+			return err
+		}
+		keys = append(keys, page...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, keys)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME", "KEY TYPE", "KEY LENGTH", "CREATED (UTC)")
+		for _, key := range keys {
+			var created string
+			if key.CreatedAt != nil {
+				created = key.CreatedAt.UTC().Format(time.Format)
+			}
+			tw.AddLine(key.ID, key.Name, key.KeyType, key.KeyLength, created)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, key := range keys {
+		fmt.Fprintf(out, "Private Key %d/%d\n", i+1, len(keys))
+		text.PrintTLSPrivateKey(out, "\t", key)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package subscription
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// CertificateAuthorities is the list of supported certificate authorities.
+var CertificateAuthorities = []string{"lets-encrypt", "globalsign"}
+
+// CreateCommand calls the Fastly API to create a TLS subscription.
+type CreateCommand struct {
+	cmd.Base
+	certAuthority string
+	commonName    string
+	configID      string
+	domains       []string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Create a TLS subscription so that Fastly issues and renews certificates for the given domains").Alias("add")
+	c.CmdClause.Flag("domain", "Domain to enable TLS for (set flag multiple times to include multiple domains)").Short('d').Required().StringsVar(&c.domains)
+	c.CmdClause.Flag("common-name", "The domain to use as the certificate's Common Name. Must be one of the given domains (defaults to the first domain)").StringVar(&c.commonName)
+	c.CmdClause.Flag("cert-authority", "The entity that issues and certifies the certificates. Valid values are: lets-encrypt, globalsign").Default("lets-encrypt").HintOptions(CertificateAuthorities...).EnumVar(&c.certAuthority, CertificateAuthorities...)
+	c.CmdClause.Flag("config-id", "Alphanumeric string identifying a TLS configuration (defaults to the account's default configuration)").StringVar(&c.configID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	input := fastly.CreateTLSSubscriptionInput{
+		CertificateAuthority: c.certAuthority,
+		Domains:              tlsDomains(c.domains),
+	}
+	if c.commonName != "" {
+		input.CommonName = &fastly.TLSDomain{ID: c.commonName}
+	}
+	if c.configID != "" {
+		input.Configuration = &fastly.TLSConfiguration{ID: c.configID}
+	}
+
+	subscription, err := c.Globals.Client.CreateTLSSubscription(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Domains":     c.domains,
+			"Common Name": c.commonName,
+		})
+		return err
+	}
+
+	text.Success(out, "Created TLS subscription %s (state: %s)", subscription.ID, subscription.State)
+	return nil
+}
+
+// tlsDomains converts a list of domain names into the TLS domain references
+// expected by the API.
+func tlsDomains(names []string) []*fastly.TLSDomain {
+	domains := make([]*fastly.TLSDomain, 0, len(names))
+	for _, name := range names {
+		domains = append(domains, &fastly.TLSDomain{ID: name})
+	}
+	return domains
+}
@@ -0,0 +1,3 @@
+// Package subscription contains commands to manage Fastly managed TLS
+// subscriptions.
+package subscription
@@ -0,0 +1,78 @@
+package subscription
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// States is the list of subscription states that can be filtered on.
+var States = []string{"pending", "processing", "issued", "renewing"}
+
+// pageSize is the number of subscriptions requested per page.
+const pageSize = 100
+
+// ListCommand calls the Fastly API to list TLS subscriptions.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListTLSSubscriptionsInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List TLS subscriptions")
+	c.CmdClause.Flag("filter-state", "Limit the returned subscriptions by state. Valid values are: pending, processing, issued, renewing").HintOptions(States...).EnumVar(&c.Input.FilterState, States...)
+	c.CmdClause.Flag("filter-domain", "Limit the returned subscriptions to those that include the given domain").StringVar(&c.Input.FilterTLSDomainsID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	var subscriptions []*fastly.TLSSubscription
+	c.Input.PageSize = pageSize
+	for c.Input.PageNumber = 1; ; c.Input.PageNumber++ {
+		page, err := c.Globals.Client.ListTLSSubscriptions(&c.Input)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Page": c.Input.PageNumber,
+			})
+			return err
+		}
+		subscriptions = append(subscriptions, page...)
+		if len(page) < pageSize {
+			break
+		}
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, subscriptions)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "STATE", "CERTIFICATE AUTHORITY", "COMMON NAME", "DOMAINS")
+		for _, s := range subscriptions {
+			var commonName string
+			if s.CommonName != nil {
+				commonName = s.CommonName.ID
+			}
+			tw.AddLine(s.ID, s.State, s.CertificateAuthority, commonName, text.TLSDomainIDs(s.Domains))
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, s := range subscriptions {
+		fmt.Fprintf(out, "Subscription %d/%d\n", i+1, len(subscriptions))
+		text.PrintTLSSubscription(out, "\t", s)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
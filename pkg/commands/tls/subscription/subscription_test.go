@@ -0,0 +1,300 @@
+package subscription_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestSubscriptionCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls subscription create"),
+			wantError: "error parsing arguments: required flag --domain not provided",
+		},
+		{
+			args:      args("tls subscription create --domain example.com --cert-authority acme"),
+			wantError: "error parsing arguments: enum value must be one of lets-encrypt,globalsign, got 'acme'",
+		},
+		{
+			args: args("tls subscription create --domain example.com"),
+			api: mock.API{
+				CreateTLSSubscriptionFn: func(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls subscription create --domain example.com --domain www.example.com --common-name www.example.com"),
+			api: mock.API{
+				CreateTLSSubscriptionFn: func(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+					if len(i.Domains) != 2 || i.Domains[1].ID != "www.example.com" || i.CommonName.ID != "www.example.com" || i.CertificateAuthority != "lets-encrypt" {
+						return nil, errTest
+					}
+					return &fastly.TLSSubscription{ID: "sub-1", State: "pending"}, nil
+				},
+			},
+			wantOutput: "Created TLS subscription sub-1 (state: pending)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestSubscriptionList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls subscription list --filter-state done"),
+			wantError: "error parsing arguments: enum value must be one of pending,processing,issued,renewing, got 'done'",
+		},
+		{
+			args: args("tls subscription list"),
+			api: mock.API{
+				ListTLSSubscriptionsFn: func(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls subscription list --filter-state pending"),
+			api: mock.API{
+				ListTLSSubscriptionsFn: listTLSSubscriptionsOK,
+			},
+			wantOutput: strings.TrimSpace(`
+ID     STATE    CERTIFICATE AUTHORITY  COMMON NAME  DOMAINS
+sub-1  pending  lets-encrypt           example.com  example.com, www.example.com
+`) + "\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestSubscriptionListPagination(t *testing.T) {
+	var pages []int
+	api := mock.API{
+		ListTLSSubscriptionsFn: func(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+			pages = append(pages, i.PageNumber)
+			n := i.PageSize
+			if i.PageNumber == 2 {
+				n = 1
+			}
+			subscriptions := make([]*fastly.TLSSubscription, n)
+			for j := range subscriptions {
+				subscriptions[j] = &fastly.TLSSubscription{ID: fmt.Sprintf("subscription-%d-%d", i.PageNumber, j)}
+			}
+			return subscriptions, nil
+		},
+	}
+
+	var stdout bytes.Buffer
+	opts := testutil.NewRunOpts(testutil.Args("tls subscription list --verbose"), &stdout)
+	opts.APIClient = mock.APIClient(api)
+	err := app.Run(opts)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, []int{1, 2}, pages)
+	testutil.AssertStringContains(t, stdout.String(), "Subscription 101/101")
+}
+
+func TestSubscriptionDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls subscription describe --id sub-1"),
+			api: mock.API{
+				GetTLSSubscriptionFn: func(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls subscription describe --id sub-1"),
+			api: mock.API{
+				GetTLSSubscriptionFn: func(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+					if i.Include == nil || *i.Include != "tls_authorizations" {
+						return nil, errTest
+					}
+					subscriptions, _ := listTLSSubscriptionsOK(nil)
+					return subscriptions[0], nil
+				},
+			},
+			wantOutput: strings.Join([]string{
+				"ID: sub-1",
+				"State: pending",
+				"Certificate Authority: lets-encrypt",
+				"Common Name: example.com",
+				"Domains: example.com, www.example.com",
+				"Authorization auth-1 (pending)",
+				"	Challenge: managed-dns",
+				"		Record: CNAME _acme-challenge.example.com",
+				"		Values: abc.fastly-validations.com",
+				"Created (UTC): 2021-06-15 23:00",
+			}, "\n") + "\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestSubscriptionUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("tls subscription update --domain example.com"),
+			wantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			args: args("tls subscription update --id sub-1 --domain example.com"),
+			api: mock.API{
+				UpdateTLSSubscriptionFn: func(i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls subscription update --id sub-1 --domain example.com --force"),
+			api: mock.API{
+				UpdateTLSSubscriptionFn: func(i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+					if !i.Force || len(i.Domains) != 1 || i.CommonName != nil {
+						return nil, errTest
+					}
+					return &fastly.TLSSubscription{ID: i.ID, State: "processing"}, nil
+				},
+			},
+			wantOutput: "Updated TLS subscription sub-1 (state: processing)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestSubscriptionDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("tls subscription delete --id sub-1"),
+			api: mock.API{
+				DeleteTLSSubscriptionFn: func(i *fastly.DeleteTLSSubscriptionInput) error {
+					return errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("tls subscription delete --id sub-1 --force"),
+			api: mock.API{
+				DeleteTLSSubscriptionFn: func(i *fastly.DeleteTLSSubscriptionInput) error {
+					if !i.Force {
+						return errTest
+					}
+					return nil
+				},
+			},
+			wantOutput: "Deleted TLS subscription sub-1",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func listTLSSubscriptionsOK(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	return []*fastly.TLSSubscription{
+		{
+			ID:                   "sub-1",
+			State:                "pending",
+			CertificateAuthority: "lets-encrypt",
+			CommonName:           &fastly.TLSDomain{ID: "example.com"},
+			Domains:              []*fastly.TLSDomain{{ID: "example.com"}, {ID: "www.example.com"}},
+			Authorizations: []*fastly.TLSAuthorizations{
+				{
+					ID:    "auth-1",
+					State: "pending",
+					Challenges: []fastly.TLSChallenge{
+						{
+							Type:       "managed-dns",
+							RecordType: "CNAME",
+							RecordName: "_acme-challenge.example.com",
+							Values:     []string{"abc.fastly-validations.com"},
+						},
+					},
+				},
+			},
+			CreatedAt: testutil.MustParseTimeRFC3339("2021-06-15T23:00:00Z"),
+		},
+	}, nil
+}
@@ -0,0 +1,61 @@
+package subscription
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update a TLS subscription.
+type UpdateCommand struct {
+	cmd.Base
+	commonName string
+	configID   string
+	domains    []string
+	force      bool
+	id         string
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Update a TLS subscription. Subscriptions can only be updated once issued, unless forced")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying a TLS subscription").Required().StringVar(&c.id)
+	c.CmdClause.Flag("domain", "Domain to enable TLS for (set flag multiple times to include multiple domains). Replaces the existing domains").Short('d').StringsVar(&c.domains)
+	c.CmdClause.Flag("common-name", "The domain to use as the certificate's Common Name").StringVar(&c.commonName)
+	c.CmdClause.Flag("config-id", "Alphanumeric string identifying a TLS configuration").StringVar(&c.configID)
+	c.CmdClause.Flag("force", "Apply the update even if domains are active. Warning: can disable production traffic").BoolVar(&c.force)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	input := fastly.UpdateTLSSubscriptionInput{
+		ID:    c.id,
+		Force: c.force,
+	}
+	if len(c.domains) > 0 {
+		input.Domains = tlsDomains(c.domains)
+	}
+	if c.commonName != "" {
+		input.CommonName = &fastly.TLSDomain{ID: c.commonName}
+	}
+	if c.configID != "" {
+		input.Configuration = &fastly.TLSConfiguration{ID: c.configID}
+	}
+
+	subscription, err := c.Globals.Client.UpdateTLSSubscription(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.id,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated TLS subscription %s (state: %s)", subscription.ID, subscription.State)
+	return nil
+}
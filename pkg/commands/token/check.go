@@ -0,0 +1,117 @@
+package token
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// localCommands are commands that never call the Fastly API and therefore
+// don't require a token with any particular scope.
+var localCommands = []string{
+	"compute build",
+	"compute init",
+	"compute pack",
+	"compute serve",
+	"compute validate",
+	"configure",
+	"help",
+	"ip-list",
+	"update",
+	"version",
+}
+
+// readCommands are the final words of commands that only read data and so can
+// be performed by a token with the global:read scope.
+var readCommands = []string{
+	"describe",
+	"diff",
+	"export",
+	"historical",
+	"list",
+	"plan",
+	"pops",
+	"realtime",
+	"regions",
+	"search",
+	"whoami",
+}
+
+// RequiredScopes returns the token scopes, any one of which is sufficient to
+// run the given command (as returned by cmd.Command.Name). A global token can
+// run any command and so is always implied.
+//
+// A nil slice is returned for commands that don't call the Fastly API, or that
+// manage tokens and credentials themselves, as there is nothing to check.
+func RequiredScopes(command string) []fastly.TokenScope {
+	for _, c := range localCommands {
+		if command == c {
+			return nil
+		}
+	}
+	for _, prefix := range []string{"credentials", "profile", "token"} {
+		if command == prefix || strings.HasPrefix(command, prefix+" ") {
+			return nil
+		}
+	}
+
+	if command == "purge" {
+		return []fastly.TokenScope{fastly.PurgeSelectScope, fastly.PurgeAllScope}
+	}
+
+	words := strings.Fields(command)
+	if len(words) > 0 {
+		last := words[len(words)-1]
+		for _, c := range readCommands {
+			if last == c {
+				return []fastly.TokenScope{fastly.GlobalReadScope}
+			}
+		}
+	}
+
+	return []fastly.TokenScope{fastly.GlobalScope}
+}
+
+// HasScope reports whether a token with the given (space separated) scope is
+// able to perform an action requiring any one of the required scopes.
+func HasScope(scope fastly.TokenScope, required []fastly.TokenScope) bool {
+	if len(required) == 0 {
+		return true
+	}
+	for _, s := range strings.Fields(string(scope)) {
+		if s == string(fastly.GlobalScope) {
+			return true
+		}
+		for _, r := range required {
+			if s == string(r) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// HasService reports whether a token has access to the given service. Tokens
+// that aren't limited to specific services have access to all of them.
+func HasService(t *fastly.Token, serviceID string) bool {
+	if len(t.Services) == 0 || serviceID == "" {
+		return true
+	}
+	for _, s := range t.Services {
+		if s == serviceID {
+			return true
+		}
+	}
+	return false
+}
+
+// ExpiresWithin reports whether a token with the given expiry time will have
+// expired before the window (relative to now) has elapsed. Tokens without an
+// expiry time never expire.
+func ExpiresWithin(expires *time.Time, window time.Duration, now time.Time) bool {
+	if expires == nil {
+		return false
+	}
+	return expires.Before(now.Add(window))
+}
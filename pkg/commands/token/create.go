@@ -0,0 +1,103 @@
+package token
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	fsttime "github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Scopes is the list of supported token scopes.
+var Scopes = []string{
+	string(fastly.GlobalScope),
+	string(fastly.PurgeSelectScope),
+	string(fastly.PurgeAllScope),
+	string(fastly.GlobalReadScope),
+}
+
+// CreateCommand calls the Fastly API to create an API token.
+type CreateCommand struct {
+	cmd.Base
+	expires  string
+	name     string
+	password string
+	scopes   []string
+	services []string
+	username string
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Create an API token. Tokens are created for the given user, which must re-authenticate with their password").Alias("add")
+	c.CmdClause.Flag("name", "A name for the token").Short('n').StringVar(&c.name)
+	c.CmdClause.Flag("username", "The login of the user the token is created for (defaults to the user of the current API token)").StringVar(&c.username)
+	c.CmdClause.Flag("password", "The password of the user (prompted for if not provided)").StringVar(&c.password)
+	c.CmdClause.Flag("scope", "An authorization scope for the token (set flag multiple times to include multiple scopes). Valid values are: global, purge_select, purge_all, global:read").HintOptions(Scopes...).EnumsVar(&c.scopes, Scopes...)
+	c.CmdClause.Flag("services", "Limit the token to the given service ID (set flag multiple times to include multiple services)").StringsVar(&c.services)
+	c.CmdClause.Flag("expires", "Time the token expires, in RFC 3339 format (e.g. 2021-12-31T23:59:59Z)").StringVar(&c.expires)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	input := fastly.CreateTokenInput{
+		Name:     c.name,
+		Username: c.username,
+		Password: c.password,
+		Scope:    fastly.TokenScope(strings.Join(c.scopes, " ")),
+		Services: c.services,
+	}
+
+	if c.expires != "" {
+		expires, err := time.Parse(time.RFC3339, c.expires)
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error parsing --expires (expected RFC 3339 format, e.g. 2021-12-31T23:59:59Z): %w", err)
+		}
+		input.ExpiresAt = &expires
+	}
+
+	if input.Username == "" {
+		user, err := c.Globals.Client.GetCurrentUser()
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error fetching current user: %w", err)
+		}
+		input.Username = user.Login
+	}
+
+	if input.Password == "" {
+		password, err := text.InputSecure(out, fmt.Sprintf("Password for %s: ", input.Username), in)
+		if err != nil {
+			return err
+		}
+		text.Break(out)
+		input.Password = password
+	}
+
+	token, err := c.Globals.Client.CreateToken(&input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Username": input.Username,
+			"Name":     input.Name,
+			"Scope":    input.Scope,
+			"Services": input.Services,
+		})
+		return err
+	}
+
+	expires := "never"
+	if token.ExpiresAt != nil {
+		expires = token.ExpiresAt.UTC().Format(fsttime.Format)
+	}
+	text.Success(out, "Created token '%s' (name: %s, id: %s, scope: %s, expires: %s)", token.AccessToken, token.Name, token.ID, token.Scope, expires)
+	return nil
+}
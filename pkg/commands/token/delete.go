@@ -0,0 +1,55 @@
+package token
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DeleteCommand calls the Fastly API to revoke an API token.
+type DeleteCommand struct {
+	cmd.Base
+	Input   fastly.DeleteTokenInput
+	current bool
+}
+
+// NewDeleteCommand returns a usable command registered under the parent.
+func NewDeleteCommand(parent cmd.Registerer, globals *config.Data) *DeleteCommand {
+	var c DeleteCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("delete", "Revoke an API token").Alias("remove")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the token").StringVar(&c.Input.TokenID)
+	c.CmdClause.Flag("current", "Revoke the token used to authenticate this request").BoolVar(&c.current)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DeleteCommand) Exec(in io.Reader, out io.Writer) error {
+	var err error
+	switch {
+	case c.current:
+		err = c.Globals.Client.DeleteTokenSelf()
+	case c.Input.TokenID != "":
+		err = c.Globals.Client.DeleteToken(&c.Input)
+	default:
+		return errors.ErrNoID
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Token ID": c.Input.TokenID,
+			"Current":  c.current,
+		})
+		return err
+	}
+
+	if c.current {
+		text.Success(out, "Revoked the current token")
+		return nil
+	}
+	text.Success(out, "Revoked token %s", c.Input.TokenID)
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package token contains commands to manage Fastly API tokens.
+package token
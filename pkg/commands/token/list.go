@@ -0,0 +1,79 @@
+package token
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list API tokens.
+type ListCommand struct {
+	cmd.Base
+	customerID string
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List API tokens of the current user, or of every user of a customer account")
+	c.CmdClause.Flag("customer-id", "Alphanumeric string identifying the customer account whose tokens should be listed (requires superuser role)").StringVar(&c.customerID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	var (
+		tokens []*fastly.Token
+		err    error
+	)
+	if c.customerID != "" {
+		tokens, err = c.Globals.Client.ListCustomerTokens(&fastly.ListCustomerTokensInput{
+			CustomerID: c.customerID,
+		})
+	} else {
+		tokens, err = c.Globals.Client.ListTokens()
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Customer ID": c.customerID,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, tokens)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "NAME", "USER ID", "SCOPE", "SERVICES", "EXPIRES (UTC)")
+		for _, t := range tokens {
+			expires := "never"
+			if t.ExpiresAt != nil {
+				expires = t.ExpiresAt.UTC().Format(time.Format)
+			}
+			services := "all"
+			if len(t.Services) > 0 {
+				services = strings.Join(t.Services, ", ")
+			}
+			tw.AddLine(t.ID, t.Name, t.UserID, t.Scope, services, expires)
+		}
+		tw.Print()
+		return nil
+	}
+
+	for i, t := range tokens {
+		fmt.Fprintf(out, "Token %d/%d\n", i+1, len(tokens))
+		text.PrintToken(out, "\t", t)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
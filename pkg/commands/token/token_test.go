@@ -0,0 +1,266 @@
+package token_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/commands/token"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestTokenCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		stdin      string
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("token create --scope everything"),
+			wantError: "error parsing arguments: enum value must be one of global,purge_select,purge_all,global:read, got 'everything'",
+		},
+		{
+			args:      args("token create --username alice@example.com --password secret --expires tomorrow"),
+			wantError: "error parsing --expires",
+		},
+		{
+			args: args("token create --username alice@example.com --password secret"),
+			api: mock.API{
+				CreateTokenFn: func(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("token create --name ci --password secret --scope purge_select --scope global:read --services 123 --services 456 --expires 2021-12-31T23:59:59Z"),
+			api: mock.API{
+				GetCurrentUserFn: func() (*fastly.User, error) {
+					return &fastly.User{Login: "alice@example.com"}, nil
+				},
+				CreateTokenFn: createTokenOK,
+			},
+			wantOutput: "Created token 'abcdef' (name: ci, id: tok-1, scope: purge_select global:read, expires: 2021-12-31 23:59)",
+		},
+		{
+			args: args("token create --name ci --username alice@example.com --scope purge_select --scope global:read --services 123 --services 456 --expires 2021-12-31T23:59:59Z"),
+			api: mock.API{
+				CreateTokenFn: createTokenOK,
+			},
+			stdin:      "secret",
+			wantOutput: "Created token 'abcdef'",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			opts.Stdin = strings.NewReader(testcase.stdin)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestTokenList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("token list"),
+			api: mock.API{
+				ListTokensFn: func() ([]*fastly.Token, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("token list"),
+			api: mock.API{
+				ListTokensFn: listTokensOK,
+			},
+			wantOutput: strings.TrimSpace(`
+ID     NAME    USER ID  SCOPE         SERVICES  EXPIRES (UTC)
+tok-1  ci      123      purge_select  456, 789  2021-12-31 23:59
+tok-2  laptop  123      global        all       never
+`) + "\n",
+		},
+		{
+			args: args("token list --customer-id abc"),
+			api: mock.API{
+				ListCustomerTokensFn: func(i *fastly.ListCustomerTokensInput) ([]*fastly.Token, error) {
+					if i.CustomerID != "abc" {
+						return nil, errTest
+					}
+					return listTokensOK()
+				},
+			},
+			wantOutput: "tok-2  laptop  123      global        all       never",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestTokenDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("token delete"),
+			wantError: "no ID found",
+		},
+		{
+			args: args("token delete --id tok-1"),
+			api: mock.API{
+				DeleteTokenFn: func(i *fastly.DeleteTokenInput) error {
+					return errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("token delete --id tok-1"),
+			api: mock.API{
+				DeleteTokenFn: func(i *fastly.DeleteTokenInput) error {
+					return nil
+				},
+			},
+			wantOutput: "Revoked token tok-1",
+		},
+		{
+			args: args("token delete --current"),
+			api: mock.API{
+				DeleteTokenSelfFn: func() error {
+					return nil
+				},
+			},
+			wantOutput: "Revoked the current token",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func createTokenOK(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	if i.Username != "alice@example.com" || i.Password != "secret" || len(i.Services) != 2 || i.ExpiresAt == nil {
+		return nil, errTest
+	}
+	return &fastly.Token{
+		ID:          "tok-1",
+		Name:        i.Name,
+		AccessToken: "abcdef",
+		Scope:       i.Scope,
+		Services:    i.Services,
+		ExpiresAt:   i.ExpiresAt,
+	}, nil
+}
+
+func listTokensOK() ([]*fastly.Token, error) {
+	return []*fastly.Token{
+		{
+			ID:        "tok-1",
+			Name:      "ci",
+			UserID:    "123",
+			Scope:     fastly.PurgeSelectScope,
+			Services:  []string{"456", "789"},
+			ExpiresAt: testutil.MustParseTimeRFC3339("2021-12-31T23:59:59Z"),
+		},
+		{
+			ID:     "tok-2",
+			Name:   "laptop",
+			UserID: "123",
+			Scope:  fastly.GlobalScope,
+		},
+	}, nil
+}
+
+func TestRequiredScopes(t *testing.T) {
+	for _, testcase := range []struct {
+		command string
+		want    []fastly.TokenScope
+	}{
+		{command: "version"},
+		{command: "compute build"},
+		{command: "profile create"},
+		{command: "token list"},
+		{command: "purge", want: []fastly.TokenScope{fastly.PurgeSelectScope, fastly.PurgeAllScope}},
+		{command: "backend list", want: []fastly.TokenScope{fastly.GlobalReadScope}},
+		{command: "stats historical", want: []fastly.TokenScope{fastly.GlobalReadScope}},
+		{command: "whoami", want: []fastly.TokenScope{fastly.GlobalReadScope}},
+		{command: "backend create", want: []fastly.TokenScope{fastly.GlobalScope}},
+		{command: "compute deploy", want: []fastly.TokenScope{fastly.GlobalScope}},
+	} {
+		t.Run(testcase.command, func(t *testing.T) {
+			testutil.AssertEqual(t, testcase.want, token.RequiredScopes(testcase.command))
+		})
+	}
+}
+
+func TestHasScope(t *testing.T) {
+	for _, testcase := range []struct {
+		scope   fastly.TokenScope
+		command string
+		want    bool
+	}{
+		{scope: "global", command: "backend create", want: true},
+		{scope: "global", command: "purge", want: true},
+		{scope: "purge_select", command: "backend create", want: false},
+		{scope: "purge_select", command: "purge", want: true},
+		{scope: "global:read", command: "backend list", want: true},
+		{scope: "global:read", command: "backend update", want: false},
+		{scope: "purge_all global:read", command: "service describe", want: true},
+		{scope: "purge_select", command: "version", want: true},
+	} {
+		t.Run(fmt.Sprintf("%s %s", testcase.scope, testcase.command), func(t *testing.T) {
+			testutil.AssertBool(t, testcase.want, token.HasScope(testcase.scope, token.RequiredScopes(testcase.command)))
+		})
+	}
+}
+
+func TestExpiresWithin(t *testing.T) {
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	soon := now.Add(48 * time.Hour)
+	later := now.Add(30 * 24 * time.Hour)
+
+	testutil.AssertBool(t, false, token.ExpiresWithin(nil, config.DefaultTokenExpiryWarning, now))
+	testutil.AssertBool(t, true, token.ExpiresWithin(&soon, config.DefaultTokenExpiryWarning, now))
+	testutil.AssertBool(t, false, token.ExpiresWithin(&later, config.DefaultTokenExpiryWarning, now))
+	testutil.AssertBool(t, false, token.ExpiresWithin(&soon, 24*time.Hour, now))
+}
@@ -0,0 +1,45 @@
+package user
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// Roles is the list of supported user roles.
+var Roles = []string{"user", "billing", "engineer", "superuser"}
+
+// CreateCommand calls the Fastly API to create a user.
+type CreateCommand struct {
+	cmd.Base
+	Input fastly.CreateUserInput
+}
+
+// NewCreateCommand returns a usable command registered under the parent.
+func NewCreateCommand(parent cmd.Registerer, globals *config.Data) *CreateCommand {
+	var c CreateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("create", "Create a user of the Fastly account (an invitation email is sent to the login address)").Alias("add")
+	c.CmdClause.Flag("login", "The login (email address) of the user").Required().StringVar(&c.Input.Login)
+	c.CmdClause.Flag("name", "The real life name of the user").Short('n').Required().StringVar(&c.Input.Name)
+	c.CmdClause.Flag("role", "The permissions role assigned to the user. Valid values are: user, billing, engineer, superuser").HintOptions(Roles...).EnumVar(&c.Input.Role, Roles...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *CreateCommand) Exec(in io.Reader, out io.Writer) error {
+	user, err := c.Globals.Client.CreateUser(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Login": c.Input.Login,
+			"Role":  c.Input.Role,
+		})
+		return err
+	}
+
+	text.Success(out, "Created user %s (id: %s, role: %s)", user.Login, user.ID, user.Role)
+	return nil
+}
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// DescribeCommand calls the Fastly API to describe a user.
+type DescribeCommand struct {
+	cmd.Base
+	Input   fastly.GetUserInput
+	current bool
+}
+
+// NewDescribeCommand returns a usable command registered under the parent.
+func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCommand {
+	var c DescribeCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("describe", "Show detailed information about a user").Alias("get")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the user").StringVar(&c.Input.ID)
+	c.CmdClause.Flag("current", "Describe the user associated with the current API token").BoolVar(&c.current)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
+	var (
+		user *fastly.User
+		err  error
+	)
+	switch {
+	case c.current:
+		user, err = c.Globals.Client.GetCurrentUser()
+	case c.Input.ID != "":
+		user, err = c.Globals.Client.GetUser(&c.Input)
+	default:
+		return errors.ErrNoID
+	}
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID":      c.Input.ID,
+			"Current": c.current,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSON(out, user)
+	}
+
+	text.PrintUser(out, "", user)
+	return nil
+}
@@ -0,0 +1,2 @@
+// Package user contains commands to manage users of a Fastly account.
+package user
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// ListCommand calls the Fastly API to list the users of a customer account.
+type ListCommand struct {
+	cmd.Base
+	Input fastly.ListCustomerUsersInput
+}
+
+// NewListCommand returns a usable command registered under the parent.
+func NewListCommand(parent cmd.Registerer, globals *config.Data) *ListCommand {
+	var c ListCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("list", "List users of a Fastly account")
+	c.CmdClause.Flag("customer-id", "Alphanumeric string identifying the customer account (defaults to the account of the current API token)").StringVar(&c.Input.CustomerID)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *ListCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.Input.CustomerID == "" {
+		current, err := c.Globals.Client.GetCurrentUser()
+		if err != nil {
+			c.Globals.ErrLog.Add(err)
+			return fmt.Errorf("error fetching current user: %w", err)
+		}
+		c.Input.CustomerID = current.CustomerID
+	}
+
+	users, err := c.Globals.Client.ListCustomerUsers(&c.Input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Customer ID": c.Input.CustomerID,
+		})
+		return err
+	}
+
+	if c.JSONOutput() {
+		return c.WriteJSONLines(out, users)
+	}
+
+	if !c.Globals.Verbose() {
+		tw := text.NewTable(out)
+		tw.AddHeader("ID", "LOGIN", "NAME", "ROLE", "2FA", "LOCKED")
+		for _, user := range users {
+			tw.AddLine(user.ID, user.Login, user.Name, user.Role, user.TwoFactorAuthEnabled, user.Locked)
+		}
+		tw.Print()
+		return nil
+	}
+
+	fmt.Fprintf(out, "Customer ID: %s\n", c.Input.CustomerID)
+	for i, user := range users {
+		fmt.Fprintf(out, "\tUser %d/%d\n", i+1, len(users))
+		text.PrintUser(out, "\t\t", user)
+	}
+	fmt.Fprintln(out)
+
+	return nil
+}
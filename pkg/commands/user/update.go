@@ -0,0 +1,50 @@
+package user
+
+import (
+	"io"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// UpdateCommand calls the Fastly API to update a user.
+type UpdateCommand struct {
+	cmd.Base
+	input fastly.UpdateUserInput
+	name  cmd.OptionalString
+	role  cmd.OptionalString
+}
+
+// NewUpdateCommand returns a usable command registered under the parent.
+func NewUpdateCommand(parent cmd.Registerer, globals *config.Data) *UpdateCommand {
+	var c UpdateCommand
+	c.Globals = globals
+	c.CmdClause = parent.Command("update", "Update a user of the Fastly account")
+	c.CmdClause.Flag("id", "Alphanumeric string identifying the user").Required().StringVar(&c.input.ID)
+	c.CmdClause.Flag("name", "The real life name of the user").Short('n').Action(c.name.Set).StringVar(&c.name.Value)
+	c.CmdClause.Flag("role", "The permissions role assigned to the user. Valid values are: user, billing, engineer, superuser").Action(c.role.Set).HintOptions(Roles...).EnumVar(&c.role.Value, Roles...)
+	return &c
+}
+
+// Exec invokes the application logic for the command.
+func (c *UpdateCommand) Exec(in io.Reader, out io.Writer) error {
+	if c.name.WasSet {
+		c.input.Name = fastly.String(c.name.Value)
+	}
+	if c.role.WasSet {
+		c.input.Role = fastly.String(c.role.Value)
+	}
+
+	user, err := c.Globals.Client.UpdateUser(&c.input)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"ID": c.input.ID,
+		})
+		return err
+	}
+
+	text.Success(out, "Updated user %s (id: %s, role: %s)", user.Login, user.ID, user.Role)
+	return nil
+}
@@ -0,0 +1,309 @@
+package user_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/fastly/cli/pkg/app"
+	"github.com/fastly/cli/pkg/mock"
+	"github.com/fastly/cli/pkg/testutil"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+func TestUserCreate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("user create --name Alice"),
+			wantError: "error parsing arguments: required flag --login not provided",
+		},
+		{
+			args:      args("user create --login alice@example.com --name Alice --role admin"),
+			wantError: "error parsing arguments: enum value must be one of user,billing,engineer,superuser, got 'admin'",
+		},
+		{
+			args: args("user create --login alice@example.com --name Alice"),
+			api: mock.API{
+				CreateUserFn: func(i *fastly.CreateUserInput) (*fastly.User, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("user create --login alice@example.com --name Alice --role engineer"),
+			api: mock.API{
+				CreateUserFn: func(i *fastly.CreateUserInput) (*fastly.User, error) {
+					return &fastly.User{ID: "123", Login: i.Login, Name: i.Name, Role: i.Role}, nil
+				},
+			},
+			wantOutput: "Created user alice@example.com (id: 123, role: engineer)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestUserList(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("user list"),
+			api: mock.API{
+				GetCurrentUserFn: func() (*fastly.User, error) {
+					return nil, errTest
+				},
+			},
+			wantError: "error fetching current user: " + errTest.Error(),
+		},
+		{
+			args: args("user list --customer-id abc"),
+			api: mock.API{
+				ListCustomerUsersFn: func(i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("user list"),
+			api: mock.API{
+				GetCurrentUserFn:    getCurrentUserOK,
+				ListCustomerUsersFn: listCustomerUsersOK,
+			},
+			wantOutput: strings.TrimSpace(`
+ID   LOGIN              NAME   ROLE       2FA    LOCKED
+123  alice@example.com  Alice  superuser  true   false
+456  bob@example.com    Bob    engineer   false  true
+`) + "\n",
+		},
+		{
+			args: args("user list --customer-id abc --verbose"),
+			api: mock.API{
+				ListCustomerUsersFn: listCustomerUsersOK,
+			},
+			wantOutput: strings.Join([]string{
+				"Fastly API token not provided",
+				"Fastly API endpoint: https://api.fastly.com",
+				"Customer ID: abc",
+				"	User 1/2",
+				"		ID: 123",
+				"		Login: alice@example.com",
+				"		Name: Alice",
+				"		Role: superuser",
+				"		Customer ID: abc",
+				"		Email Hash: ",
+				"		Limit Services: false",
+				"		Locked: false",
+				"		Require New Password: false",
+				"		Two Factor Auth Enabled: true",
+				"		Two Factor Setup Required: false",
+				"	User 2/2",
+				"		ID: 456",
+				"		Login: bob@example.com",
+				"		Name: Bob",
+				"		Role: engineer",
+				"		Customer ID: abc",
+				"		Email Hash: ",
+				"		Limit Services: true",
+				"		Locked: true",
+				"		Require New Password: false",
+				"		Two Factor Auth Enabled: false",
+				"		Two Factor Setup Required: false",
+			}, "\n") + "\n\n",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertString(t, testcase.wantOutput, stdout.String())
+		})
+	}
+}
+
+func TestUserDescribe(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("user describe"),
+			wantError: "no ID found",
+		},
+		{
+			args: args("user describe --id 456"),
+			api: mock.API{
+				GetUserFn: func(i *fastly.GetUserInput) (*fastly.User, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("user describe --id 456"),
+			api: mock.API{
+				GetUserFn: func(i *fastly.GetUserInput) (*fastly.User, error) {
+					users, _ := listCustomerUsersOK(nil)
+					return users[1], nil
+				},
+			},
+			wantOutput: "Login: bob@example.com",
+		},
+		{
+			args: args("user describe --current"),
+			api: mock.API{
+				GetCurrentUserFn: getCurrentUserOK,
+			},
+			wantOutput: "Login: alice@example.com",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestUserUpdate(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args:      args("user update --role user"),
+			wantError: "error parsing arguments: required flag --id not provided",
+		},
+		{
+			args: args("user update --id 456 --role user"),
+			api: mock.API{
+				UpdateUserFn: func(i *fastly.UpdateUserInput) (*fastly.User, error) {
+					return nil, errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("user update --id 456 --role user"),
+			api: mock.API{
+				UpdateUserFn: func(i *fastly.UpdateUserInput) (*fastly.User, error) {
+					if i.Name != nil || i.Role == nil {
+						return nil, errTest
+					}
+					return &fastly.User{ID: i.ID, Login: "bob@example.com", Role: *i.Role}, nil
+				},
+			},
+			wantOutput: "Updated user bob@example.com (id: 456, role: user)",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+func TestUserDelete(t *testing.T) {
+	args := testutil.Args
+	for _, testcase := range []struct {
+		args       []string
+		api        mock.API
+		wantError  string
+		wantOutput string
+	}{
+		{
+			args: args("user delete --id 456"),
+			api: mock.API{
+				DeleteUserFn: func(i *fastly.DeleteUserInput) error {
+					return errTest
+				},
+			},
+			wantError: errTest.Error(),
+		},
+		{
+			args: args("user delete --id 456"),
+			api: mock.API{
+				DeleteUserFn: func(i *fastly.DeleteUserInput) error {
+					return nil
+				},
+			},
+			wantOutput: "Deleted user 456",
+		},
+	} {
+		t.Run(strings.Join(testcase.args, " "), func(t *testing.T) {
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(testcase.api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			testutil.AssertStringContains(t, stdout.String(), testcase.wantOutput)
+		})
+	}
+}
+
+var errTest = errors.New("fixture error")
+
+func getCurrentUserOK() (*fastly.User, error) {
+	users, _ := listCustomerUsersOK(nil)
+	return users[0], nil
+}
+
+func listCustomerUsersOK(i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+	if i != nil && i.CustomerID != "abc" {
+		return nil, errTest
+	}
+	return []*fastly.User{
+		{
+			ID:                   "123",
+			Login:                "alice@example.com",
+			Name:                 "Alice",
+			Role:                 "superuser",
+			CustomerID:           "abc",
+			TwoFactorAuthEnabled: true,
+		},
+		{
+			ID:            "456",
+			Login:         "bob@example.com",
+			Name:          "Bob",
+			Role:          "engineer",
+			CustomerID:    "abc",
+			LimitServices: true,
+			Locked:        true,
+		},
+	}, nil
+}
@@ -27,7 +27,7 @@ func NewDescribeCommand(parent cmd.Registerer, globals *config.Data) *DescribeCo
 	// Optional Flags
 	c.CmdClause.Flag("dynamic", "Whether the VCL snippet is dynamic or versioned").Action(c.dynamic.Set).BoolVar(&c.dynamic.Value)
 	c.CmdClause.Flag("name", "The name of the VCL snippet").StringVar(&c.name)
-	c.RegisterServiceIDFlag(&c.manifest.Flag.ServiceID)
+	c.RegisterServiceIDFlag(&c.manifest)
 	c.CmdClause.Flag("snippet-id", "Alphanumeric string identifying a VCL Snippet").Short('i').StringVar(&c.snippetID)
 
 	return &c
@@ -79,6 +79,9 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 			})
 			return err
 		}
+		if c.JSONOutput() {
+			return c.WriteJSON(out, v)
+		}
 		c.printDynamic(out, v)
 		return nil
 	}
@@ -99,6 +102,9 @@ func (c *DescribeCommand) Exec(in io.Reader, out io.Writer) error {
 		})
 		return err
 	}
+	if c.JSONOutput() {
+		return c.WriteJSON(out, v)
+	}
 	c.print(out, v)
 	return nil
 }
@@ -2,6 +2,8 @@ package snippet_test
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/fastly/cli/pkg/app"
@@ -540,6 +542,166 @@ func TestVCLSnippetUpdate(t *testing.T) {
 	}
 }
 
+func TestVCLSnippetSync(t *testing.T) {
+	args := testutil.Args
+	inSync := map[string]string{
+		"recv.blocklist.vcl":  "# dynamic content",
+		"deliver.headers.vcl": "# versioned content",
+		"fetch.same.vcl":      "# same content",
+		"README.md":           "ignored",
+	}
+	with := func(changes map[string]string) map[string]string {
+		files := make(map[string]string)
+		for k, v := range inSync {
+			files[k] = v
+		}
+		for k, v := range changes {
+			files[k] = v
+		}
+		return files
+	}
+
+	var versions []int
+	api := mock.API{
+		ListVersionsFn: testutil.ListVersions,
+		CloneVersionFn: testutil.CloneVersionResult(4),
+		ListSnippetsFn: listSnippetsForSync,
+		GetDynamicSnippetFn: func(i *fastly.GetDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+			return &fastly.DynamicSnippet{ID: i.ID, ServiceID: i.ServiceID, Content: "# dynamic content"}, nil
+		},
+		UpdateDynamicSnippetFn: func(i *fastly.UpdateDynamicSnippetInput) (*fastly.DynamicSnippet, error) {
+			if i.ID != "dyn" || *i.Content != "# new dynamic content" {
+				t.Errorf("unexpected dynamic snippet update: %s %s", i.ID, *i.Content)
+			}
+			return &fastly.DynamicSnippet{ID: i.ID, ServiceID: i.ServiceID, Content: *i.Content}, nil
+		},
+		CreateSnippetFn: func(i *fastly.CreateSnippetInput) (*fastly.Snippet, error) {
+			versions = append(versions, i.ServiceVersion)
+			return &fastly.Snippet{Name: i.Name, ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion}, nil
+		},
+		UpdateSnippetFn: func(i *fastly.UpdateSnippetInput) (*fastly.Snippet, error) {
+			versions = append(versions, i.ServiceVersion)
+			return &fastly.Snippet{Name: i.Name, ServiceID: i.ServiceID, ServiceVersion: i.ServiceVersion}, nil
+		},
+	}
+
+	for _, testcase := range []struct {
+		name         string
+		args         []string
+		files        map[string]string
+		wantError    string
+		wantOutput   []string
+		wantVersions []int
+	}{
+		{
+			name:      "validate missing --dir flag",
+			args:      args("vcl snippet sync --service-id 123 --version 1"),
+			wantError: "error parsing arguments: required flag --dir not provided",
+		},
+		{
+			name:      "validate file name without a type",
+			args:      args("vcl snippet sync --dir snippetDir --service-id 123 --version 1"),
+			files:     map[string]string{"blocklist.vcl": ""},
+			wantError: "invalid VCL snippet file name 'blocklist.vcl'",
+		},
+		{
+			name:      "validate file name with an invalid type",
+			args:      args("vcl snippet sync --dir snippetDir --service-id 123 --version 1"),
+			files:     map[string]string{"request.blocklist.vcl": ""},
+			wantError: "invalid VCL snippet type 'request' in file name 'request.blocklist.vcl'",
+		},
+		{
+			name:      "validate dynamic snippet type change",
+			args:      args("vcl snippet sync --dir snippetDir --service-id 123 --version 1"),
+			files:     map[string]string{"deliver.blocklist.vcl": "# dynamic content"},
+			wantError: "dynamic VCL snippet 'blocklist' has type 'recv' but its file has type 'deliver'",
+		},
+		{
+			name:       "validate already in sync",
+			args:       args("vcl snippet sync --dir snippetDir --service-id 123 --version 1"),
+			files:      inSync,
+			wantOutput: []string{"VCL snippets on service 123 version 1 are already in sync with"},
+		},
+		{
+			name:  "validate dynamic snippets are updated without a new version",
+			args:  args("vcl snippet sync --dir snippetDir --service-id 123 --version 1"),
+			files: with(map[string]string{"recv.blocklist.vcl": "# new dynamic content"}),
+			wantOutput: []string{
+				"~ recv.blocklist (dynamic)",
+				"Synced VCL snippets on service 123 (1 dynamic updated)",
+			},
+		},
+		{
+			name:      "validate versioned snippets require an editable version",
+			args:      args("vcl snippet sync --dir snippetDir --service-id 123 --version 1"),
+			files:     with(map[string]string{"deliver.headers.vcl": "# new versioned content"}),
+			wantError: "service version 1 is not editable",
+		},
+		{
+			name: "validate dry run",
+			args: args("vcl snippet sync --dir snippetDir --dry-run --service-id 123 --version 1"),
+			files: with(map[string]string{
+				"recv.blocklist.vcl":  "# new dynamic content",
+				"deliver.headers.vcl": "# new versioned content",
+				"log.new.vcl":         "# new snippet",
+			}),
+			wantOutput: []string{
+				"~ recv.blocklist (dynamic)",
+				"~ deliver.headers",
+				"+ log.new",
+				"Dry run: 1 dynamic snippet(s) would be updated, 1 versioned snippet(s) created and 1 updated",
+			},
+		},
+		{
+			name: "validate versioned snippets are synced on an autocloned version",
+			args: args("vcl snippet sync --autoclone --dir snippetDir --service-id 123 --version 1"),
+			files: map[string]string{
+				"recv.blocklist.vcl": "# new dynamic content",
+				"fetch.headers.vcl":  "# versioned content",
+				"fetch.same.vcl":     "# same content",
+				"log.new.vcl":        "# new snippet",
+			},
+			wantOutput: []string{
+				"~ fetch.headers",
+				"+ log.new",
+				"Synced VCL snippets on service 123 (1 dynamic updated, 1 created and 1 updated on version 4)",
+			},
+			wantVersions: []int{4, 4},
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			versions = nil
+			dir := t.TempDir()
+			for name, content := range testcase.files {
+				if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for i, v := range testcase.args {
+				if v == "snippetDir" {
+					testcase.args[i] = dir
+				}
+			}
+
+			var stdout bytes.Buffer
+			opts := testutil.NewRunOpts(testcase.args, &stdout)
+			opts.APIClient = mock.APIClient(api)
+			err := app.Run(opts)
+			testutil.AssertErrorContains(t, err, testcase.wantError)
+			for _, s := range testcase.wantOutput {
+				testutil.AssertStringContains(t, stdout.String(), s)
+			}
+			if testcase.wantVersions == nil {
+				testcase.wantVersions = []int{}
+			}
+			if versions == nil {
+				versions = []int{}
+			}
+			testutil.AssertEqual(t, testcase.wantVersions, versions)
+		})
+	}
+}
+
 func getSnippet(i *fastly.GetSnippetInput) (*fastly.Snippet, error) {
 	t := testutil.Date
 
@@ -606,3 +768,11 @@ func listSnippets(i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error) {
 	}
 	return vs, nil
 }
+
+func listSnippetsForSync(i *fastly.ListSnippetsInput) ([]*fastly.Snippet, error) {
+	return []*fastly.Snippet{
+		{ID: "dyn", Name: "blocklist", Type: fastly.SnippetTypeRecv, Dynamic: 1},
+		{ID: "hdr", Name: "headers", Type: fastly.SnippetTypeDeliver, Content: "# versioned content"},
+		{ID: "sam", Name: "same", Type: fastly.SnippetTypeFetch, Content: "# same content"},
+	}, nil
+}
@@ -0,0 +1,341 @@
+package snippet
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/compute/manifest"
+	"github.com/fastly/cli/pkg/config"
+	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// snippetTypes are the locations in generated VCL where a snippet can be
+// placed, and so the valid prefixes of a snippet file name.
+var snippetTypes = []fastly.SnippetType{
+	fastly.SnippetTypeInit,
+	fastly.SnippetTypeRecv,
+	fastly.SnippetTypeHash,
+	fastly.SnippetTypeHit,
+	fastly.SnippetTypeMiss,
+	fastly.SnippetTypePass,
+	fastly.SnippetTypeFetch,
+	fastly.SnippetTypeError,
+	fastly.SnippetTypeDeliver,
+	fastly.SnippetTypeLog,
+	fastly.SnippetTypeNone,
+}
+
+// NewSyncCommand returns a usable command registered under the parent.
+func NewSyncCommand(parent cmd.Registerer, globals *config.Data) *SyncCommand {
+	var c SyncCommand
+	c.CmdClause = parent.Command("sync", "Sync the VCL snippets of a service with a directory of <type>.<name>.vcl files")
+	c.Globals = globals
+	c.manifest.File.SetOutput(c.Globals.Output)
+	c.manifest.File.Read(manifest.Filename)
+
+	// Required flags
+	c.CmdClause.Flag("dir", "Directory of VCL snippet files, each named after the snippet's type and name, e.g. recv.blocklist.vcl").Required().StringVar(&c.dir)
+	c.RegisterServiceVersionFlag(cmd.ServiceVersionFlagOpts{
+		Dst: &c.serviceVersion.Value,
+	})
+
+	// Optional flags
+	c.RegisterAutoCloneFlag(cmd.AutoCloneFlagOpts{
+		Action: c.autoClone.Set,
+		Dst:    &c.autoClone.Value,
+	})
+	c.CmdClause.Flag("dry-run", "Print the changes that would be made without making them").BoolVar(&c.dryRun)
+	c.RegisterServiceIDFlag(&c.manifest)
+
+	return &c
+}
+
+// SyncCommand calls the Fastly API to make the VCL snippets of a service match
+// a directory of files.
+//
+// Dynamic snippets are updated in place, as their content isn't versioned.
+// Versioned snippets are created or updated on the given service version,
+// which is cloned first when --autoclone is set and it isn't editable.
+type SyncCommand struct {
+	cmd.Base
+
+	autoClone      cmd.OptionalAutoClone
+	dir            string
+	dryRun         bool
+	manifest       manifest.Data
+	serviceVersion cmd.OptionalServiceVersion
+}
+
+// File is a VCL snippet read from a sync directory.
+type File struct {
+	Name    string
+	Type    fastly.SnippetType
+	Content string
+}
+
+// syncPlan is the set of changes required to sync a service's snippets.
+type syncPlan struct {
+	dynamic []*fastly.UpdateDynamicSnippetInput
+	create  []*fastly.CreateSnippetInput
+	update  []*fastly.UpdateSnippetInput
+	summary []string
+}
+
+func (p syncPlan) versioned() bool {
+	return len(p.create) > 0 || len(p.update) > 0
+}
+
+// Exec invokes the application logic for the command.
+func (c *SyncCommand) Exec(in io.Reader, out io.Writer) error {
+	// The version is only cloned once we know there are versioned snippets to
+	// change, so that syncing dynamic snippets never creates a new version.
+	serviceID, serviceVersion, err := cmd.ServiceDetails(cmd.ServiceDetailsOpts{
+		AllowActiveLocked:  true,
+		Client:             c.Globals.Client,
+		Manifest:           c.manifest,
+		Out:                out,
+		ServiceVersionFlag: c.serviceVersion,
+		VerboseMode:        c.Globals.Flag.Verbose,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": errors.ServiceVersion(serviceVersion),
+		})
+		return err
+	}
+
+	files, err := ReadSnippets(c.dir)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Directory": c.dir,
+		})
+		return err
+	}
+
+	snippets, err := c.Globals.Client.ListSnippets(&fastly.ListSnippetsInput{
+		ServiceID:      serviceID,
+		ServiceVersion: serviceVersion.Number,
+	})
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	plan, err := c.plan(serviceID, files, snippets)
+	if err != nil {
+		c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+			"Service ID":      serviceID,
+			"Service Version": serviceVersion.Number,
+		})
+		return err
+	}
+
+	if len(plan.summary) == 0 {
+		text.Info(out, "VCL snippets on service %s version %d are already in sync with %s", serviceID, serviceVersion.Number, c.dir)
+		return nil
+	}
+	for _, line := range plan.summary {
+		text.Output(out, line)
+	}
+	if c.dryRun {
+		text.Info(out, "Dry run: %d dynamic snippet(s) would be updated, %d versioned snippet(s) created and %d updated", len(plan.dynamic), len(plan.create), len(plan.update))
+		return nil
+	}
+
+	if plan.versioned() {
+		v, err := c.autoClone.Parse(serviceVersion, serviceID, c.Globals.Verbose(), out, c.Globals.Client)
+		if err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+			})
+			return err
+		}
+		serviceVersion = v
+	}
+
+	for _, input := range plan.dynamic {
+		if _, err := c.Globals.Client.UpdateDynamicSnippet(input); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID": serviceID,
+				"Snippet ID": input.ID,
+			})
+			return err
+		}
+	}
+	for _, input := range plan.create {
+		input.ServiceVersion = serviceVersion.Number
+		if _, err := c.Globals.Client.CreateSnippet(input); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+				"Name":            input.Name,
+			})
+			return err
+		}
+	}
+	for _, input := range plan.update {
+		input.ServiceVersion = serviceVersion.Number
+		if _, err := c.Globals.Client.UpdateSnippet(input); err != nil {
+			c.Globals.ErrLog.AddWithContext(err, map[string]interface{}{
+				"Service ID":      serviceID,
+				"Service Version": serviceVersion.Number,
+				"Name":            input.Name,
+			})
+			return err
+		}
+	}
+
+	if plan.versioned() {
+		text.Success(out, "Synced VCL snippets on service %s (%d dynamic updated, %d created and %d updated on version %d)", serviceID, len(plan.dynamic), len(plan.create), len(plan.update), serviceVersion.Number)
+		return nil
+	}
+	text.Success(out, "Synced VCL snippets on service %s (%d dynamic updated)", serviceID, len(plan.dynamic))
+	return nil
+}
+
+// plan compares the snippet files with the snippets of a service version.
+// Files without a matching snippet are created as versioned snippets.
+func (c *SyncCommand) plan(serviceID string, files []File, snippets []*fastly.Snippet) (syncPlan, error) {
+	var p syncPlan
+
+	existing := make(map[string]*fastly.Snippet, len(snippets))
+	for _, s := range snippets {
+		if s.DeletedAt == nil {
+			existing[s.Name] = s
+		}
+	}
+
+	for _, f := range files {
+		label := fmt.Sprintf("%s.%s", f.Type, f.Name)
+		s, ok := existing[f.Name]
+		switch {
+		case !ok:
+			p.create = append(p.create, &fastly.CreateSnippetInput{
+				ServiceID: serviceID,
+				Name:      f.Name,
+				Type:      f.Type,
+				Content:   f.Content,
+			})
+			p.summary = append(p.summary, "+ "+label)
+		case s.Dynamic == 1:
+			if s.Type != f.Type {
+				return p, errors.RemediationError{
+					Inner:       fmt.Errorf("dynamic VCL snippet '%s' has type '%s' but its file has type '%s'", f.Name, s.Type, f.Type),
+					Remediation: "Change the snippet's type with 'fastly vcl snippet update --type', or rename the file.",
+				}
+			}
+			d, err := c.Globals.Client.GetDynamicSnippet(&fastly.GetDynamicSnippetInput{
+				ServiceID: serviceID,
+				ID:        s.ID,
+			})
+			if err != nil {
+				return p, err
+			}
+			if d.Content != f.Content {
+				p.dynamic = append(p.dynamic, &fastly.UpdateDynamicSnippetInput{
+					ServiceID: serviceID,
+					ID:        s.ID,
+					Content:   fastly.String(f.Content),
+				})
+				p.summary = append(p.summary, fmt.Sprintf("~ %s (dynamic)", label))
+			}
+		case s.Content != f.Content || s.Type != f.Type:
+			location := f.Type
+			p.update = append(p.update, &fastly.UpdateSnippetInput{
+				ServiceID: serviceID,
+				Name:      f.Name,
+				Type:      &location,
+				Content:   fastly.String(f.Content),
+			})
+			p.summary = append(p.summary, "~ "+label)
+		}
+	}
+
+	return p, nil
+}
+
+// ReadSnippets reads the VCL snippet files in a directory. Each file is named
+// <type>.<name>.vcl, where type is a fastly.SnippetType. Files without a .vcl
+// extension are ignored.
+func ReadSnippets(dir string) ([]File, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []File
+	for _, e := range entries {
+		if e.IsDir() || filepath.Ext(e.Name()) != ".vcl" {
+			continue
+		}
+
+		f, err := parseSnippetFilename(e.Name())
+		if err != nil {
+			return nil, err
+		}
+		/* #nosec */
+		content, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		f.Content = string(content)
+		files = append(files, f)
+	}
+
+	if len(files) == 0 {
+		return nil, errors.RemediationError{
+			Inner:       fmt.Errorf("no VCL snippet files found in %s", dir),
+			Remediation: "Name each snippet file after its type and name, e.g. recv.blocklist.vcl.",
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Name < files[j].Name
+	})
+	for i := 1; i < len(files); i++ {
+		if files[i].Name == files[i-1].Name {
+			return nil, fmt.Errorf("error reading %s: more than one file for VCL snippet '%s'", dir, files[i].Name)
+		}
+	}
+	return files, nil
+}
+
+func parseSnippetFilename(filename string) (File, error) {
+	var f File
+	parts := strings.SplitN(strings.TrimSuffix(filename, ".vcl"), ".", 2)
+	if len(parts) != 2 || parts[1] == "" {
+		return f, errors.RemediationError{
+			Inner:       fmt.Errorf("invalid VCL snippet file name '%s'", filename),
+			Remediation: "Name each snippet file after its type and name, e.g. recv.blocklist.vcl.",
+		}
+	}
+
+	for _, t := range snippetTypes {
+		if parts[0] == string(t) {
+			f.Type = t
+			f.Name = parts[1]
+			return f, nil
+		}
+	}
+
+	types := make([]string, len(snippetTypes))
+	for i, t := range snippetTypes {
+		types[i] = string(t)
+	}
+	return f, errors.RemediationError{
+		Inner:       fmt.Errorf("invalid VCL snippet type '%s' in file name '%s'", parts[0], filename),
+		Remediation: fmt.Sprintf("The type must be one of: %s.", strings.Join(types, ", ")),
+	}
+}
@@ -7,11 +7,15 @@ import (
 	"net/http"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/cmd"
+	"github.com/fastly/cli/pkg/commands/token"
 	"github.com/fastly/cli/pkg/config"
 	"github.com/fastly/cli/pkg/errors"
+	"github.com/fastly/cli/pkg/text"
+	fsttime "github.com/fastly/cli/pkg/time"
 	"github.com/fastly/cli/pkg/useragent"
 )
 
@@ -68,8 +72,13 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 		return fmt.Errorf("error decoding API response: %w", err)
 	}
 
+	if c.JSONOutput() {
+		return c.WriteJSON(out, response)
+	}
+
 	if !c.Globals.Verbose() {
 		fmt.Fprintf(out, "%s <%s>\n", response.User.Name, response.User.Login)
+		c.warnExpiry(out, response.Token)
 		return nil
 	}
 
@@ -95,10 +104,27 @@ func (c *RootCommand) Exec(in io.Reader, out io.Writer) error {
 	for _, k := range keys {
 		fmt.Fprintf(out, "\t%s (%s)\n", response.Services[k], k)
 	}
+	c.warnExpiry(out, response.Token)
 
 	return nil
 }
 
+// warnExpiry warns if the token expires within the configured window.
+func (c *RootCommand) warnExpiry(out io.Writer, t Token) {
+	if t.ExpiresAt == "" {
+		return
+	}
+	expires, err := time.Parse(time.RFC3339, t.ExpiresAt)
+	if err != nil {
+		return
+	}
+	if token.ExpiresWithin(&expires, c.Globals.TokenExpiryWarning(), time.Now()) {
+		text.Warning(out, "Fastly API token expires soon.")
+		fmt.Fprintf(out, "The token '%s' expires at %s (UTC).\n", t.Name, expires.UTC().Format(fsttime.Format))
+		fmt.Fprintf(out, "Create a replacement with `fastly token create` before then.\n")
+	}
+}
+
 // VerifyResponse models the Fastly API response for the whoami command.
 type VerifyResponse struct {
 	Customer Customer          `json:"customer"`
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/fastly/cli/pkg/api"
 	"github.com/fastly/cli/pkg/app"
@@ -46,6 +47,12 @@ func TestWhoami(t *testing.T) {
 			client:     verifyClient(basicResponse),
 			wantOutput: basicOutputVerbose,
 		},
+		{
+			name:       "expiring token",
+			args:       args("--token=x whoami"),
+			client:     verifyClient(expiringResponse()),
+			wantOutput: "The token 'Token name' expires at",
+		},
 		{
 			name:      "500 from API",
 			args:      args("--token=x whoami"),
@@ -139,6 +146,12 @@ var basicResponse = whoami.VerifyResponse{
 	},
 }
 
+func expiringResponse() whoami.VerifyResponse {
+	r := basicResponse
+	r.Token.ExpiresAt = time.Now().Add(24 * time.Hour).Format(time.RFC3339)
+	return r
+}
+
 var basicOutput = "Alice Programmer <alice@example.com>\n"
 
 var basicOutputVerbose = strings.TrimSpace(`
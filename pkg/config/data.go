@@ -2,12 +2,16 @@ package config
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -18,6 +22,7 @@ import (
 	"github.com/fastly/cli/pkg/revision"
 	"github.com/fastly/cli/pkg/text"
 	"github.com/fastly/cli/pkg/useragent"
+	"github.com/fastly/go-fastly/v3/fastly"
 	toml "github.com/pelletier/go-toml"
 )
 
@@ -41,6 +46,10 @@ const (
 	// SourceDefault indicates the parameter came from a program default.
 	SourceDefault
 
+	// SourceTokenHelper indicates the parameter came from the output of the
+	// token helper command configured in the config file.
+	SourceTokenHelper
+
 	// DirectoryPermissions is the default directory permissions for the config file directory.
 	DirectoryPermissions = 0700
 
@@ -98,40 +107,220 @@ type Data struct {
 
 	Client    api.Interface
 	RTSClient api.RealtimeStatsInterface
+
+	// Credentials holds the tokens decrypted from the credential store, keyed
+	// by CredentialKey. It's only populated once the store has been unlocked.
+	Credentials map[string]string
+
+	// tokenHelper caches the result of running the token helper, so that it's
+	// run at most once for the lifetime of the process.
+	tokenHelper *tokenHelperResult
+}
+
+type tokenHelperResult struct {
+	token string
+	err   error
 }
 
 // Token yields the Fastly API token.
+//
+// A token stored against a profile selected via --profile takes precedence
+// over the environment, while a profile selected via the environment (or the
+// default profile) only takes precedence over the token helper and then the
+// top-level user token.
+//
+// Tokens that have been moved into the credential store are resolved from
+// Credentials, and are only available once the store has been unlocked.
 func (d *Data) Token() (string, Source) {
+	token, source, key, _ := d.token()
+	if token == CredentialStoreToken {
+		token = d.Credentials[key]
+		if token == "" {
+			return "", SourceUndefined
+		}
+	}
+	return token, source
+}
+
+// TokenStored indicates whether the token in use is held in the credential
+// store, and so requires the store to be unlocked before it can be used.
+func (d *Data) TokenStored() bool {
+	token, _, _, _ := d.token()
+	return token == CredentialStoreToken
+}
+
+// TokenError yields the error from resolving the token in use, which is only
+// possible when it's provided by the token helper.
+func (d *Data) TokenError() error {
+	_, _, _, err := d.token()
+	return err
+}
+
+// token yields the Fastly API token as it was provided, along with the key
+// it would be held under in the credential store.
+func (d *Data) token() (string, Source, string, error) {
 	if d.Flag.Token != "" {
-		return d.Flag.Token, SourceFlag
+		return d.Flag.Token, SourceFlag, "", nil
+	}
+
+	if p, source := d.profile(); source == SourceFlag && p.Token != "" {
+		name, _ := d.Profile()
+		return p.Token, SourceFile, CredentialKey(name), nil
 	}
 
 	if d.Env.Token != "" {
-		return d.Env.Token, SourceEnvironment
+		return d.Env.Token, SourceEnvironment, "", nil
+	}
+
+	if p, source := d.profile(); source != SourceUndefined && p.Token != "" {
+		name, _ := d.Profile()
+		return p.Token, SourceFile, CredentialKey(name), nil
+	}
+
+	if d.File.User.TokenHelper != "" {
+		token, err := d.runTokenHelper()
+		if err != nil {
+			return "", SourceUndefined, "", err
+		}
+		return token, SourceTokenHelper, "", nil
 	}
 
 	if d.File.User.Token != "" {
-		return d.File.User.Token, SourceFile
+		return d.File.User.Token, SourceFile, CredentialKey(""), nil
+	}
+
+	return "", SourceUndefined, "", nil
+}
+
+// runTokenHelper runs the token helper command and reads the token from its
+// stdout. The helper's stdin and stderr are those of the CLI, so that it can
+// prompt the user if necessary.
+//
+// NOTE: the command is split on whitespace and run directly rather than via a
+// shell, and the result is cached so the helper only runs once per process.
+func (d *Data) runTokenHelper() (string, error) {
+	if d.tokenHelper != nil {
+		return d.tokenHelper.token, d.tokenHelper.err
+	}
+
+	var r tokenHelperResult
+	args := strings.Fields(d.File.User.TokenHelper)
+	if len(args) == 0 {
+		r.err = fmt.Errorf("%v: the token_helper command is blank", ErrInvalidConfig)
+		d.tokenHelper = &r
+		return r.token, r.err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), TokenHelperTimeout)
+	defer cancel()
+
+	// gosec flagged this:
+	// G204 (CWE-78): Subprocess launched with variable
+	// Disabling as the command is configured by the user in their config file.
+	/* #nosec */
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+
+	switch {
+	case err != nil:
+		r.err = fmt.Errorf("error running token helper '%s': %w", d.File.User.TokenHelper, err)
+	case strings.TrimSpace(string(out)) == "":
+		r.err = fmt.Errorf("token helper '%s' didn't output a token", d.File.User.TokenHelper)
+	default:
+		r.token = strings.TrimSpace(string(out))
+	}
+
+	d.tokenHelper = &r
+	return r.token, r.err
+}
+
+// CredentialKey returns the key that the token of the named profile is held
+// under in the credential store. An empty name refers to the top-level user
+// token.
+func CredentialKey(profile string) string {
+	if profile == "" {
+		return "user"
+	}
+	return "profile." + profile
+}
+
+// Profile yields the name of the profile in use.
+//
+// NOTE: the profile name is returned even when it doesn't exist in the config
+// file so that the caller can report it back to the user.
+func (d *Data) Profile() (string, Source) {
+	if d.Flag.Profile != "" {
+		return d.Flag.Profile, SourceFlag
+	}
+
+	if d.Env.Profile != "" {
+		return d.Env.Profile, SourceEnvironment
+	}
+
+	if name, ok := d.File.DefaultProfile(); ok {
+		return name, SourceFile
 	}
 
 	return "", SourceUndefined
 }
 
+// profile returns the profile in use along with the source it was selected
+// from. SourceUndefined is returned if no profile is in use or if the selected
+// profile doesn't exist.
+func (d *Data) profile() (*Profile, Source) {
+	name, source := d.Profile()
+	if source == SourceUndefined {
+		return nil, SourceUndefined
+	}
+	p, ok := d.File.Profiles[name]
+	if !ok || p == nil {
+		return nil, SourceUndefined
+	}
+	return p, source
+}
+
 // Verbose yields the verbose flag, which can only be set via flags.
 func (d *Data) Verbose() bool {
 	return d.Flag.Verbose
 }
 
+// JSON yields the json flag, which can only be set via flags.
+func (d *Data) JSON() bool {
+	return d.Flag.JSON
+}
+
+// TokenExpiryWarning yields the window within which an expiring API token
+// should be warned about. DefaultTokenExpiryWarning is returned if the config
+// file doesn't specify a valid duration.
+func (d *Data) TokenExpiryWarning() time.Duration {
+	if d.File.User.TokenExpiryWarning != "" {
+		if w, err := time.ParseDuration(d.File.User.TokenExpiryWarning); err == nil && w >= 0 {
+			return w
+		}
+	}
+	return DefaultTokenExpiryWarning
+}
+
 // Endpoint yields the API endpoint.
 func (d *Data) Endpoint() (string, Source) {
 	if d.Flag.Endpoint != "" {
 		return d.Flag.Endpoint, SourceFlag
 	}
 
+	if p, source := d.profile(); source == SourceFlag && p.APIEndpoint != "" {
+		return p.APIEndpoint, SourceFile
+	}
+
 	if d.Env.Endpoint != "" {
 		return d.Env.Endpoint, SourceEnvironment
 	}
 
+	if p, source := d.profile(); source != SourceUndefined && p.APIEndpoint != "" {
+		return p.APIEndpoint, SourceFile
+	}
+
 	if d.File.Fastly.APIEndpoint != DefaultEndpoint && d.File.Fastly.APIEndpoint != "" {
 		return d.File.Fastly.APIEndpoint, SourceFile
 	}
@@ -153,6 +342,17 @@ var FilePath = func() string {
 // DefaultEndpoint is the default Fastly API endpoint.
 const DefaultEndpoint = "https://api.fastly.com"
 
+// TokenHelperTimeout is how long the token helper has to output a token.
+const TokenHelperTimeout = 30 * time.Second
+
+// CredentialStoreToken is written to the config file in place of a token that
+// has been moved into the encrypted credential store.
+const CredentialStoreToken = "credential-store"
+
+// DefaultTokenExpiryWarning is the default window within which an expiring
+// API token is warned about.
+const DefaultTokenExpiryWarning = 7 * 24 * time.Hour
+
 // LegacyFile represents the old toml configuration format.
 //
 // NOTE: this exists to catch situations where an existing CLI user upgrades
@@ -177,6 +377,15 @@ type File struct {
 	Language      Language            `toml:"language"`
 	StarterKits   StarterKitLanguages `toml:"starter-kits"`
 
+	// Profiles are named sets of user credentials (and an optional API
+	// endpoint) which can be selected via --profile or the environment.
+	Profiles map[string]*Profile `toml:"profile,omitempty"`
+
+	// TokenChecks caches the details of the API tokens in use, keyed by
+	// TokenFingerprint, so that a token's expiry and scope can be checked
+	// without looking it up on every command.
+	TokenChecks map[string]*TokenCheck `toml:"token_check,omitempty"`
+
 	// We store off a possible legacy configuration so that we can later extract
 	// the relevant email and token values that may pre-exist.
 	Legacy LegacyFile `toml:"legacy"`
@@ -203,13 +412,113 @@ type CLI struct {
 type User struct {
 	Token string `toml:"token"`
 	Email string `toml:"email"`
+
+	// TokenHelper is a command that outputs the Fastly API token to stdout,
+	// for example to fetch it from a secrets manager.
+	TokenHelper string `toml:"token_helper,omitempty"`
+
+	// TokenExpiryWarning is how far in advance (as a Go duration string, e.g.
+	// "72h") the CLI warns that the API token in use is about to expire.
+	TokenExpiryWarning string `toml:"token_expiry_warning,omitempty"`
+}
+
+// Profile represents a named set of user specific configuration.
+type Profile struct {
+	Default     bool   `toml:"default"`
+	Email       string `toml:"email"`
+	Token       string `toml:"token"`
+	APIEndpoint string `toml:"api_endpoint,omitempty"`
+}
+
+// TokenCheckTTL is how long the cached details of an API token are used before
+// the token is looked up again.
+const TokenCheckTTL = "24h"
+
+// TokenCheck represents the cached details of an API token.
+type TokenCheck struct {
+	Name        string   `toml:"name"`
+	Scope       string   `toml:"scope"`
+	Services    []string `toml:"services,omitempty"`
+	ExpiresAt   string   `toml:"expires_at,omitempty"`
+	LastChecked string   `toml:"last_checked"`
+}
+
+// NewTokenCheck returns the details of an API token to cache.
+func NewTokenCheck(t *fastly.Token) *TokenCheck {
+	c := &TokenCheck{
+		Name:        t.Name,
+		Scope:       string(t.Scope),
+		Services:    t.Services,
+		LastChecked: time.Now().Format(time.RFC3339),
+	}
+	if t.ExpiresAt != nil {
+		c.ExpiresAt = t.ExpiresAt.Format(time.RFC3339)
+	}
+	return c
+}
+
+// Token returns the cached details as a fastly.Token.
+func (c *TokenCheck) Token() *fastly.Token {
+	t := &fastly.Token{
+		Name:     c.Name,
+		Scope:    fastly.TokenScope(c.Scope),
+		Services: c.Services,
+	}
+	if expires, err := time.Parse(time.RFC3339, c.ExpiresAt); err == nil {
+		t.ExpiresAt = &expires
+	}
+	return t
+}
+
+// TokenFingerprint returns the key under which the details of an API token
+// are cached, so that the token itself isn't written to the config file again.
+func TokenFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// DefaultProfile returns the name of the profile marked as the default.
+func (f *File) DefaultProfile() (string, bool) {
+	names := make([]string, 0, len(f.Profiles))
+	for name := range f.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if p := f.Profiles[name]; p != nil && p.Default {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// SetDefaultProfile marks the named profile as the default, ensuring no other
+// profile is also marked as the default.
+func (f *File) SetDefaultProfile(name string) {
+	for n, p := range f.Profiles {
+		if p != nil {
+			p.Default = n == name
+		}
+	}
 }
 
 // Language represents C@E language specific configuration.
 type Language struct {
+	Go   Go   `toml:"go"`
 	Rust Rust `toml:"rust"`
 }
 
+// Go represents Go C@E language specific configuration.
+type Go struct {
+	// ToolchainConstraint is the `go` version constraint for the compiler that
+	// TinyGo builds with (a range is expected, e.g. >= 1.17 < 1.19).
+	ToolchainConstraint string `toml:"toolchain_constraint"`
+
+	// TinyGoConstraint is the `tinygo` version constraint for the compiler that
+	// we support (a range is expected, e.g. >= 0.24.0 < 1.0.0).
+	TinyGoConstraint string `toml:"tinygo_constraint"`
+}
+
 // Rust represents Rust C@E language specific configuration.
 type Rust struct {
 	// ToolchainVersion is the `rustup` toolchain string for the compiler that we
@@ -237,6 +546,7 @@ type Rust struct {
 // StarterKitLanguages represents language specific starter kits.
 type StarterKitLanguages struct {
 	AssemblyScript []StarterKit `toml:"assemblyscript"`
+	Go             []StarterKit `toml:"go"`
 	JavaScript     []StarterKit `toml:"javascript"`
 	Rust           []StarterKit `toml:"rust"`
 }
@@ -478,14 +788,18 @@ func (f *File) Write(fpath string) error {
 // Environment represents all of the configuration parameters that can come
 // from environment variables.
 type Environment struct {
-	Token    string
-	Endpoint string
+	Token                 string
+	Endpoint              string
+	Profile               string
+	CredentialsPassphrase string
 }
 
 // Read populates the fields from the provided environment.
 func (e *Environment) Read(state map[string]string) {
 	e.Token = state[env.Token]
 	e.Endpoint = state[env.Endpoint]
+	e.Profile = state[env.Profile]
+	e.CredentialsPassphrase = state[env.CredentialsPassphrase]
 }
 
 // Flag represents all of the configuration parameters that can be set with
@@ -495,6 +809,8 @@ type Flag struct {
 	Token    string
 	Verbose  bool
 	Endpoint string
+	JSON     bool
+	Profile  string
 }
 
 // This suggests our embedded config is unexpectedly faulty and so we should
@@ -420,3 +420,205 @@ func TestValidConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestProfiles(t *testing.T) {
+	file := config.File{
+		Fastly: config.Fastly{APIEndpoint: config.DefaultEndpoint},
+		User:   config.User{Token: "user-token"},
+		Profiles: map[string]*config.Profile{
+			"production": {Default: true, Token: "production-token"},
+			"staging":    {Token: "staging-token", APIEndpoint: "https://staging.example.com"},
+		},
+	}
+
+	for _, testcase := range []struct {
+		name               string
+		flag               config.Flag
+		env                config.Environment
+		file               config.File
+		wantProfile        string
+		wantProfileSource  config.Source
+		wantToken          string
+		wantTokenSource    config.Source
+		wantEndpoint       string
+		wantEndpointSource config.Source
+	}{
+		{
+			name:               "no profiles",
+			file:               config.File{User: config.User{Token: "user-token"}},
+			wantProfileSource:  config.SourceUndefined,
+			wantToken:          "user-token",
+			wantTokenSource:    config.SourceFile,
+			wantEndpoint:       config.DefaultEndpoint,
+			wantEndpointSource: config.SourceDefault,
+		},
+		{
+			name:               "default profile",
+			file:               file,
+			wantProfile:        "production",
+			wantProfileSource:  config.SourceFile,
+			wantToken:          "production-token",
+			wantTokenSource:    config.SourceFile,
+			wantEndpoint:       config.DefaultEndpoint,
+			wantEndpointSource: config.SourceDefault,
+		},
+		{
+			name:               "profile from environment",
+			env:                config.Environment{Profile: "staging"},
+			file:               file,
+			wantProfile:        "staging",
+			wantProfileSource:  config.SourceEnvironment,
+			wantToken:          "staging-token",
+			wantTokenSource:    config.SourceFile,
+			wantEndpoint:       "https://staging.example.com",
+			wantEndpointSource: config.SourceFile,
+		},
+		{
+			name:               "token from environment overrides profile from environment",
+			env:                config.Environment{Profile: "staging", Token: "env-token"},
+			file:               file,
+			wantProfile:        "staging",
+			wantProfileSource:  config.SourceEnvironment,
+			wantToken:          "env-token",
+			wantTokenSource:    config.SourceEnvironment,
+			wantEndpoint:       "https://staging.example.com",
+			wantEndpointSource: config.SourceFile,
+		},
+		{
+			name:               "profile from flag overrides environment",
+			flag:               config.Flag{Profile: "staging"},
+			env:                config.Environment{Token: "env-token", Endpoint: "https://env.example.com"},
+			file:               file,
+			wantProfile:        "staging",
+			wantProfileSource:  config.SourceFlag,
+			wantToken:          "staging-token",
+			wantTokenSource:    config.SourceFile,
+			wantEndpoint:       "https://staging.example.com",
+			wantEndpointSource: config.SourceFile,
+		},
+		{
+			name:               "token from flag overrides profile from flag",
+			flag:               config.Flag{Profile: "staging", Token: "flag-token"},
+			file:               file,
+			wantProfile:        "staging",
+			wantProfileSource:  config.SourceFlag,
+			wantToken:          "flag-token",
+			wantTokenSource:    config.SourceFlag,
+			wantEndpoint:       "https://staging.example.com",
+			wantEndpointSource: config.SourceFile,
+		},
+		{
+			name:               "unknown profile",
+			flag:               config.Flag{Profile: "unknown"},
+			file:               file,
+			wantProfile:        "unknown",
+			wantProfileSource:  config.SourceFlag,
+			wantToken:          "user-token",
+			wantTokenSource:    config.SourceFile,
+			wantEndpoint:       config.DefaultEndpoint,
+			wantEndpointSource: config.SourceDefault,
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			d := config.Data{
+				File: testcase.file,
+				Env:  testcase.env,
+				Flag: testcase.flag,
+			}
+
+			profile, source := d.Profile()
+			testutil.AssertString(t, testcase.wantProfile, profile)
+			if source != testcase.wantProfileSource {
+				t.Errorf("profile source: want %v, have %v", testcase.wantProfileSource, source)
+			}
+
+			token, source := d.Token()
+			testutil.AssertString(t, testcase.wantToken, token)
+			if source != testcase.wantTokenSource {
+				t.Errorf("token source: want %v, have %v", testcase.wantTokenSource, source)
+			}
+
+			endpoint, source := d.Endpoint()
+			testutil.AssertString(t, testcase.wantEndpoint, endpoint)
+			if source != testcase.wantEndpointSource {
+				t.Errorf("endpoint source: want %v, have %v", testcase.wantEndpointSource, source)
+			}
+		})
+	}
+}
+
+func TestTokenHelper(t *testing.T) {
+	for _, testcase := range []struct {
+		name            string
+		flag            config.Flag
+		env             config.Environment
+		file            config.File
+		wantToken       string
+		wantTokenSource config.Source
+		wantError       string
+	}{
+		{
+			name:            "token from helper",
+			file:            config.File{User: config.User{TokenHelper: "echo helper-token", Token: "user-token"}},
+			wantToken:       "helper-token",
+			wantTokenSource: config.SourceTokenHelper,
+		},
+		{
+			name:            "token from environment overrides helper",
+			env:             config.Environment{Token: "env-token"},
+			file:            config.File{User: config.User{TokenHelper: "false"}},
+			wantToken:       "env-token",
+			wantTokenSource: config.SourceEnvironment,
+		},
+		{
+			name: "token from profile overrides helper",
+			file: config.File{
+				User: config.User{TokenHelper: "false"},
+				Profiles: map[string]*config.Profile{
+					"production": {Default: true, Token: "production-token"},
+				},
+			},
+			wantToken:       "production-token",
+			wantTokenSource: config.SourceFile,
+		},
+		{
+			name:            "helper fails",
+			file:            config.File{User: config.User{TokenHelper: "false"}},
+			wantTokenSource: config.SourceUndefined,
+			wantError:       "error running token helper 'false'",
+		},
+		{
+			name:            "helper is blank",
+			file:            config.File{User: config.User{TokenHelper: "  "}},
+			wantTokenSource: config.SourceUndefined,
+			wantError:       "the configuration file is invalid: the token_helper command is blank",
+		},
+		{
+			name:            "helper outputs nothing",
+			file:            config.File{User: config.User{TokenHelper: "true"}},
+			wantTokenSource: config.SourceUndefined,
+			wantError:       "token helper 'true' didn't output a token",
+		},
+	} {
+		t.Run(testcase.name, func(t *testing.T) {
+			d := config.Data{
+				Flag: testcase.flag,
+				Env:  testcase.env,
+				File: testcase.file,
+			}
+			token, source := d.Token()
+			testutil.AssertString(t, testcase.wantToken, token)
+			testutil.AssertEqual(t, testcase.wantTokenSource, source)
+			testutil.AssertErrorContains(t, d.TokenError(), testcase.wantError)
+		})
+	}
+}
+
+func TestTokenHelperCached(t *testing.T) {
+	d := config.Data{
+		File: config.File{User: config.User{TokenHelper: "date +%s%N"}},
+	}
+	first, _ := d.Token()
+	second, _ := d.Token()
+	testutil.AssertString(t, first, second)
+}
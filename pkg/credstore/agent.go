@@ -0,0 +1,151 @@
+package credstore
+
+import (
+	"bufio"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// SocketDirectory is the name of the directory the agent socket lives in,
+	// alongside the CLI application config file.
+	SocketDirectory = "agent"
+
+	// SocketName is the name of the socket the agent listens on.
+	SocketName = "agent.sock"
+
+	// DirectoryPermissions is the directory permissions for the directory the
+	// agent socket lives in.
+	DirectoryPermissions = 0700
+
+	// DefaultTimeout is how long the agent holds the key for by default.
+	DefaultTimeout = 15 * time.Minute
+
+	agentDialTimeout = time.Second
+
+	requestKey  = "key"
+	requestStop = "stop"
+	responseOK  = "ok"
+)
+
+// ErrNoAgent indicates there's no agent running that holds the key.
+var ErrNoAgent = errors.New("no credential agent is running")
+
+// SocketPath returns the location of the agent socket for the given CLI
+// application config file path.
+func SocketPath(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), SocketDirectory, SocketName)
+}
+
+// Serve runs an agent listening on the given socket, which hands out the key
+// to clients until either the timeout elapses or the agent is stopped.
+//
+// NOTE: the socket is created inside a directory that's only accessible by the
+// current user, so that there's no window between the socket being created
+// and its permissions being restricted in which another user can connect.
+func Serve(socket string, key []byte, timeout time.Duration) error {
+	dir := filepath.Dir(socket)
+	if err := os.MkdirAll(dir, DirectoryPermissions); err != nil {
+		return fmt.Errorf("error creating agent socket directory: %w", err)
+	}
+	// The directory may predate the agent, so its permissions are enforced.
+	if err := os.Chmod(dir, DirectoryPermissions); err != nil {
+		return fmt.Errorf("error setting agent socket directory permissions: %w", err)
+	}
+
+	// Remove a socket left behind by an agent that didn't exit cleanly.
+	if err := os.Remove(socket); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing stale agent socket: %w", err)
+	}
+
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("error starting credential agent: %w", err)
+	}
+	defer l.Close()
+
+	if err := os.Chmod(socket, FilePermissions); err != nil {
+		return fmt.Errorf("error setting agent socket permissions: %w", err)
+	}
+
+	timer := time.AfterFunc(timeout, func() { l.Close() })
+	defer timer.Stop()
+
+	encoded := hex.EncodeToString(key)
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			// The listener is closed either when the timeout elapses or when the
+			// agent is asked to stop, neither of which is an error.
+			return nil
+		}
+		if stop := handle(conn, encoded); stop {
+			return nil
+		}
+	}
+}
+
+// handle responds to a single agent request, and reports whether the agent
+// should stop.
+func handle(conn net.Conn, key string) bool {
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(agentDialTimeout))
+
+	req, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return false
+	}
+
+	switch strings.TrimSpace(req) {
+	case requestKey:
+		fmt.Fprintln(conn, key)
+	case requestStop:
+		fmt.Fprintln(conn, responseOK)
+		return true
+	}
+	return false
+}
+
+// AgentKey requests the key from the agent listening on the given socket.
+func AgentKey(socket string) ([]byte, error) {
+	resp, err := request(socket, requestKey)
+	if err != nil {
+		return nil, err
+	}
+	key, err := hex.DecodeString(resp)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding key from credential agent: %w", err)
+	}
+	return key, nil
+}
+
+// StopAgent asks the agent listening on the given socket to exit, forgetting
+// the key it holds.
+func StopAgent(socket string) error {
+	_, err := request(socket, requestStop)
+	return err
+}
+
+func request(socket, req string) (string, error) {
+	conn, err := net.DialTimeout("unix", socket, agentDialTimeout)
+	if err != nil {
+		return "", ErrNoAgent
+	}
+	defer conn.Close()
+	conn.SetDeadline(time.Now().Add(agentDialTimeout))
+
+	if _, err := fmt.Fprintln(conn, req); err != nil {
+		return "", fmt.Errorf("error communicating with credential agent: %w", err)
+	}
+	resp, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return "", fmt.Errorf("error communicating with credential agent: %w", err)
+	}
+	return strings.TrimSpace(resp), nil
+}
@@ -0,0 +1,87 @@
+package credstore_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fastly/cli/pkg/credstore"
+	"github.com/fastly/cli/pkg/testutil"
+)
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), credstore.Filename)
+
+	s, err := credstore.New()
+	testutil.AssertNoError(t, err)
+	key, err := s.Key("correct horse")
+	testutil.AssertNoError(t, err)
+
+	tokens, err := s.Open(key)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, map[string]string{}, tokens)
+
+	want := map[string]string{"user": "abc", "profile.staging": "def"}
+	testutil.AssertNoError(t, s.Seal(key, want))
+	testutil.AssertNoError(t, s.Write(path))
+
+	s, err = credstore.Read(path)
+	testutil.AssertNoError(t, err)
+	key, err = s.Key("correct horse")
+	testutil.AssertNoError(t, err)
+	tokens, err = s.Open(key)
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, want, tokens)
+
+	key, err = s.Key("battery staple")
+	testutil.AssertNoError(t, err)
+	_, err = s.Open(key)
+	if !errors.Is(err, credstore.ErrIncorrectPassphrase) {
+		t.Fatalf("want %v, have %v", credstore.ErrIncorrectPassphrase, err)
+	}
+}
+
+func TestAgent(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), "config.toml")
+	socket := credstore.SocketPath(configPath)
+
+	_, err := credstore.AgentKey(socket)
+	if !errors.Is(err, credstore.ErrNoAgent) {
+		t.Fatalf("want %v, have %v", credstore.ErrNoAgent, err)
+	}
+
+	key := []byte("0123456789abcdef0123456789abcdef")
+	done := make(chan error)
+	go func() {
+		done <- credstore.Serve(socket, key, time.Minute)
+	}()
+
+	var have []byte
+	for i := 0; i < 100; i++ {
+		if have, err = credstore.AgentKey(socket); err == nil {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, key, have)
+
+	fi, err := os.Stat(filepath.Dir(socket))
+	testutil.AssertNoError(t, err)
+	testutil.AssertEqual(t, os.FileMode(credstore.DirectoryPermissions), fi.Mode().Perm())
+
+	testutil.AssertNoError(t, credstore.StopAgent(socket))
+	testutil.AssertNoError(t, <-done)
+
+	go func() {
+		done <- credstore.Serve(socket, key, 50*time.Millisecond)
+	}()
+	select {
+	case err := <-done:
+		testutil.AssertNoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("agent didn't exit after its timeout")
+	}
+}
@@ -0,0 +1,4 @@
+// Package credstore implements an encrypted store for Fastly API tokens, along
+// with an agent process that can hold the store's key in memory for a limited
+// time so that the passphrase doesn't need to be entered for every command.
+package credstore
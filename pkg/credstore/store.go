@@ -0,0 +1,154 @@
+package credstore
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Filename is the name of the credential store file, which lives alongside
+	// the CLI application config file.
+	Filename = "credentials.json"
+
+	// FilePermissions is the file permissions for the credential store.
+	FilePermissions = 0600
+
+	// Version is the current version of the credential store file format.
+	Version = 1
+
+	// The scrypt parameters used to derive a key from a passphrase, as
+	// recommended for interactive logins.
+	scryptN = 32768
+	scryptR = 8
+	scryptP = 1
+
+	keySize   = 32
+	nonceSize = 24
+	saltSize  = 16
+)
+
+// ErrIncorrectPassphrase indicates the credential store couldn't be decrypted
+// with the passphrase (or agent key) provided.
+var ErrIncorrectPassphrase = errors.New("incorrect passphrase for the credential store")
+
+// Path returns the location of the credential store for the given CLI
+// application config file path.
+func Path(configPath string) string {
+	return filepath.Join(filepath.Dir(configPath), Filename)
+}
+
+// Store is the encrypted, on-disk representation of the credential store.
+//
+// The tokens are held as a JSON object (keyed by config.CredentialKey), which
+// is sealed using NaCl secretbox with a key derived from the user's passphrase
+// using scrypt.
+type Store struct {
+	Version int    `json:"version"`
+	Salt    []byte `json:"salt"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// New returns an empty credential store with a freshly generated salt.
+func New() (*Store, error) {
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+	return &Store{Version: Version, Salt: salt}, nil
+}
+
+// Read loads the credential store from the given path.
+func Read(path string) (*Store, error) {
+	/* #nosec */
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var s Store
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("error parsing credential store: %w", err)
+	}
+	if s.Version != Version {
+		return nil, fmt.Errorf("unsupported credential store version: %d", s.Version)
+	}
+	return &s, nil
+}
+
+// Write persists the credential store to the given path.
+func (s *Store) Write(path string) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, FilePermissions)
+}
+
+// Key derives the key for the credential store from the given passphrase.
+func (s *Store) Key(passphrase string) ([]byte, error) {
+	return scrypt.Key([]byte(passphrase), s.Salt, scryptN, scryptR, scryptP, keySize)
+}
+
+// Open decrypts the tokens held in the credential store using the given key.
+// An empty store yields an empty set of tokens.
+func (s *Store) Open(key []byte) (map[string]string, error) {
+	tokens := make(map[string]string)
+	if len(s.Data) == 0 {
+		return tokens, nil
+	}
+
+	k, err := toKey(key)
+	if err != nil {
+		return nil, err
+	}
+	var nonce [nonceSize]byte
+	copy(nonce[:], s.Nonce)
+
+	data, ok := secretbox.Open(nil, s.Data, &nonce, k)
+	if !ok {
+		return nil, ErrIncorrectPassphrase
+	}
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("error parsing credential store data: %w", err)
+	}
+	return tokens, nil
+}
+
+// Seal encrypts the given tokens into the credential store using the given
+// key, replacing any tokens it previously held.
+func (s *Store) Seal(key []byte, tokens map[string]string) error {
+	k, err := toKey(key)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(tokens)
+	if err != nil {
+		return err
+	}
+
+	var nonce [nonceSize]byte
+	if _, err := io.ReadFull(rand.Reader, nonce[:]); err != nil {
+		return fmt.Errorf("error generating nonce: %w", err)
+	}
+
+	s.Nonce = nonce[:]
+	s.Data = secretbox.Seal(nil, data, &nonce, k)
+	return nil
+}
+
+func toKey(key []byte) (*[keySize]byte, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("invalid credential store key length: %d", len(key))
+	}
+	var k [keySize]byte
+	copy(k[:], key)
+	return &k, nil
+}
@@ -13,4 +13,12 @@ const (
 
 	// ServiceID is the env var we look in for the required Service ID.
 	ServiceID = "FASTLY_SERVICE_ID"
+
+	// Profile is the env var we look in for the name of the config profile.
+	Profile = "FASTLY_PROFILE"
+
+	// CredentialsPassphrase is the env var we look in for the passphrase that
+	// unlocks the encrypted credential store.
+	/* #nosec */
+	CredentialsPassphrase = "FASTLY_CREDENTIALS_PASSPHRASE"
 )
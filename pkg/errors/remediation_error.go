@@ -96,3 +96,18 @@ var AutoCloneRemediation = strings.Join([]string{
 var IDRemediation = strings.Join([]string{
 	"Please provide one via the --id flag",
 }, " ")
+
+// ProfileRemediation suggests listing the available profiles or creating a
+// new one.
+var ProfileRemediation = strings.Join([]string{
+	"Run `fastly profile list` to see the available profiles,",
+	"or create a new profile using `fastly profile create --name <NAME>`.",
+}, " ")
+
+// CredentialsRemediation suggests how to provide the passphrase for the
+// encrypted credential store.
+var CredentialsRemediation = fmt.Sprintf(strings.Join([]string{
+	"Check the passphrase and try again, or unlock the credential store for a",
+	"period of time using `fastly credentials unlock`. The passphrase can also be",
+	"provided via the environment variable %s.",
+}, " "), env.CredentialsPassphrase)
@@ -3,6 +3,7 @@ package exec
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log"
@@ -10,6 +11,7 @@ import (
 	"os/exec"
 	"os/signal"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 )
@@ -24,7 +26,13 @@ type Streaming struct {
 	Env     []string
 	Output  io.Writer
 	Timeout time.Duration
+
+	mu      sync.Mutex
 	process *os.Process
+
+	cmd    *exec.Cmd
+	cancel context.CancelFunc
+	stderr bytes.Buffer
 }
 
 // MonitorSignals spawns a goroutine that configures signal handling so that
@@ -55,12 +63,22 @@ func monitorSignals(s *Streaming) {
 // Exec executes the compiler command and pipes the child process stdout and
 // stderr output to the supplied io.Writer, it waits for the command to exit
 // cleanly or returns an error.
-func (s Streaming) Exec() error {
+func (s *Streaming) Exec() error {
+	if err := s.Start(); err != nil {
+		return err
+	}
+	return s.Wait()
+}
+
+// Start executes the command in the same way as Exec, but returns once the
+// child process has started, so that it can be signalled straight away. Wait
+// must then be called to wait for the process to exit.
+func (s *Streaming) Start() error {
 	// Construct the command with given arguments and environment.
 	var cmd *exec.Cmd
 	if s.Timeout > 0 {
 		ctx, cancel := context.WithTimeout(context.Background(), s.Timeout)
-		defer cancel()
+		s.cancel = cancel
 		// gosec flagged this:
 		// G204 (CWE-78): Subprocess launched with variable
 		// Disabling as the variables come from trusted sources.
@@ -75,33 +93,61 @@ func (s Streaming) Exec() error {
 	}
 	cmd.Env = append(os.Environ(), s.Env...)
 
-	// Store off Process so it can be killed by signals
-	//lint:ignore SA4005 because it doesn't fail on macOS but does when run in CI.
-	s.process = cmd.Process
-
 	// Pipe the child process stdout and stderr to our own output writer.
-	var stderrBuf bytes.Buffer
+	s.stderr.Reset()
 	cmd.Stdout = s.Output
-	cmd.Stderr = io.MultiWriter(s.Output, &stderrBuf)
-
-	if err := cmd.Run(); err != nil {
-		var ctx string
-		if stderrBuf.Len() > 0 {
-			ctx = fmt.Sprintf(":\n%s", strings.TrimSpace(stderrBuf.String()))
-		} else {
-			ctx = fmt.Sprintf(":\n%s", err)
+	cmd.Stderr = io.MultiWriter(s.Output, &s.stderr)
+
+	if err := cmd.Start(); err != nil {
+		if s.cancel != nil {
+			s.cancel()
 		}
-		return fmt.Errorf("error during execution process%s", ctx)
+		return s.error(err)
 	}
 
+	// Store off Process so it can be killed by signals.
+	s.mu.Lock()
+	s.cmd = cmd
+	s.process = cmd.Process
+	s.mu.Unlock()
 	return nil
 }
 
+// Wait waits for the command started by Start to exit cleanly or returns an
+// error.
+func (s *Streaming) Wait() error {
+	if s.cancel != nil {
+		defer s.cancel()
+	}
+	if err := s.cmd.Wait(); err != nil {
+		return s.error(err)
+	}
+	return nil
+}
+
+// error adds the context of why the child process failed to its error.
+func (s *Streaming) error(err error) error {
+	// A process stopped by a signal reports the signal rather than its
+	// stderr output, as that won't explain why it stopped.
+	var exitErr *exec.ExitError
+	signaled := errors.As(err, &exitErr) && !exitErr.Exited()
+
+	var ctx string
+	if s.stderr.Len() > 0 && !signaled {
+		ctx = fmt.Sprintf(":\n%s", strings.TrimSpace(s.stderr.String()))
+	} else {
+		ctx = fmt.Sprintf(":\n%s", err)
+	}
+	return fmt.Errorf("error during execution process%s", ctx)
+}
+
 // Signal enables spawned subprocess to accept given signal.
-func (s Streaming) Signal(signal os.Signal) error {
+func (s *Streaming) Signal(signal os.Signal) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	if s.process != nil {
 		err := s.process.Signal(signal)
-		if err != nil {
+		if err != nil && err != os.ErrProcessDone {
 			return err
 		}
 	}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"net/http"
+
 	"github.com/fastly/go-fastly/v3/fastly"
 )
 
@@ -8,9 +10,16 @@ import (
 // The zero value is useful, but will panic on all methods. Provide function
 // implementations for the method(s) your test will call.
 type API struct {
-	AllDatacentersFn func() (datacenters []fastly.Datacenter, err error)
-	AllIPsFn         func() (v4, v6 fastly.IPAddrs, err error)
-	GetTokenSelfFn   func() (*fastly.Token, error)
+	GetFn func(string, *fastly.RequestOptions) (*http.Response, error)
+
+	AllDatacentersFn     func() (datacenters []fastly.Datacenter, err error)
+	AllIPsFn             func() (v4, v6 fastly.IPAddrs, err error)
+	GetTokenSelfFn       func() (*fastly.Token, error)
+	ListTokensFn         func() ([]*fastly.Token, error)
+	ListCustomerTokensFn func(*fastly.ListCustomerTokensInput) ([]*fastly.Token, error)
+	CreateTokenFn        func(*fastly.CreateTokenInput) (*fastly.Token, error)
+	DeleteTokenFn        func(*fastly.DeleteTokenInput) error
+	DeleteTokenSelfFn    func() error
 
 	CreateServiceFn     func(*fastly.CreateServiceInput) (*fastly.Service, error)
 	ListServicesFn      func(*fastly.ListServicesInput) ([]*fastly.Service, error)
@@ -47,6 +56,45 @@ type API struct {
 	UpdateHealthCheckFn func(*fastly.UpdateHealthCheckInput) (*fastly.HealthCheck, error)
 	DeleteHealthCheckFn func(*fastly.DeleteHealthCheckInput) error
 
+	CreateHeaderFn func(*fastly.CreateHeaderInput) (*fastly.Header, error)
+	ListHeadersFn  func(*fastly.ListHeadersInput) ([]*fastly.Header, error)
+	GetHeaderFn    func(*fastly.GetHeaderInput) (*fastly.Header, error)
+	UpdateHeaderFn func(*fastly.UpdateHeaderInput) (*fastly.Header, error)
+	DeleteHeaderFn func(*fastly.DeleteHeaderInput) error
+
+	CreateConditionFn func(*fastly.CreateConditionInput) (*fastly.Condition, error)
+	ListConditionsFn  func(*fastly.ListConditionsInput) ([]*fastly.Condition, error)
+	GetConditionFn    func(*fastly.GetConditionInput) (*fastly.Condition, error)
+	UpdateConditionFn func(*fastly.UpdateConditionInput) (*fastly.Condition, error)
+	DeleteConditionFn func(*fastly.DeleteConditionInput) error
+
+	CreateCacheSettingFn func(*fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error)
+	ListCacheSettingsFn  func(*fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error)
+	GetCacheSettingFn    func(*fastly.GetCacheSettingInput) (*fastly.CacheSetting, error)
+	UpdateCacheSettingFn func(*fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error)
+	DeleteCacheSettingFn func(*fastly.DeleteCacheSettingInput) error
+
+	CreateRequestSettingFn func(*fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error)
+	ListRequestSettingsFn  func(*fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error)
+	GetRequestSettingFn    func(*fastly.GetRequestSettingInput) (*fastly.RequestSetting, error)
+	UpdateRequestSettingFn func(*fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error)
+	DeleteRequestSettingFn func(*fastly.DeleteRequestSettingInput) error
+
+	CreateResponseObjectFn func(*fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error)
+	ListResponseObjectsFn  func(*fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error)
+	GetResponseObjectFn    func(*fastly.GetResponseObjectInput) (*fastly.ResponseObject, error)
+	UpdateResponseObjectFn func(*fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error)
+	DeleteResponseObjectFn func(*fastly.DeleteResponseObjectInput) error
+
+	CreateGzipFn func(*fastly.CreateGzipInput) (*fastly.Gzip, error)
+	ListGzipsFn  func(*fastly.ListGzipsInput) ([]*fastly.Gzip, error)
+	GetGzipFn    func(*fastly.GetGzipInput) (*fastly.Gzip, error)
+	UpdateGzipFn func(*fastly.UpdateGzipInput) (*fastly.Gzip, error)
+	DeleteGzipFn func(*fastly.DeleteGzipInput) error
+
+	GetSettingsFn    func(*fastly.GetSettingsInput) (*fastly.Settings, error)
+	UpdateSettingsFn func(*fastly.UpdateSettingsInput) (*fastly.Settings, error)
+
 	GetPackageFn    func(*fastly.GetPackageInput) (*fastly.Package, error)
 	UpdatePackageFn func(*fastly.UpdatePackageInput) (*fastly.Package, error)
 
@@ -215,7 +263,12 @@ type API struct {
 	UpdateOpenstackFn func(*fastly.UpdateOpenstackInput) (*fastly.Openstack, error)
 	DeleteOpenstackFn func(*fastly.DeleteOpenstackInput) error
 
-	GetUserFn func(*fastly.GetUserInput) (*fastly.User, error)
+	GetUserFn           func(*fastly.GetUserInput) (*fastly.User, error)
+	GetCurrentUserFn    func() (*fastly.User, error)
+	ListCustomerUsersFn func(*fastly.ListCustomerUsersInput) ([]*fastly.User, error)
+	CreateUserFn        func(*fastly.CreateUserInput) (*fastly.User, error)
+	UpdateUserFn        func(*fastly.UpdateUserInput) (*fastly.User, error)
+	DeleteUserFn        func(*fastly.DeleteUserInput) error
 
 	GetRegionsFn   func() (*fastly.RegionsResponse, error)
 	GetStatsJSONFn func(i *fastly.GetStatsInput, dst interface{}) error
@@ -259,6 +312,36 @@ type API struct {
 	GetNewRelicFn    func(i *fastly.GetNewRelicInput) (*fastly.NewRelic, error)
 	ListNewRelicFn   func(i *fastly.ListNewRelicInput) ([]*fastly.NewRelic, error)
 	UpdateNewRelicFn func(i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error)
+
+	CreatePrivateKeyFn func(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error)
+	DeletePrivateKeyFn func(i *fastly.DeletePrivateKeyInput) error
+	GetPrivateKeyFn    func(i *fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error)
+	ListPrivateKeysFn  func(i *fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error)
+
+	CreateCustomTLSCertificateFn func(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	DeleteCustomTLSCertificateFn func(i *fastly.DeleteCustomTLSCertificateInput) error
+	GetCustomTLSCertificateFn    func(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+	ListCustomTLSCertificatesFn  func(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error)
+	UpdateCustomTLSCertificateFn func(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error)
+
+	CreateTLSActivationFn func(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error)
+	DeleteTLSActivationFn func(i *fastly.DeleteTLSActivationInput) error
+	GetTLSActivationFn    func(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error)
+	ListTLSActivationsFn  func(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error)
+	UpdateTLSActivationFn func(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error)
+
+	CreateTLSSubscriptionFn func(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	DeleteTLSSubscriptionFn func(i *fastly.DeleteTLSSubscriptionInput) error
+	GetTLSSubscriptionFn    func(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+	ListTLSSubscriptionsFn  func(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error)
+	UpdateTLSSubscriptionFn func(i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error)
+
+	ListTLSDomainsFn func(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error)
+}
+
+// Get implements Interface.
+func (m API) Get(p string, ro *fastly.RequestOptions) (*http.Response, error) {
+	return m.GetFn(p, ro)
 }
 
 // AllDatacenters implements Interface.
@@ -276,6 +359,31 @@ func (m API) GetTokenSelf() (*fastly.Token, error) {
 	return m.GetTokenSelfFn()
 }
 
+// ListTokens implements Interface.
+func (m API) ListTokens() ([]*fastly.Token, error) {
+	return m.ListTokensFn()
+}
+
+// ListCustomerTokens implements Interface.
+func (m API) ListCustomerTokens(i *fastly.ListCustomerTokensInput) ([]*fastly.Token, error) {
+	return m.ListCustomerTokensFn(i)
+}
+
+// CreateToken implements Interface.
+func (m API) CreateToken(i *fastly.CreateTokenInput) (*fastly.Token, error) {
+	return m.CreateTokenFn(i)
+}
+
+// DeleteToken implements Interface.
+func (m API) DeleteToken(i *fastly.DeleteTokenInput) error {
+	return m.DeleteTokenFn(i)
+}
+
+// DeleteTokenSelf implements Interface.
+func (m API) DeleteTokenSelf() error {
+	return m.DeleteTokenSelfFn()
+}
+
 // CreateService implements Interface.
 func (m API) CreateService(i *fastly.CreateServiceInput) (*fastly.Service, error) {
 	return m.CreateServiceFn(i)
@@ -426,6 +534,166 @@ func (m API) DeleteHealthCheck(i *fastly.DeleteHealthCheckInput) error {
 	return m.DeleteHealthCheckFn(i)
 }
 
+// CreateHeader implements Interface.
+func (m API) CreateHeader(i *fastly.CreateHeaderInput) (*fastly.Header, error) {
+	return m.CreateHeaderFn(i)
+}
+
+// ListHeaders implements Interface.
+func (m API) ListHeaders(i *fastly.ListHeadersInput) ([]*fastly.Header, error) {
+	return m.ListHeadersFn(i)
+}
+
+// GetHeader implements Interface.
+func (m API) GetHeader(i *fastly.GetHeaderInput) (*fastly.Header, error) {
+	return m.GetHeaderFn(i)
+}
+
+// UpdateHeader implements Interface.
+func (m API) UpdateHeader(i *fastly.UpdateHeaderInput) (*fastly.Header, error) {
+	return m.UpdateHeaderFn(i)
+}
+
+// DeleteHeader implements Interface.
+func (m API) DeleteHeader(i *fastly.DeleteHeaderInput) error {
+	return m.DeleteHeaderFn(i)
+}
+
+// CreateCondition implements Interface.
+func (m API) CreateCondition(i *fastly.CreateConditionInput) (*fastly.Condition, error) {
+	return m.CreateConditionFn(i)
+}
+
+// ListConditions implements Interface.
+func (m API) ListConditions(i *fastly.ListConditionsInput) ([]*fastly.Condition, error) {
+	return m.ListConditionsFn(i)
+}
+
+// GetCondition implements Interface.
+func (m API) GetCondition(i *fastly.GetConditionInput) (*fastly.Condition, error) {
+	return m.GetConditionFn(i)
+}
+
+// UpdateCondition implements Interface.
+func (m API) UpdateCondition(i *fastly.UpdateConditionInput) (*fastly.Condition, error) {
+	return m.UpdateConditionFn(i)
+}
+
+// DeleteCondition implements Interface.
+func (m API) DeleteCondition(i *fastly.DeleteConditionInput) error {
+	return m.DeleteConditionFn(i)
+}
+
+// CreateCacheSetting implements Interface.
+func (m API) CreateCacheSetting(i *fastly.CreateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.CreateCacheSettingFn(i)
+}
+
+// ListCacheSettings implements Interface.
+func (m API) ListCacheSettings(i *fastly.ListCacheSettingsInput) ([]*fastly.CacheSetting, error) {
+	return m.ListCacheSettingsFn(i)
+}
+
+// GetCacheSetting implements Interface.
+func (m API) GetCacheSetting(i *fastly.GetCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.GetCacheSettingFn(i)
+}
+
+// UpdateCacheSetting implements Interface.
+func (m API) UpdateCacheSetting(i *fastly.UpdateCacheSettingInput) (*fastly.CacheSetting, error) {
+	return m.UpdateCacheSettingFn(i)
+}
+
+// DeleteCacheSetting implements Interface.
+func (m API) DeleteCacheSetting(i *fastly.DeleteCacheSettingInput) error {
+	return m.DeleteCacheSettingFn(i)
+}
+
+// CreateRequestSetting implements Interface.
+func (m API) CreateRequestSetting(i *fastly.CreateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.CreateRequestSettingFn(i)
+}
+
+// ListRequestSettings implements Interface.
+func (m API) ListRequestSettings(i *fastly.ListRequestSettingsInput) ([]*fastly.RequestSetting, error) {
+	return m.ListRequestSettingsFn(i)
+}
+
+// GetRequestSetting implements Interface.
+func (m API) GetRequestSetting(i *fastly.GetRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.GetRequestSettingFn(i)
+}
+
+// UpdateRequestSetting implements Interface.
+func (m API) UpdateRequestSetting(i *fastly.UpdateRequestSettingInput) (*fastly.RequestSetting, error) {
+	return m.UpdateRequestSettingFn(i)
+}
+
+// DeleteRequestSetting implements Interface.
+func (m API) DeleteRequestSetting(i *fastly.DeleteRequestSettingInput) error {
+	return m.DeleteRequestSettingFn(i)
+}
+
+// CreateResponseObject implements Interface.
+func (m API) CreateResponseObject(i *fastly.CreateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.CreateResponseObjectFn(i)
+}
+
+// ListResponseObjects implements Interface.
+func (m API) ListResponseObjects(i *fastly.ListResponseObjectsInput) ([]*fastly.ResponseObject, error) {
+	return m.ListResponseObjectsFn(i)
+}
+
+// GetResponseObject implements Interface.
+func (m API) GetResponseObject(i *fastly.GetResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.GetResponseObjectFn(i)
+}
+
+// UpdateResponseObject implements Interface.
+func (m API) UpdateResponseObject(i *fastly.UpdateResponseObjectInput) (*fastly.ResponseObject, error) {
+	return m.UpdateResponseObjectFn(i)
+}
+
+// DeleteResponseObject implements Interface.
+func (m API) DeleteResponseObject(i *fastly.DeleteResponseObjectInput) error {
+	return m.DeleteResponseObjectFn(i)
+}
+
+// CreateGzip implements Interface.
+func (m API) CreateGzip(i *fastly.CreateGzipInput) (*fastly.Gzip, error) {
+	return m.CreateGzipFn(i)
+}
+
+// ListGzips implements Interface.
+func (m API) ListGzips(i *fastly.ListGzipsInput) ([]*fastly.Gzip, error) {
+	return m.ListGzipsFn(i)
+}
+
+// GetGzip implements Interface.
+func (m API) GetGzip(i *fastly.GetGzipInput) (*fastly.Gzip, error) {
+	return m.GetGzipFn(i)
+}
+
+// UpdateGzip implements Interface.
+func (m API) UpdateGzip(i *fastly.UpdateGzipInput) (*fastly.Gzip, error) {
+	return m.UpdateGzipFn(i)
+}
+
+// DeleteGzip implements Interface.
+func (m API) DeleteGzip(i *fastly.DeleteGzipInput) error {
+	return m.DeleteGzipFn(i)
+}
+
+// GetSettings implements Interface.
+func (m API) GetSettings(i *fastly.GetSettingsInput) (*fastly.Settings, error) {
+	return m.GetSettingsFn(i)
+}
+
+// UpdateSettings implements Interface.
+func (m API) UpdateSettings(i *fastly.UpdateSettingsInput) (*fastly.Settings, error) {
+	return m.UpdateSettingsFn(i)
+}
+
 // GetPackage implements Interface.
 func (m API) GetPackage(i *fastly.GetPackageInput) (*fastly.Package, error) {
 	return m.GetPackageFn(i)
@@ -1126,6 +1394,31 @@ func (m API) GetUser(i *fastly.GetUserInput) (*fastly.User, error) {
 	return m.GetUserFn(i)
 }
 
+// GetCurrentUser implements Interface.
+func (m API) GetCurrentUser() (*fastly.User, error) {
+	return m.GetCurrentUserFn()
+}
+
+// ListCustomerUsers implements Interface.
+func (m API) ListCustomerUsers(i *fastly.ListCustomerUsersInput) ([]*fastly.User, error) {
+	return m.ListCustomerUsersFn(i)
+}
+
+// CreateUser implements Interface.
+func (m API) CreateUser(i *fastly.CreateUserInput) (*fastly.User, error) {
+	return m.CreateUserFn(i)
+}
+
+// UpdateUser implements Interface.
+func (m API) UpdateUser(i *fastly.UpdateUserInput) (*fastly.User, error) {
+	return m.UpdateUserFn(i)
+}
+
+// DeleteUser implements Interface.
+func (m API) DeleteUser(i *fastly.DeleteUserInput) error {
+	return m.DeleteUserFn(i)
+}
+
 // GetRegions implements Interface.
 func (m API) GetRegions() (*fastly.RegionsResponse, error) {
 	return m.GetRegionsFn()
@@ -1300,3 +1593,103 @@ func (m API) ListNewRelic(i *fastly.ListNewRelicInput) ([]*fastly.NewRelic, erro
 func (m API) UpdateNewRelic(i *fastly.UpdateNewRelicInput) (*fastly.NewRelic, error) {
 	return m.UpdateNewRelicFn(i)
 }
+
+// CreatePrivateKey implements Interface.
+func (m API) CreatePrivateKey(i *fastly.CreatePrivateKeyInput) (*fastly.PrivateKey, error) {
+	return m.CreatePrivateKeyFn(i)
+}
+
+// DeletePrivateKey implements Interface.
+func (m API) DeletePrivateKey(i *fastly.DeletePrivateKeyInput) error {
+	return m.DeletePrivateKeyFn(i)
+}
+
+// GetPrivateKey implements Interface.
+func (m API) GetPrivateKey(i *fastly.GetPrivateKeyInput) (*fastly.PrivateKey, error) {
+	return m.GetPrivateKeyFn(i)
+}
+
+// ListPrivateKeys implements Interface.
+func (m API) ListPrivateKeys(i *fastly.ListPrivateKeysInput) ([]*fastly.PrivateKey, error) {
+	return m.ListPrivateKeysFn(i)
+}
+
+// CreateCustomTLSCertificate implements Interface.
+func (m API) CreateCustomTLSCertificate(i *fastly.CreateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return m.CreateCustomTLSCertificateFn(i)
+}
+
+// DeleteCustomTLSCertificate implements Interface.
+func (m API) DeleteCustomTLSCertificate(i *fastly.DeleteCustomTLSCertificateInput) error {
+	return m.DeleteCustomTLSCertificateFn(i)
+}
+
+// GetCustomTLSCertificate implements Interface.
+func (m API) GetCustomTLSCertificate(i *fastly.GetCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return m.GetCustomTLSCertificateFn(i)
+}
+
+// ListCustomTLSCertificates implements Interface.
+func (m API) ListCustomTLSCertificates(i *fastly.ListCustomTLSCertificatesInput) ([]*fastly.CustomTLSCertificate, error) {
+	return m.ListCustomTLSCertificatesFn(i)
+}
+
+// UpdateCustomTLSCertificate implements Interface.
+func (m API) UpdateCustomTLSCertificate(i *fastly.UpdateCustomTLSCertificateInput) (*fastly.CustomTLSCertificate, error) {
+	return m.UpdateCustomTLSCertificateFn(i)
+}
+
+// CreateTLSActivation implements Interface.
+func (m API) CreateTLSActivation(i *fastly.CreateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return m.CreateTLSActivationFn(i)
+}
+
+// DeleteTLSActivation implements Interface.
+func (m API) DeleteTLSActivation(i *fastly.DeleteTLSActivationInput) error {
+	return m.DeleteTLSActivationFn(i)
+}
+
+// GetTLSActivation implements Interface.
+func (m API) GetTLSActivation(i *fastly.GetTLSActivationInput) (*fastly.TLSActivation, error) {
+	return m.GetTLSActivationFn(i)
+}
+
+// ListTLSActivations implements Interface.
+func (m API) ListTLSActivations(i *fastly.ListTLSActivationsInput) ([]*fastly.TLSActivation, error) {
+	return m.ListTLSActivationsFn(i)
+}
+
+// UpdateTLSActivation implements Interface.
+func (m API) UpdateTLSActivation(i *fastly.UpdateTLSActivationInput) (*fastly.TLSActivation, error) {
+	return m.UpdateTLSActivationFn(i)
+}
+
+// CreateTLSSubscription implements Interface.
+func (m API) CreateTLSSubscription(i *fastly.CreateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return m.CreateTLSSubscriptionFn(i)
+}
+
+// DeleteTLSSubscription implements Interface.
+func (m API) DeleteTLSSubscription(i *fastly.DeleteTLSSubscriptionInput) error {
+	return m.DeleteTLSSubscriptionFn(i)
+}
+
+// GetTLSSubscription implements Interface.
+func (m API) GetTLSSubscription(i *fastly.GetTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return m.GetTLSSubscriptionFn(i)
+}
+
+// ListTLSSubscriptions implements Interface.
+func (m API) ListTLSSubscriptions(i *fastly.ListTLSSubscriptionsInput) ([]*fastly.TLSSubscription, error) {
+	return m.ListTLSSubscriptionsFn(i)
+}
+
+// UpdateTLSSubscription implements Interface.
+func (m API) UpdateTLSSubscription(i *fastly.UpdateTLSSubscriptionInput) (*fastly.TLSSubscription, error) {
+	return m.UpdateTLSSubscriptionFn(i)
+}
+
+// ListTLSDomains implements Interface.
+func (m API) ListTLSDomains(i *fastly.ListTLSDomainsInput) ([]*fastly.TLSDomain, error) {
+	return m.ListTLSDomainsFn(i)
+}
@@ -11,3 +11,11 @@ func APIClient(a API) func(string, string) (api.Interface, error) {
 		return a, nil
 	}
 }
+
+// RTSClient takes a mock.RTS and returns an app.RTSClientFactory that uses
+// that mock, ignoring the token and endpoint. It should only be used for tests.
+func RTSClient(r RTS) func(string, string) (api.RealtimeStatsInterface, error) {
+	return func(token, endpoint string) (api.RealtimeStatsInterface, error) {
+		return r, nil
+	}
+}
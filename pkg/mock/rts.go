@@ -0,0 +1,17 @@
+package mock
+
+import (
+	"github.com/fastly/go-fastly/v3/fastly"
+)
+
+// RTS is a mock implementation of api.RealtimeStatsInterface that's used for
+// testing. The zero value is useful, but will panic on all methods. Provide
+// function implementations for the method(s) your test will call.
+type RTS struct {
+	GetRealtimeStatsJSONFn func(*fastly.GetRealtimeStatsInput, interface{}) error
+}
+
+// GetRealtimeStatsJSON implements api.RealtimeStatsInterface.
+func (m RTS) GetRealtimeStatsJSON(i *fastly.GetRealtimeStatsInput, dst interface{}) error {
+	return m.GetRealtimeStatsJSONFn(i, dst)
+}
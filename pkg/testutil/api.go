@@ -1,8 +1,17 @@
 package testutil
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
 
+	"github.com/fastly/cli/pkg/api"
+	"github.com/fastly/cli/pkg/mock"
 	"github.com/fastly/go-fastly/v3/fastly"
 )
 
@@ -61,3 +70,100 @@ func CloneVersionResult(version int) func(i *fastly.CloneVersionInput) (*fastly.
 func CloneVersionError(i *fastly.CloneVersionInput) (*fastly.Version, error) {
 	return nil, Err
 }
+
+// GetTokenSelf returns a globally scoped API token that doesn't expire.
+//
+// Commands run with a token trigger the runtime token check, which requires
+// the mock API to implement GetTokenSelf.
+func GetTokenSelf() (*fastly.Token, error) {
+	return &fastly.Token{
+		ID:    "123",
+		Name:  "Foo",
+		Scope: fastly.GlobalScope,
+	}, nil
+}
+
+// EmptyResourceLists sets every List function of the given mock API that
+// hasn't already been set to one that returns no resources.
+//
+// This is useful for commands that walk every resource type of a service
+// version, where a test only cares about a few of those resource types.
+func EmptyResourceLists(api mock.API) mock.API {
+	v := reflect.ValueOf(&api).Elem()
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := v.Field(i)
+		name := t.Field(i).Name
+		if !strings.HasPrefix(name, "List") || !strings.HasSuffix(name, "Fn") || !f.IsNil() {
+			continue
+		}
+		ft := f.Type()
+		f.Set(reflect.MakeFunc(ft, func([]reflect.Value) []reflect.Value {
+			results := make([]reflect.Value, ft.NumOut())
+			for j := range results {
+				results[j] = reflect.Zero(ft.Out(j))
+			}
+			return results
+		}))
+	}
+	return api
+}
+
+// PaginatedGet returns a mock.API GetFn that serves a slice of go-fastly
+// structs (e.g. []*fastly.DictionaryItem) a page at a time, using the page
+// query parameter and api.PageSize, encoded as the API would encode them.
+func PaginatedGet(results interface{}) func(string, *fastly.RequestOptions) (*http.Response, error) {
+	return func(path string, ro *fastly.RequestOptions) (*http.Response, error) {
+		page := 1
+		if ro != nil {
+			if n, err := strconv.Atoi(ro.Params["page"]); err == nil {
+				page = n
+			}
+		}
+
+		rv := reflect.ValueOf(results)
+		start, end := (page-1)*api.PageSize, page*api.PageSize
+		if start > rv.Len() {
+			start = rv.Len()
+		}
+		if end > rv.Len() {
+			end = rv.Len()
+		}
+
+		ms := make([]map[string]interface{}, 0, end-start)
+		for i := start; i < end; i++ {
+			ms = append(ms, apiFields(rv.Index(i).Interface()))
+		}
+		body, err := json.Marshal(ms)
+		if err != nil {
+			return nil, err
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(bytes.NewReader(body)),
+		}, nil
+	}
+}
+
+// GetError returns a generic error message for any request.
+func GetError(string, *fastly.RequestOptions) (*http.Response, error) {
+	return nil, Err
+}
+
+// apiFields converts a go-fastly struct into a map keyed by its mapstructure
+// tags, which are the field names used by the API.
+func apiFields(v interface{}) map[string]interface{} {
+	m := make(map[string]interface{})
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	t := rv.Type()
+	for i := 0; i < t.NumField(); i++ {
+		key := strings.Split(t.Field(i).Tag.Get("mapstructure"), ",")[0]
+		f := rv.Field(i)
+		if key == "" || (f.Kind() == reflect.Ptr && f.IsNil()) {
+			continue
+		}
+		m[key] = f.Interface()
+	}
+	return m
+}
@@ -62,6 +62,7 @@ func NewRunOpts(args []string, stdout io.Writer) app.RunOpts {
 		ErrLog:     errors.Log,
 		ConfigFile: config.File{},
 		HTTPClient: http.DefaultClient,
+		RTSClient:  mock.RTSClient(mock.RTS{}),
 		Stdout:     stdout,
 	}
 }
@@ -0,0 +1,22 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintCacheSetting pretty prints a fastly.CacheSetting structure in verbose
+// format to a given io.Writer. Consumers can provide a prefix string which
+// will be used as a prefix to each line, useful for indentation.
+func PrintCacheSetting(out io.Writer, prefix string, cs *fastly.CacheSetting) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Name: %s\n", cs.Name)
+	fmt.Fprintf(out, "Action: %s\n", cs.Action)
+	fmt.Fprintf(out, "TTL: %d\n", cs.TTL)
+	fmt.Fprintf(out, "Stale TTL: %d\n", cs.StaleTTL)
+	fmt.Fprintf(out, "Cache condition: %s\n", cs.CacheCondition)
+}
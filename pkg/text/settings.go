@@ -0,0 +1,21 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintSettings pretty prints a fastly.Settings structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will be
+// used as a prefix to each line, useful for indentation.
+func PrintSettings(out io.Writer, prefix string, s *fastly.Settings) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "Default TTL: %d\n", s.DefaultTTL)
+	fmt.Fprintf(out, "Default host: %s\n", s.DefaultHost)
+	fmt.Fprintf(out, "Stale if error: %t\n", s.StaleIfError)
+	fmt.Fprintf(out, "Stale if error TTL: %d\n", s.StaleIfErrorTTL)
+}
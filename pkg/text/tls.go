@@ -0,0 +1,141 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintTLSPrivateKey pretty prints a fastly.PrivateKey structure in verbose
+// format to a given io.Writer. Consumers can provide a prefix string which
+// will be used as a prefix to each line, useful for indentation.
+func PrintTLSPrivateKey(out io.Writer, prefix string, k *fastly.PrivateKey) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", k.ID)
+	fmt.Fprintf(out, "Name: %s\n", k.Name)
+	fmt.Fprintf(out, "Key Type: %s\n", k.KeyType)
+	fmt.Fprintf(out, "Key Length: %d\n", k.KeyLength)
+	fmt.Fprintf(out, "Public Key SHA1: %s\n", k.PublicKeySHA1)
+	fmt.Fprintf(out, "Replace: %t\n", k.Replace)
+	if k.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", k.CreatedAt.UTC().Format(time.Format))
+	}
+}
+
+// PrintTLSCertificate pretty prints a fastly.CustomTLSCertificate structure in
+// verbose format to a given io.Writer. Consumers can provide a prefix string
+// which will be used as a prefix to each line, useful for indentation.
+func PrintTLSCertificate(out io.Writer, prefix string, c *fastly.CustomTLSCertificate) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", c.ID)
+	fmt.Fprintf(out, "Name: %s\n", c.Name)
+	fmt.Fprintf(out, "Issued To: %s\n", c.IssuedTo)
+	fmt.Fprintf(out, "Issuer: %s\n", c.Issuer)
+	fmt.Fprintf(out, "Serial Number: %s\n", c.SerialNumber)
+	fmt.Fprintf(out, "Signature Algorithm: %s\n", c.SignatureAlgorithm)
+	fmt.Fprintf(out, "Replace: %t\n", c.Replace)
+	fmt.Fprintf(out, "Domains: %s\n", TLSDomainIDs(c.Domains))
+	if c.NotBefore != nil {
+		fmt.Fprintf(out, "Not Before (UTC): %s\n", c.NotBefore.UTC().Format(time.Format))
+	}
+	if c.NotAfter != nil {
+		fmt.Fprintf(out, "Not After (UTC): %s\n", c.NotAfter.UTC().Format(time.Format))
+	}
+	if c.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", c.CreatedAt.UTC().Format(time.Format))
+	}
+	if c.UpdatedAt != nil {
+		fmt.Fprintf(out, "Last edited (UTC): %s\n", c.UpdatedAt.UTC().Format(time.Format))
+	}
+}
+
+// PrintTLSActivation pretty prints a fastly.TLSActivation structure in verbose
+// format to a given io.Writer. Consumers can provide a prefix string which
+// will be used as a prefix to each line, useful for indentation.
+func PrintTLSActivation(out io.Writer, prefix string, a *fastly.TLSActivation) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", a.ID)
+	if a.Domain != nil {
+		fmt.Fprintf(out, "Domain: %s\n", a.Domain.ID)
+	}
+	if a.Certificate != nil {
+		fmt.Fprintf(out, "Certificate ID: %s\n", a.Certificate.ID)
+	}
+	if a.Configuration != nil {
+		fmt.Fprintf(out, "Configuration ID: %s\n", a.Configuration.ID)
+	}
+	if a.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", a.CreatedAt.UTC().Format(time.Format))
+	}
+}
+
+// PrintTLSSubscription pretty prints a fastly.TLSSubscription structure in
+// verbose format to a given io.Writer. Consumers can provide a prefix string
+// which will be used as a prefix to each line, useful for indentation.
+func PrintTLSSubscription(out io.Writer, prefix string, s *fastly.TLSSubscription) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", s.ID)
+	fmt.Fprintf(out, "State: %s\n", s.State)
+	fmt.Fprintf(out, "Certificate Authority: %s\n", s.CertificateAuthority)
+	if s.CommonName != nil {
+		fmt.Fprintf(out, "Common Name: %s\n", s.CommonName.ID)
+	}
+	fmt.Fprintf(out, "Domains: %s\n", TLSDomainIDs(s.Domains))
+	if s.Configuration != nil {
+		fmt.Fprintf(out, "Configuration ID: %s\n", s.Configuration.ID)
+	}
+	for _, cert := range s.Certificates {
+		fmt.Fprintf(out, "Certificate ID: %s\n", cert.ID)
+	}
+	for _, auth := range s.Authorizations {
+		fmt.Fprintf(out, "Authorization %s (%s)\n", auth.ID, auth.State)
+		for _, ch := range auth.Challenges {
+			fmt.Fprintf(out, "\tChallenge: %s\n", ch.Type)
+			fmt.Fprintf(out, "\t\tRecord: %s %s\n", ch.RecordType, ch.RecordName)
+			fmt.Fprintf(out, "\t\tValues: %s\n", strings.Join(ch.Values, ", "))
+		}
+	}
+	if s.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", s.CreatedAt.UTC().Format(time.Format))
+	}
+	if s.UpdatedAt != nil {
+		fmt.Fprintf(out, "Last edited (UTC): %s\n", s.UpdatedAt.UTC().Format(time.Format))
+	}
+}
+
+// PrintTLSDomain pretty prints a fastly.TLSDomain structure in verbose format
+// to a given io.Writer. Consumers can provide a prefix string which will be
+// used as a prefix to each line, useful for indentation.
+func PrintTLSDomain(out io.Writer, prefix string, d *fastly.TLSDomain) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", d.ID)
+	fmt.Fprintf(out, "Type: %s\n", d.Type)
+	for _, a := range d.Activations {
+		fmt.Fprintf(out, "Activation ID: %s\n", a.ID)
+	}
+	for _, c := range d.Certificates {
+		fmt.Fprintf(out, "Certificate ID: %s\n", c.ID)
+	}
+	for _, s := range d.Subscriptions {
+		fmt.Fprintf(out, "Subscription ID: %s\n", s.ID)
+	}
+}
+
+// TLSDomainIDs returns the IDs (i.e. the domain names) of the given TLS
+// domains as a comma-separated list.
+func TLSDomainIDs(domains []*fastly.TLSDomain) string {
+	ids := make([]string, 0, len(domains))
+	for _, d := range domains {
+		ids = append(ids, d.ID)
+	}
+	return strings.Join(ids, ", ")
+}
@@ -0,0 +1,34 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintToken pretty prints a fastly.Token structure in verbose format to a
+// given io.Writer. Consumers can provide a prefix string which will be used as
+// a prefix to each line, useful for indentation.
+func PrintToken(out io.Writer, prefix string, t *fastly.Token) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", t.ID)
+	fmt.Fprintf(out, "Name: %s\n", t.Name)
+	fmt.Fprintf(out, "User ID: %s\n", t.UserID)
+	fmt.Fprintf(out, "Services: %s\n", strings.Join(t.Services, ", "))
+	fmt.Fprintf(out, "Scope: %s\n", t.Scope)
+	fmt.Fprintf(out, "IP: %s\n", t.IP)
+	if t.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", t.CreatedAt.UTC().Format(time.Format))
+	}
+	if t.LastUsedAt != nil {
+		fmt.Fprintf(out, "Last used (UTC): %s\n", t.LastUsedAt.UTC().Format(time.Format))
+	}
+	if t.ExpiresAt != nil {
+		fmt.Fprintf(out, "Expires (UTC): %s\n", t.ExpiresAt.UTC().Format(time.Format))
+	}
+}
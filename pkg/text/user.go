@@ -0,0 +1,38 @@
+package text
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/fastly/cli/pkg/time"
+	"github.com/fastly/go-fastly/v3/fastly"
+	"github.com/segmentio/textio"
+)
+
+// PrintUser pretty prints a fastly.User structure in verbose format to a given
+// io.Writer. Consumers can provide a prefix string which will be used as a
+// prefix to each line, useful for indentation.
+func PrintUser(out io.Writer, prefix string, u *fastly.User) {
+	out = textio.NewPrefixWriter(out, prefix)
+
+	fmt.Fprintf(out, "ID: %s\n", u.ID)
+	fmt.Fprintf(out, "Login: %s\n", u.Login)
+	fmt.Fprintf(out, "Name: %s\n", u.Name)
+	fmt.Fprintf(out, "Role: %s\n", u.Role)
+	fmt.Fprintf(out, "Customer ID: %s\n", u.CustomerID)
+	fmt.Fprintf(out, "Email Hash: %s\n", u.EmailHash)
+	fmt.Fprintf(out, "Limit Services: %t\n", u.LimitServices)
+	fmt.Fprintf(out, "Locked: %t\n", u.Locked)
+	fmt.Fprintf(out, "Require New Password: %t\n", u.RequireNewPassword)
+	fmt.Fprintf(out, "Two Factor Auth Enabled: %t\n", u.TwoFactorAuthEnabled)
+	fmt.Fprintf(out, "Two Factor Setup Required: %t\n", u.TwoFactorSetupRequired)
+	if u.CreatedAt != nil {
+		fmt.Fprintf(out, "Created (UTC): %s\n", u.CreatedAt.UTC().Format(time.Format))
+	}
+	if u.UpdatedAt != nil {
+		fmt.Fprintf(out, "Last edited (UTC): %s\n", u.UpdatedAt.UTC().Format(time.Format))
+	}
+	if u.DeletedAt != nil {
+		fmt.Fprintf(out, "Deleted (UTC): %s\n", u.DeletedAt.UTC().Format(time.Format))
+	}
+}